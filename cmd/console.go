@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/df-mc/dragonfly/server"
+	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// console is the command source for commands typed into the server's standard input.
+type console struct {
+	log *slog.Logger
+}
+
+// Name ...
+func (console) Name() string { return "Console" }
+
+// Position ...
+func (console) Position() mgl64.Vec3 { return mgl64.Vec3{} }
+
+// SendCommandOutput logs the messages and errors of a command run from the console.
+func (c console) SendCommandOutput(o *cmd.Output) {
+	for _, m := range o.Messages() {
+		c.log.Info(m.String())
+	}
+	for _, err := range o.Errors() {
+		c.log.Error(err.Error())
+	}
+}
+
+// runConsole reads commands line by line from r and executes them in the default world until r
+// is closed.
+func runConsole(srv *server.Server, r io.Reader, log *slog.Logger) {
+	src := console{log: log}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "/")
+		if line == "" {
+			continue
+		}
+		name, args, _ := strings.Cut(line, " ")
+		command, ok := cmd.ByAlias(name)
+		if !ok {
+			log.Error("unknown command", "command", name)
+			continue
+		}
+		<-srv.World().Exec(func(tx *world.Tx) {
+			command.Execute(args, src, tx)
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 // Server.
 type listener struct {
 	*minecraft.Listener
+	// wrapConn, if set, wraps every accepted connection, e.g. to observe the packets of a player.
+	wrapConn func(conn *minecraft.Conn) session.Conn
 }
 
 // Accept blocks until the next connection is established and returns it. An error is returned if the Listener was
@@ -18,10 +20,16 @@ func (l listener) Accept() (session.Conn, error) {
 	if err != nil {
 		return nil, err
 	}
+	if l.wrapConn != nil {
+		return l.wrapConn(conn.(*minecraft.Conn)), nil
+	}
 	return conn.(session.Conn), err
 }
 
 // Disconnect disconnects a connection from the Listener with a reason.
 func (l listener) Disconnect(conn session.Conn, reason string) error {
+	if c, ok := conn.(interface{ Unwrap() *minecraft.Conn }); ok {
+		return l.Listener.Disconnect(c.Unwrap(), reason)
+	}
 	return l.Listener.Disconnect(conn.(*minecraft.Conn), reason)
 }
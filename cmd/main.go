@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/df-mc/dragonfly/server"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/player"
 	"github.com/df-mc/dragonfly/server/player/chat"
+	"github.com/df-mc/dragonfly/server/session"
 	"github.com/df-mc/dragonfly/server/world"
 	"github.com/didntpot/pregdk"
 	_ "github.com/joho/godotenv/autoload"
@@ -20,6 +23,7 @@ import (
 	"github.com/secmc/plugin/plugin/adapters/plugin"
 	pcfg "github.com/secmc/plugin/plugin/config"
 	"github.com/secmc/plugin/plugin/ports"
+	pb "github.com/secmc/plugin/proto/generated/go"
 )
 
 func main() {
@@ -30,11 +34,6 @@ func main() {
 	slog.SetLogLoggerLevel(logLevel)
 
 	chat.Global.Subscribe(chat.StdoutSubscriber{})
-	conf, err := readConfig(slog.Default())
-	if err != nil {
-		panic(err)
-	}
-
 	manager := plugin.NewManager(
 		nil,
 		slog.Default(),
@@ -44,7 +43,15 @@ func main() {
 		func(e ports.EventManager) world.Handler {
 			return handlers.NewWorldHandler(e)
 		},
+		func(e ports.EventManager, inv *inventory.Inventory, kind pb.InventoryType, container *cube.Pos) inventory.Handler {
+			return handlers.NewInventoryHandler(e, inv, kind, container)
+		},
 	)
+	conf, err := readConfig(slog.Default(), manager.WrapConn)
+	if err != nil {
+		panic(err)
+	}
+
 	cfgPlugins, err := pcfg.LoadConfig("plugins/plugins.yaml")
 	if err != nil {
 		log.Fatalf("failed loading plugin config: %v", err)
@@ -70,6 +77,7 @@ func main() {
 	defer manager.Close()
 
 	srv.Listen()
+	go runConsole(srv, os.Stdin, slog.Default())
 	for p := range srv.Accept() {
 		manager.AttachPlayer(p)
 	}
@@ -77,7 +85,7 @@ func main() {
 
 // readConfig reads the configuration from the config.toml file, or creates the
 // file if it does not yet exist.
-func readConfig(log *slog.Logger) (server.Config, error) {
+func readConfig(log *slog.Logger, wrapConn func(conn *minecraft.Conn) session.Conn) (server.Config, error) {
 	c := server.DefaultConfig()
 	var zero server.Config
 	if _, err := os.Stat("config.toml"); err != nil {
@@ -104,6 +112,6 @@ func readConfig(log *slog.Logger) (server.Config, error) {
 	listenerFunc(&cfg, c.Network.Address, []minecraft.Protocol{
 		pregdk.Protocol(false),
 		basicProtocol{Protocol: 860, Version: "1.21.124"},
-	})
+	}, wrapConn)
 	return cfg, nil
 }
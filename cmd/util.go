@@ -6,11 +6,12 @@ import (
 	"log/slog"
 
 	"github.com/df-mc/dragonfly/server"
+	"github.com/df-mc/dragonfly/server/session"
 	"github.com/sandertv/gophertunnel/minecraft"
 )
 
 // listenerFunc ...
-func listenerFunc(c *server.Config, addr string, protocols []minecraft.Protocol) {
+func listenerFunc(c *server.Config, addr string, protocols []minecraft.Protocol, wrapConn func(conn *minecraft.Conn) session.Conn) {
 	c.Listeners = []func(conf server.Config) (server.Listener, error){
 		func(conf server.Config) (server.Listener, error) {
 			cfg := minecraft.ListenConfig{
@@ -32,7 +33,7 @@ func listenerFunc(c *server.Config, addr string, protocols []minecraft.Protocol)
 				return nil, fmt.Errorf("create minecraft listener: %w", err)
 			}
 			conf.Log.Info("Listener running.", "addr", l.Addr())
-			return listener{l}, nil
+			return listener{Listener: l, wrapConn: wrapConn}, nil
 		},
 	}
 }
@@ -33,7 +33,13 @@ const client = new dfplugin.Plugin(
   grpc.credentials.createInsecure()
 );
 
-const call = client.EventStream();
+// The host rejects connections without the token it passed in DF_PLUGIN_TOKEN.
+const metadata = new grpc.Metadata();
+if (process.env.DF_PLUGIN_TOKEN) {
+  metadata.set('df-plugin-token', process.env.DF_PLUGIN_TOKEN);
+}
+
+const call = client.EventStream(metadata);
 
 console.log(`[node] connecting to ${serverAddress}...`);
 
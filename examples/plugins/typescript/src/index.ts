@@ -355,6 +355,12 @@ const client = new grpc.Client(
     grpc.credentials.createInsecure()
 );
 
+// The host rejects connections without the token it passed in DF_PLUGIN_TOKEN.
+const metadata = new grpc.Metadata();
+if (process.env.DF_PLUGIN_TOKEN) {
+    metadata.set('df-plugin-token', process.env.DF_PLUGIN_TOKEN);
+}
+
 // Create bidirectional stream
 const call = client.makeBidiStreamRequest<PluginToHost, HostToPlugin>(
     '/df.plugin.Plugin/EventStream',
@@ -364,7 +370,8 @@ const call = client.makeBidiStreamRequest<PluginToHost, HostToPlugin>(
     },
     (buf: Buffer) => {
         return HostToPlugin.decode(new Uint8Array(buf));
-    }
+    },
+    metadata
 ) as grpc.ClientDuplexStream<PluginToHost, HostToPlugin>;
 
 console.log(`[ts] connecting to ${serverAddress}...`);
@@ -321,7 +321,13 @@ abstract class PluginBase {
             $options['credentials'] = \call_user_func($factory);
         }
         $this->client = new PluginClient($this->serverAddress, $options);
-        $this->call = $this->client->EventStream();
+        // The host rejects connections without the token it passed in DF_PLUGIN_TOKEN.
+        $metadata = [];
+        $token = getenv('DF_PLUGIN_TOKEN') ?: '';
+        if ($token !== '') {
+            $metadata['df-plugin-token'] = [$token];
+        }
+        $this->call = $this->client->EventStream($metadata);
         $this->sender = new StreamSender($this->call, $this->pluginId);
         $this->server = new Server(new Actions($this->sender, $this->pluginId));
         $this->running = true;
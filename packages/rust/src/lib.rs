@@ -99,8 +99,14 @@ impl PluginRunner {
         };
         tx.send(hello_msg).await?;
 
-        let request_stream = ReceiverStream::new(rx);
-        let mut event_stream = raw_client.event_stream(request_stream).await?.into_inner();
+        let mut request = tonic::Request::new(ReceiverStream::new(rx));
+        // The host rejects connections without the token it passed in DF_PLUGIN_TOKEN.
+        if let Ok(token) = std::env::var("DF_PLUGIN_TOKEN") {
+            request
+                .metadata_mut()
+                .insert("df-plugin-token", token.parse()?);
+        }
+        let mut event_stream = raw_client.event_stream(request).await?.into_inner();
 
         let server = Server {
             plugin_id: plugin.get_id().to_owned(),
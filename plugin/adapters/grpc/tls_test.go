@@ -0,0 +1,240 @@
+package grpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// testPKI is a throwaway CA with a server and a client certificate, written to PEM files.
+type testPKI struct {
+	caFile, serverCertFile, serverKeyFile string
+	caPool                                *x509.CertPool
+	client                                tls.Certificate
+}
+
+func newTestPKI(t *testing.T, clientCN string, clientDNS ...string) testPKI {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey := newKey(t)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("create ca: %v", err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("parse ca: %v", err)
+	}
+
+	serverKey := newKey(t)
+	serverDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "dragonfly"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, &serverKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("create server certificate: %v", err)
+	}
+
+	clientKey := newKey(t)
+	clientDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: clientCN},
+		DNSNames:     clientDNS,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, &clientKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("create client certificate: %v", err)
+	}
+
+	pki := testPKI{
+		caFile:         filepath.Join(dir, "ca.pem"),
+		serverCertFile: filepath.Join(dir, "server.pem"),
+		serverKeyFile:  filepath.Join(dir, "server-key.pem"),
+		caPool:         x509.NewCertPool(),
+		client:         tls.Certificate{Certificate: [][]byte{clientDER}, PrivateKey: clientKey},
+	}
+	pki.caPool.AddCert(ca)
+	writePEM(t, pki.caFile, "CERTIFICATE", caDER)
+	writePEM(t, pki.serverCertFile, "CERTIFICATE", serverDER)
+	keyDER, err := x509.MarshalECPrivateKey(serverKey)
+	if err != nil {
+		t.Fatalf("marshal server key: %v", err)
+	}
+	writePEM(t, pki.serverKeyFile, "EC PRIVATE KEY", keyDER)
+	return pki
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return key
+}
+
+func writePEM(t *testing.T, path, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+}
+
+// startTLSServer starts a plugin server that reports the peer identities of the first message
+// of every stream on the returned channel.
+func startTLSServer(t *testing.T, tlsConfig *tls.Config) (*GrpcServer, <-chan []string) {
+	t.Helper()
+	identities := make(chan []string, 1)
+	srv, err := NewServer("127.0.0.1:0", tlsConfig, func(stream *GrpcStream) error {
+		if _, err := stream.Recv(); err != nil {
+			return err
+		}
+		identities <- stream.PeerIdentities()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("start server: %v", err)
+	}
+	go func() { _ = srv.Serve() }()
+	t.Cleanup(srv.Stop)
+	return srv, identities
+}
+
+// openStream sends one message over a new stream and waits for the server to end it.
+func openStream(t *testing.T, addr string, clientTLS *tls.Config) error {
+	t.Helper()
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(rawProtoCodec{})),
+	)
+	if err != nil {
+		t.Fatalf("create client: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cs, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}, "/df.plugin.Plugin/EventStream")
+	if err != nil {
+		return err
+	}
+	data := []byte{}
+	if err := cs.SendMsg(&data); err != nil {
+		return err
+	}
+	_ = cs.CloseSend()
+	var reply []byte
+	if err := cs.RecvMsg(&reply); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+func TestLoadTLSConfigRequiresKeyPair(t *testing.T) {
+	pki := newTestPKI(t, "test-plugin")
+	if _, err := LoadTLSConfig(pki.serverCertFile, "", ""); err == nil {
+		t.Fatal("expected an error without a key")
+	}
+	if _, err := LoadTLSConfig(pki.serverCertFile, pki.serverKeyFile, filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Fatal("expected an error for a missing client ca")
+	}
+}
+
+func TestMutualTLSPeerIdentities(t *testing.T) {
+	pki := newTestPKI(t, "test-plugin", "test-plugin.local")
+	tlsConfig, err := LoadTLSConfig(pki.serverCertFile, pki.serverKeyFile, pki.caFile)
+	if err != nil {
+		t.Fatalf("load tls config: %v", err)
+	}
+	srv, identities := startTLSServer(t, tlsConfig)
+
+	err = openStream(t, srv.Address(), &tls.Config{
+		RootCAs:      pki.caPool,
+		Certificates: []tls.Certificate{pki.client},
+	})
+	if err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	select {
+	case ids := <-identities:
+		if want := []string{"test-plugin", "test-plugin.local"}; !slices.Equal(ids, want) {
+			t.Fatalf("identities = %v, want %v", ids, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not receive the stream")
+	}
+}
+
+func TestMutualTLSRejectsClientWithoutCertificate(t *testing.T) {
+	pki := newTestPKI(t, "test-plugin")
+	tlsConfig, err := LoadTLSConfig(pki.serverCertFile, pki.serverKeyFile, pki.caFile)
+	if err != nil {
+		t.Fatalf("load tls config: %v", err)
+	}
+	srv, identities := startTLSServer(t, tlsConfig)
+
+	if err := openStream(t, srv.Address(), &tls.Config{RootCAs: pki.caPool}); err == nil {
+		t.Fatal("expected the stream to fail without a client certificate")
+	}
+	select {
+	case ids := <-identities:
+		t.Fatalf("handler was called with identities %v", ids)
+	default:
+	}
+}
+
+func TestTLSWithoutClientCAHasNoIdentities(t *testing.T) {
+	pki := newTestPKI(t, "test-plugin")
+	tlsConfig, err := LoadTLSConfig(pki.serverCertFile, pki.serverKeyFile, "")
+	if err != nil {
+		t.Fatalf("load tls config: %v", err)
+	}
+	srv, identities := startTLSServer(t, tlsConfig)
+
+	if err := openStream(t, srv.Address(), &tls.Config{RootCAs: pki.caPool}); err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	select {
+	case ids := <-identities:
+		if ids != nil {
+			t.Fatalf("identities = %v, want none", ids)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not receive the stream")
+	}
+}
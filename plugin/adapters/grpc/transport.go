@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -12,13 +14,20 @@ import (
 	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 // GrpcStream wraps a bidirectional stream for a connected plugin
 type GrpcStream struct {
 	stream grpc.ServerStream
+	ctx    context.Context
 	mu     sync.Mutex
+
+	closed    chan struct{}
+	closeOnce sync.Once
 }
 
 // GrpcServer manages the gRPC server that plugins connect to
@@ -67,11 +76,12 @@ func (s *pluginService) EventStream(stream grpc.ServerStream) error {
 	if s.handler == nil {
 		return errors.New("no handler registered")
 	}
-	return s.handler(&GrpcStream{stream: stream})
+	return s.handler(&GrpcStream{stream: stream, ctx: stream.Context(), closed: make(chan struct{})})
 }
 
-// NewServer creates a new gRPC server that plugins will connect to
-func NewServer(address string, handler StreamHandler) (*GrpcServer, error) {
+// NewServer creates a new gRPC server that plugins will connect to. Connections use TLS when
+// tlsConfig is non-nil and are unencrypted otherwise.
+func NewServer(address string, tlsConfig *tls.Config, handler StreamHandler) (*GrpcServer, error) {
 	network := "tcp"
 	addr := address
 	if strings.Contains(address, "://") {
@@ -113,9 +123,13 @@ func NewServer(address string, handler StreamHandler) (*GrpcServer, error) {
 		_ = os.Chmod(addr, 0666)
 	}
 
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
 	server := grpc.NewServer(
 		grpc.ForceServerCodec(rawProtoCodec{}),
-		grpc.Creds(insecure.NewCredentials()),
+		grpc.Creds(creds),
 	)
 
 	service := &pluginService{handler: handler}
@@ -177,8 +191,14 @@ func (s *GrpcStream) Send(data []byte) error {
 }
 
 func (s *GrpcStream) Recv() ([]byte, error) {
+	s.mu.Lock()
+	stream := s.stream
+	s.mu.Unlock()
+	if stream == nil {
+		return nil, errors.New("stream closed")
+	}
 	var data []byte
-	if err := s.stream.RecvMsg(&data); err != nil {
+	if err := stream.RecvMsg(&data); err != nil {
 		if errors.Is(err, io.EOF) {
 			return nil, err
 		}
@@ -187,9 +207,36 @@ func (s *GrpcStream) Recv() ([]byte, error) {
 	return data, nil
 }
 
+// Metadata returns the first value of the incoming gRPC metadata key, or "" if it was not sent.
+func (s *GrpcStream) Metadata(key string) string {
+	md, ok := metadata.FromIncomingContext(s.ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// PeerAddress returns the remote address of the connection, or "" if it is unknown.
+func (s *GrpcStream) PeerAddress() string {
+	if p, ok := peer.FromContext(s.ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
 func (s *GrpcStream) Close() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.stream = nil
+	s.closeOnce.Do(func() { close(s.closed) })
 	return nil
 }
+
+// Done is closed once the host closed the stream. The stream handler should return then, which
+// ends the RPC for the plugin.
+func (s *GrpcStream) Done() <-chan struct{} {
+	return s.closed
+}
@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/secmc/plugin/plugin/ports"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+var _ inventory.Handler = (*InventoryHandler)(nil)
+
+// InventoryHandler handles the slot changes players make in a single inventory: one of their own or
+// the inventory of a container block.
+type InventoryHandler struct {
+	manager   ports.EventManager
+	inv       *inventory.Inventory
+	kind      pb.InventoryType
+	container *cube.Pos
+}
+
+func NewInventoryHandler(manager ports.EventManager, inv *inventory.Inventory, kind pb.InventoryType, container *cube.Pos) inventory.Handler {
+	return &InventoryHandler{manager: manager, inv: inv, kind: kind, container: container}
+}
+
+func (h *InventoryHandler) HandleTake(ctx *inventory.Context, slot int, it item.Stack) {
+	before, _ := h.inv.Item(slot)
+	h.emit(ctx, slot, pb.InventorySlotAction_INVENTORY_SLOT_ACTION_TAKE, it, before, before.Grow(-it.Count()))
+}
+
+func (h *InventoryHandler) HandlePlace(ctx *inventory.Context, slot int, it item.Stack) {
+	before, _ := h.inv.Item(slot)
+	after := it
+	if !before.Empty() && before.Comparable(it) {
+		after = before.Grow(it.Count())
+	}
+	h.emit(ctx, slot, pb.InventorySlotAction_INVENTORY_SLOT_ACTION_PLACE, it, before, after)
+}
+
+func (h *InventoryHandler) HandleDrop(ctx *inventory.Context, slot int, it item.Stack) {
+	before, _ := h.inv.Item(slot)
+	h.emit(ctx, slot, pb.InventorySlotAction_INVENTORY_SLOT_ACTION_DROP, it, before, before.Grow(-it.Count()))
+}
+
+func (h *InventoryHandler) emit(ctx *inventory.Context, slot int, action pb.InventorySlotAction, it, before, after item.Stack) {
+	p, ok := ctx.Val().(*player.Player)
+	if !ok {
+		return
+	}
+	h.manager.EmitPlayerInventorySlotChange(ctx, p, h.kind, h.container, slot, action, it, before, after)
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/secmc/plugin/plugin/ports"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// slotChange is an EmitPlayerInventorySlotChange call.
+type slotChange struct {
+	kind                pb.InventoryType
+	container           *cube.Pos
+	slot                int
+	action              pb.InventorySlotAction
+	item, before, after item.Stack
+}
+
+// slotRecorder records the slot changes emitted to it. Other events are not expected.
+type slotRecorder struct {
+	ports.EventManager
+	changes []slotChange
+}
+
+func (r *slotRecorder) EmitPlayerInventorySlotChange(_ *inventory.Context, _ *player.Player, kind pb.InventoryType, container *cube.Pos, slot int, action pb.InventorySlotAction, it, before, after item.Stack) {
+	r.changes = append(r.changes, slotChange{kind, container, slot, action, it, before, after})
+}
+
+func TestInventoryHandlerSlotChanges(t *testing.T) {
+	diamonds := func(n int) item.Stack { return item.NewStack(item.Diamond{}, n) }
+	emeralds := func(n int) item.Stack { return item.NewStack(item.Emerald{}, n) }
+	pos := cube.Pos{1, 64, 2}
+
+	tests := []struct {
+		name      string
+		slot      item.Stack
+		container *cube.Pos
+		handle    func(h inventory.Handler, ctx *inventory.Context)
+		want      slotChange
+	}{
+		{
+			name: "take part of a stack",
+			slot: diamonds(10),
+			handle: func(h inventory.Handler, ctx *inventory.Context) {
+				h.HandleTake(ctx, 0, diamonds(4))
+			},
+			want: slotChange{action: pb.InventorySlotAction_INVENTORY_SLOT_ACTION_TAKE, item: diamonds(4), before: diamonds(10), after: diamonds(6)},
+		},
+		{
+			name: "place on an empty slot",
+			handle: func(h inventory.Handler, ctx *inventory.Context) {
+				h.HandlePlace(ctx, 0, diamonds(3))
+			},
+			want: slotChange{action: pb.InventorySlotAction_INVENTORY_SLOT_ACTION_PLACE, item: diamonds(3), after: diamonds(3)},
+		},
+		{
+			name: "place onto the same item",
+			slot: diamonds(5),
+			handle: func(h inventory.Handler, ctx *inventory.Context) {
+				h.HandlePlace(ctx, 0, diamonds(3))
+			},
+			want: slotChange{action: pb.InventorySlotAction_INVENTORY_SLOT_ACTION_PLACE, item: diamonds(3), before: diamonds(5), after: diamonds(8)},
+		},
+		{
+			name: "place onto another item",
+			slot: emeralds(5),
+			handle: func(h inventory.Handler, ctx *inventory.Context) {
+				h.HandlePlace(ctx, 0, diamonds(3))
+			},
+			want: slotChange{action: pb.InventorySlotAction_INVENTORY_SLOT_ACTION_PLACE, item: diamonds(3), before: emeralds(5), after: diamonds(3)},
+		},
+		{
+			name:      "drop from a container",
+			slot:      emeralds(2),
+			container: &pos,
+			handle: func(h inventory.Handler, ctx *inventory.Context) {
+				h.HandleDrop(ctx, 0, emeralds(2))
+			},
+			want: slotChange{container: &pos, action: pb.InventorySlotAction_INVENTORY_SLOT_ACTION_DROP, item: emeralds(2), before: emeralds(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := inventory.New(1, nil)
+			if !tt.slot.Empty() {
+				_ = inv.SetItem(0, tt.slot)
+			}
+			kind := pb.InventoryType_INVENTORY_TYPE_PLAYER
+			if tt.container != nil {
+				kind = pb.InventoryType_INVENTORY_TYPE_CONTAINER
+			}
+			rec := &slotRecorder{}
+			h := NewInventoryHandler(rec, inv, kind, tt.container)
+			tt.handle(h, event.C[inventory.Holder](&player.Player{}))
+
+			if len(rec.changes) != 1 {
+				t.Fatalf("emitted %d slot changes, want 1", len(rec.changes))
+			}
+			got, want := rec.changes[0], tt.want
+			want.kind = kind
+			if got.kind != want.kind || got.container != want.container || got.slot != want.slot || got.action != want.action {
+				t.Errorf("emitted %v %v slot %d %v, want %v %v slot %d %v", got.kind, got.container, got.slot, got.action, want.kind, want.container, want.slot, want.action)
+			}
+			for _, s := range []struct {
+				name      string
+				got, want item.Stack
+			}{{"item", got.item, want.item}, {"before", got.before, want.before}, {"after", got.after, want.after}} {
+				if !s.got.Equal(s.want) {
+					t.Errorf("%s = %v, want %v", s.name, s.got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestInventoryHandlerIgnoresNonPlayers(t *testing.T) {
+	rec := &slotRecorder{}
+	h := NewInventoryHandler(rec, inventory.New(1, nil), pb.InventoryType_INVENTORY_TYPE_CONTAINER, &cube.Pos{})
+	h.HandlePlace(event.C[inventory.Holder](nil), 0, item.NewStack(item.Diamond{}, 1))
+	if len(rec.changes) != 0 {
+		t.Errorf("emitted %d slot changes for a change without a player, want none", len(rec.changes))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/cmd"
 	"github.com/df-mc/dragonfly/server/item"
@@ -116,11 +117,16 @@ func (h *PlayerHandler) HandleItemUseOnBlock(ctx *player.Context, pos cube.Pos,
 	if p == nil {
 		return
 	}
-	var block world.Block
+	var b world.Block
 	if tx := p.Tx(); tx != nil {
-		block = tx.Block(pos)
+		b = tx.Block(pos)
 	}
-	h.manager.EmitPlayerItemUseOnBlock(ctx, p, pos, face, clickPos, block)
+	if opensContainer(p, b) {
+		if h.manager.EmitContainerOpen(ctx, p, pos, b); ctx.Cancelled() {
+			return
+		}
+	}
+	h.manager.EmitPlayerItemUseOnBlock(ctx, p, pos, face, clickPos, b)
 }
 
 func (h *PlayerHandler) HandleItemUseOnEntity(ctx *player.Context, e world.Entity) {
@@ -178,3 +184,15 @@ func (h *PlayerHandler) HandleTransfer(ctx *player.Context, addr *net.UDPAddr) {
 func (h *PlayerHandler) HandleDiagnostics(p *player.Player, d session.Diagnostics) {
 	h.manager.EmitPlayerDiagnostics(p, d)
 }
+
+// opensContainer reports whether a player using an item on b opens it as a container. Like
+// Dragonfly, a sneaking player only opens it with an empty hand.
+func opensContainer(p *player.Player, b world.Block) bool {
+	switch b.(type) {
+	case block.Container, block.EnderChest:
+	default:
+		return false
+	}
+	held, _ := p.HeldItems()
+	return !p.Sneaking() || held.Empty()
+}
@@ -1,10 +1,13 @@
 package plugin
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
+	"github.com/df-mc/dragonfly/server/block"
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/entity"
 	"github.com/df-mc/dragonfly/server/entity/effect"
@@ -29,259 +32,299 @@ func (m *Manager) applyActions(p *pluginProcess, batch *pb.ActionBatch) {
 	if batch == nil {
 		return
 	}
+	if batch.Atomic {
+		m.applyAtomicBatch(p, batch)
+		return
+	}
 	for _, action := range batch.Actions {
 		if action == nil {
 			continue
 		}
 		correlationID := action.GetCorrelationId()
-		switch kind := action.Kind.(type) {
-		case *pb.Action_SendChat:
-			m.handleSendChat(kind.SendChat)
-		case *pb.Action_Teleport:
-			m.handleTeleport(kind.Teleport)
-		case *pb.Action_Kick:
-			m.handleKick(kind.Kick)
-		case *pb.Action_SetGameMode:
-			m.handleSetGameMode(kind.SetGameMode)
-		case *pb.Action_GiveItem:
-			m.handleGiveItem(kind.GiveItem)
-		case *pb.Action_ClearInventory:
-			m.handleClearInventory(kind.ClearInventory)
-		case *pb.Action_SetHeldItem:
-			m.handleSetHeldItem(kind.SetHeldItem)
-		case *pb.Action_PlayerSetArmour:
-			m.handlePlayerSetArmour(kind.PlayerSetArmour)
-		case *pb.Action_SetHealth:
-			m.handleSetHealth(kind.SetHealth)
-		case *pb.Action_SetFood:
-			m.handleSetFood(kind.SetFood)
-		case *pb.Action_SetExperience:
-			m.handleSetExperience(kind.SetExperience)
-		case *pb.Action_SetVelocity:
-			m.handleSetVelocity(kind.SetVelocity)
-		case *pb.Action_AddEffect:
-			m.handleAddEffect(kind.AddEffect)
-		case *pb.Action_RemoveEffect:
-			m.handleRemoveEffect(kind.RemoveEffect)
-		case *pb.Action_SendTitle:
-			m.handleSendTitle(kind.SendTitle)
-		case *pb.Action_SendPopup:
-			m.handleSendPopup(kind.SendPopup)
-		case *pb.Action_SendTip:
-			m.handleSendTip(kind.SendTip)
-		case *pb.Action_PlaySound:
-			m.handlePlaySound(kind.PlaySound)
-		case *pb.Action_ExecuteCommand:
-			m.handleExecuteCommand(kind.ExecuteCommand)
-		case *pb.Action_WorldSetDefaultGameMode:
-			m.handleWorldSetDefaultGameMode(p, correlationID, kind.WorldSetDefaultGameMode)
-		case *pb.Action_WorldSetDifficulty:
-			m.handleWorldSetDifficulty(p, correlationID, kind.WorldSetDifficulty)
-		case *pb.Action_WorldSetTickRange:
-			m.handleWorldSetTickRange(p, correlationID, kind.WorldSetTickRange)
-		case *pb.Action_WorldSetBlock:
-			m.handleWorldSetBlock(p, correlationID, kind.WorldSetBlock)
-		case *pb.Action_WorldPlaySound:
-			m.handleWorldPlaySound(p, correlationID, kind.WorldPlaySound)
-		case *pb.Action_WorldAddParticle:
-			m.handleWorldAddParticle(p, correlationID, kind.WorldAddParticle)
-		case *pb.Action_WorldSetTime:
-			m.handleWorldSetTime(p, correlationID, kind.WorldSetTime)
-		case *pb.Action_WorldStopTime:
-			m.handleWorldStopTime(p, correlationID, kind.WorldStopTime)
-		case *pb.Action_WorldStartTime:
-			m.handleWorldStartTime(p, correlationID, kind.WorldStartTime)
-		case *pb.Action_WorldSetSpawn:
-			m.handleWorldSetSpawn(p, correlationID, kind.WorldSetSpawn)
-		case *pb.Action_WorldQueryEntities:
-			m.handleWorldQueryEntities(p, correlationID, kind.WorldQueryEntities)
-		case *pb.Action_WorldQueryPlayers:
-			m.handleWorldQueryPlayers(p, correlationID, kind.WorldQueryPlayers)
-		case *pb.Action_WorldQueryEntitiesWithin:
-			m.handleWorldQueryEntitiesWithin(p, correlationID, kind.WorldQueryEntitiesWithin)
-		case *pb.Action_WorldQueryDefaultGameMode:
-			m.handleWorldQueryDefaultGameMode(p, correlationID, kind.WorldQueryDefaultGameMode)
-		case *pb.Action_WorldQueryPlayerSpawn:
-			m.handleWorldQueryPlayerSpawn(p, correlationID, kind.WorldQueryPlayerSpawn)
-		case *pb.Action_WorldQueryBlock:
-			m.handleWorldQueryBlock(p, correlationID, kind.WorldQueryBlock)
-		case *pb.Action_WorldQueryBiome:
-			m.handleWorldQueryBiome(p, correlationID, kind.WorldQueryBiome)
-		case *pb.Action_WorldQueryLight:
-			m.handleWorldQueryLight(p, correlationID, kind.WorldQueryLight)
-		case *pb.Action_WorldQuerySkyLight:
-			m.handleWorldQuerySkyLight(p, correlationID, kind.WorldQuerySkyLight)
-		case *pb.Action_WorldQueryTemperature:
-			m.handleWorldQueryTemperature(p, correlationID, kind.WorldQueryTemperature)
-		case *pb.Action_WorldQueryHighestBlock:
-			m.handleWorldQueryHighestBlock(p, correlationID, kind.WorldQueryHighestBlock)
-		case *pb.Action_WorldQueryRainingAt:
-			m.handleWorldQueryRainingAt(p, correlationID, kind.WorldQueryRainingAt)
-		case *pb.Action_WorldQuerySnowingAt:
-			m.handleWorldQuerySnowingAt(p, correlationID, kind.WorldQuerySnowingAt)
-		case *pb.Action_WorldQueryThunderingAt:
-			m.handleWorldQueryThunderingAt(p, correlationID, kind.WorldQueryThunderingAt)
-		case *pb.Action_WorldQueryLiquid:
-			m.handleWorldQueryLiquid(p, correlationID, kind.WorldQueryLiquid)
-		case *pb.Action_WorldSetBiome:
-			m.handleWorldSetBiome(p, correlationID, kind.WorldSetBiome)
-		case *pb.Action_WorldSetLiquid:
-			m.handleWorldSetLiquid(p, correlationID, kind.WorldSetLiquid)
-		case *pb.Action_WorldScheduleBlockUpdate:
-			m.handleWorldScheduleBlockUpdate(p, correlationID, kind.WorldScheduleBlockUpdate)
-		case *pb.Action_WorldBuildStructure:
-			m.handleWorldBuildStructure(p, correlationID, kind.WorldBuildStructure)
-		case *pb.Action_PlayerStartSprinting:
-			m.handlePlayerStartSprinting(kind.PlayerStartSprinting)
-		case *pb.Action_PlayerStopSprinting:
-			m.handlePlayerStopSprinting(kind.PlayerStopSprinting)
-		case *pb.Action_PlayerStartSneaking:
-			m.handlePlayerStartSneaking(kind.PlayerStartSneaking)
-		case *pb.Action_PlayerStopSneaking:
-			m.handlePlayerStopSneaking(kind.PlayerStopSneaking)
-		case *pb.Action_PlayerStartSwimming:
-			m.handlePlayerStartSwimming(kind.PlayerStartSwimming)
-		case *pb.Action_PlayerStopSwimming:
-			m.handlePlayerStopSwimming(kind.PlayerStopSwimming)
-		case *pb.Action_PlayerStartCrawling:
-			m.handlePlayerStartCrawling(kind.PlayerStartCrawling)
-		case *pb.Action_PlayerStopCrawling:
-			m.handlePlayerStopCrawling(kind.PlayerStopCrawling)
-		case *pb.Action_PlayerStartGliding:
-			m.handlePlayerStartGliding(kind.PlayerStartGliding)
-		case *pb.Action_PlayerStopGliding:
-			m.handlePlayerStopGliding(kind.PlayerStopGliding)
-		case *pb.Action_PlayerStartFlying:
-			m.handlePlayerStartFlying(kind.PlayerStartFlying)
-		case *pb.Action_PlayerStopFlying:
-			m.handlePlayerStopFlying(kind.PlayerStopFlying)
-		case *pb.Action_PlayerSetImmobile:
-			m.handlePlayerSetImmobile(kind.PlayerSetImmobile)
-		case *pb.Action_PlayerSetMobile:
-			m.handlePlayerSetMobile(kind.PlayerSetMobile)
-		case *pb.Action_PlayerSetSpeed:
-			m.handlePlayerSetSpeed(kind.PlayerSetSpeed)
-		case *pb.Action_PlayerSetFlightSpeed:
-			m.handlePlayerSetFlightSpeed(kind.PlayerSetFlightSpeed)
-		case *pb.Action_PlayerSetVerticalFlightSpeed:
-			m.handlePlayerSetVerticalFlightSpeed(kind.PlayerSetVerticalFlightSpeed)
-		case *pb.Action_PlayerSetAbsorption:
-			m.handlePlayerSetAbsorption(kind.PlayerSetAbsorption)
-		case *pb.Action_PlayerSetOnFire:
-			m.handlePlayerSetOnFire(kind.PlayerSetOnFire)
-		case *pb.Action_PlayerExtinguish:
-			m.handlePlayerExtinguish(kind.PlayerExtinguish)
-		case *pb.Action_PlayerSetInvisible:
-			m.handlePlayerSetInvisible(kind.PlayerSetInvisible)
-		case *pb.Action_PlayerSetVisible:
-			m.handlePlayerSetVisible(kind.PlayerSetVisible)
-		case *pb.Action_PlayerSetScale:
-			m.handlePlayerSetScale(kind.PlayerSetScale)
-		case *pb.Action_PlayerSetHeldSlot:
-			m.handlePlayerSetHeldSlot(kind.PlayerSetHeldSlot)
-		case *pb.Action_PlayerSendToast:
-			m.handlePlayerSendToast(kind.PlayerSendToast)
-		case *pb.Action_PlayerSendJukeboxPopup:
-			m.handlePlayerSendJukeboxPopup(kind.PlayerSendJukeboxPopup)
-		case *pb.Action_PlayerShowCoordinates:
-			m.handlePlayerShowCoordinates(kind.PlayerShowCoordinates)
-		case *pb.Action_PlayerHideCoordinates:
-			m.handlePlayerHideCoordinates(kind.PlayerHideCoordinates)
-		case *pb.Action_PlayerEnableInstantRespawn:
-			m.handlePlayerEnableInstantRespawn(kind.PlayerEnableInstantRespawn)
-		case *pb.Action_PlayerDisableInstantRespawn:
-			m.handlePlayerDisableInstantRespawn(kind.PlayerDisableInstantRespawn)
-		case *pb.Action_PlayerSetNameTag:
-			m.handlePlayerSetNameTag(kind.PlayerSetNameTag)
-		case *pb.Action_PlayerSetScoreTag:
-			m.handlePlayerSetScoreTag(kind.PlayerSetScoreTag)
-		case *pb.Action_PlayerShowParticle:
-			m.handlePlayerShowParticle(kind.PlayerShowParticle)
-		case *pb.Action_PlayerSendScoreboard:
-			m.handlePlayerSendScoreboard(kind.PlayerSendScoreboard)
-		case *pb.Action_PlayerRemoveScoreboard:
-			m.handlePlayerRemoveScoreboard(kind.PlayerRemoveScoreboard)
-		case *pb.Action_PlayerSendMenuForm:
-			m.handlePlayerSendMenuForm(kind.PlayerSendMenuForm)
-		case *pb.Action_PlayerSendModalForm:
-			m.handlePlayerSendModalForm(kind.PlayerSendModalForm)
-		case *pb.Action_PlayerSendDialogue:
-			m.handlePlayerSendDialogue(p, correlationID, kind.PlayerSendDialogue)
-		case *pb.Action_PlayerRespawn:
-			m.handlePlayerRespawn(kind.PlayerRespawn)
-		case *pb.Action_PlayerTransfer:
-			m.handlePlayerTransferAction(kind.PlayerTransfer)
-		case *pb.Action_PlayerKnockBack:
-			m.handlePlayerKnockBack(kind.PlayerKnockBack)
-		case *pb.Action_PlayerSwingArm:
-			m.handlePlayerSwingArm(kind.PlayerSwingArm)
-		case *pb.Action_PlayerPunchAir:
-			m.handlePlayerPunchAirAction(kind.PlayerPunchAir)
-		case *pb.Action_PlayerSendBossBar:
-			m.handlePlayerSendBossBar(kind.PlayerSendBossBar)
-		case *pb.Action_PlayerRemoveBossBar:
-			m.handlePlayerRemoveBossBar(kind.PlayerRemoveBossBar)
-		case *pb.Action_PlayerShowHudElement:
-			m.handlePlayerShowHudElement(kind.PlayerShowHudElement)
-		case *pb.Action_PlayerHideHudElement:
-			m.handlePlayerHideHudElement(kind.PlayerHideHudElement)
-		case *pb.Action_PlayerCloseDialogue:
-			m.handlePlayerCloseDialogue(kind.PlayerCloseDialogue)
-		case *pb.Action_PlayerCloseForm:
-			m.handlePlayerCloseForm(kind.PlayerCloseForm)
-		case *pb.Action_PlayerOpenSign:
-			m.handlePlayerOpenSign(kind.PlayerOpenSign)
-		case *pb.Action_PlayerEditSign:
-			m.handlePlayerEditSign(kind.PlayerEditSign)
-		case *pb.Action_PlayerTurnLecternPage:
-			m.handlePlayerTurnLecternPage(kind.PlayerTurnLecternPage)
-		case *pb.Action_PlayerHidePlayer:
-			m.handlePlayerHidePlayer(kind.PlayerHidePlayer)
-		case *pb.Action_PlayerShowPlayer:
-			m.handlePlayerShowPlayer(kind.PlayerShowPlayer)
-		case *pb.Action_PlayerRemoveAllDebugShapes:
-			m.handlePlayerRemoveAllDebugShapes(kind.PlayerRemoveAllDebugShapes)
-		case *pb.Action_PlayerOpenBlockContainer:
-			m.handlePlayerOpenBlockContainer(kind.PlayerOpenBlockContainer)
-		case *pb.Action_PlayerDropItem:
-			m.handlePlayerDropItem(kind.PlayerDropItem)
-		case *pb.Action_PlayerSetItemCooldown:
-			m.handlePlayerSetItemCooldown(kind.PlayerSetItemCooldown)
+		if ok, reason := p.permissions.check(actionFamily(action)); !ok {
+			p.log.Warn("rejected action", "reason", reason, "correlation_id", correlationID)
+			m.sendActionError(p, correlationID, reason)
+			continue
 		}
-	}
-}
-
-func (m *Manager) handleSendChat(act *pb.SendChatAction) {
+		m.applyAction(p, action)
+	}
+}
+
+// applyAction runs a single action. Permissions must have been checked by the caller.
+func (m *Manager) applyAction(p *pluginProcess, action *pb.Action) {
+	correlationID := action.GetCorrelationId()
+	switch kind := action.Kind.(type) {
+	case *pb.Action_SendChat:
+		m.handleSendChat(p, correlationID, kind.SendChat)
+	case *pb.Action_Teleport:
+		m.handleTeleport(p, correlationID, kind.Teleport)
+	case *pb.Action_Kick:
+		m.handleKick(p, correlationID, kind.Kick)
+	case *pb.Action_SetGameMode:
+		m.handleSetGameMode(p, correlationID, kind.SetGameMode)
+	case *pb.Action_GiveItem:
+		m.handleGiveItem(p, correlationID, kind.GiveItem)
+	case *pb.Action_ClearInventory:
+		m.handleClearInventory(p, correlationID, kind.ClearInventory)
+	case *pb.Action_SetHeldItem:
+		m.handleSetHeldItem(p, correlationID, kind.SetHeldItem)
+	case *pb.Action_PlayerSetArmour:
+		m.handlePlayerSetArmour(p, correlationID, kind.PlayerSetArmour)
+	case *pb.Action_SetHealth:
+		m.handleSetHealth(p, correlationID, kind.SetHealth)
+	case *pb.Action_SetFood:
+		m.handleSetFood(p, correlationID, kind.SetFood)
+	case *pb.Action_SetExperience:
+		m.handleSetExperience(p, correlationID, kind.SetExperience)
+	case *pb.Action_SetVelocity:
+		m.handleSetVelocity(p, correlationID, kind.SetVelocity)
+	case *pb.Action_AddEffect:
+		m.handleAddEffect(p, correlationID, kind.AddEffect)
+	case *pb.Action_RemoveEffect:
+		m.handleRemoveEffect(p, correlationID, kind.RemoveEffect)
+	case *pb.Action_SendTitle:
+		m.handleSendTitle(p, correlationID, kind.SendTitle)
+	case *pb.Action_SendPopup:
+		m.handleSendPopup(p, correlationID, kind.SendPopup)
+	case *pb.Action_SendTip:
+		m.handleSendTip(p, correlationID, kind.SendTip)
+	case *pb.Action_PlaySound:
+		m.handlePlaySound(p, correlationID, kind.PlaySound)
+	case *pb.Action_ExecuteCommand:
+		m.handleExecuteCommand(p, correlationID, kind.ExecuteCommand)
+	case *pb.Action_WorldSetDefaultGameMode:
+		m.handleWorldSetDefaultGameMode(p, correlationID, kind.WorldSetDefaultGameMode)
+	case *pb.Action_WorldSetDifficulty:
+		m.handleWorldSetDifficulty(p, correlationID, kind.WorldSetDifficulty)
+	case *pb.Action_WorldSetTickRange:
+		m.handleWorldSetTickRange(p, correlationID, kind.WorldSetTickRange)
+	case *pb.Action_WorldSetBlock:
+		m.handleWorldSetBlock(p, correlationID, kind.WorldSetBlock)
+	case *pb.Action_WorldPlaySound:
+		m.handleWorldPlaySound(p, correlationID, kind.WorldPlaySound)
+	case *pb.Action_WorldAddParticle:
+		m.handleWorldAddParticle(p, correlationID, kind.WorldAddParticle)
+	case *pb.Action_WorldSetTime:
+		m.handleWorldSetTime(p, correlationID, kind.WorldSetTime)
+	case *pb.Action_WorldStopTime:
+		m.handleWorldStopTime(p, correlationID, kind.WorldStopTime)
+	case *pb.Action_WorldStartTime:
+		m.handleWorldStartTime(p, correlationID, kind.WorldStartTime)
+	case *pb.Action_WorldSetSpawn:
+		m.handleWorldSetSpawn(p, correlationID, kind.WorldSetSpawn)
+	case *pb.Action_WorldQueryEntities:
+		m.handleWorldQueryEntities(p, correlationID, kind.WorldQueryEntities)
+	case *pb.Action_WorldQueryPlayers:
+		m.handleWorldQueryPlayers(p, correlationID, kind.WorldQueryPlayers)
+	case *pb.Action_WorldQueryEntitiesWithin:
+		m.handleWorldQueryEntitiesWithin(p, correlationID, kind.WorldQueryEntitiesWithin)
+	case *pb.Action_WorldQueryDefaultGameMode:
+		m.handleWorldQueryDefaultGameMode(p, correlationID, kind.WorldQueryDefaultGameMode)
+	case *pb.Action_WorldQueryPlayerSpawn:
+		m.handleWorldQueryPlayerSpawn(p, correlationID, kind.WorldQueryPlayerSpawn)
+	case *pb.Action_WorldQueryBlock:
+		m.handleWorldQueryBlock(p, correlationID, kind.WorldQueryBlock)
+	case *pb.Action_WorldQueryBiome:
+		m.handleWorldQueryBiome(p, correlationID, kind.WorldQueryBiome)
+	case *pb.Action_WorldQueryLight:
+		m.handleWorldQueryLight(p, correlationID, kind.WorldQueryLight)
+	case *pb.Action_WorldQuerySkyLight:
+		m.handleWorldQuerySkyLight(p, correlationID, kind.WorldQuerySkyLight)
+	case *pb.Action_WorldQueryTemperature:
+		m.handleWorldQueryTemperature(p, correlationID, kind.WorldQueryTemperature)
+	case *pb.Action_WorldQueryHighestBlock:
+		m.handleWorldQueryHighestBlock(p, correlationID, kind.WorldQueryHighestBlock)
+	case *pb.Action_WorldQueryRainingAt:
+		m.handleWorldQueryRainingAt(p, correlationID, kind.WorldQueryRainingAt)
+	case *pb.Action_WorldQuerySnowingAt:
+		m.handleWorldQuerySnowingAt(p, correlationID, kind.WorldQuerySnowingAt)
+	case *pb.Action_WorldQueryThunderingAt:
+		m.handleWorldQueryThunderingAt(p, correlationID, kind.WorldQueryThunderingAt)
+	case *pb.Action_WorldQueryLiquid:
+		m.handleWorldQueryLiquid(p, correlationID, kind.WorldQueryLiquid)
+	case *pb.Action_WorldSetBiome:
+		m.handleWorldSetBiome(p, correlationID, kind.WorldSetBiome)
+	case *pb.Action_WorldSetLiquid:
+		m.handleWorldSetLiquid(p, correlationID, kind.WorldSetLiquid)
+	case *pb.Action_WorldScheduleBlockUpdate:
+		m.handleWorldScheduleBlockUpdate(p, correlationID, kind.WorldScheduleBlockUpdate)
+	case *pb.Action_WorldBuildStructure:
+		m.handleWorldBuildStructure(p, correlationID, kind.WorldBuildStructure)
+	case *pb.Action_PlayerStartSprinting:
+		m.handlePlayerStartSprinting(p, correlationID, kind.PlayerStartSprinting)
+	case *pb.Action_PlayerStopSprinting:
+		m.handlePlayerStopSprinting(p, correlationID, kind.PlayerStopSprinting)
+	case *pb.Action_PlayerStartSneaking:
+		m.handlePlayerStartSneaking(p, correlationID, kind.PlayerStartSneaking)
+	case *pb.Action_PlayerStopSneaking:
+		m.handlePlayerStopSneaking(p, correlationID, kind.PlayerStopSneaking)
+	case *pb.Action_PlayerStartSwimming:
+		m.handlePlayerStartSwimming(p, correlationID, kind.PlayerStartSwimming)
+	case *pb.Action_PlayerStopSwimming:
+		m.handlePlayerStopSwimming(p, correlationID, kind.PlayerStopSwimming)
+	case *pb.Action_PlayerStartCrawling:
+		m.handlePlayerStartCrawling(p, correlationID, kind.PlayerStartCrawling)
+	case *pb.Action_PlayerStopCrawling:
+		m.handlePlayerStopCrawling(p, correlationID, kind.PlayerStopCrawling)
+	case *pb.Action_PlayerStartGliding:
+		m.handlePlayerStartGliding(p, correlationID, kind.PlayerStartGliding)
+	case *pb.Action_PlayerStopGliding:
+		m.handlePlayerStopGliding(p, correlationID, kind.PlayerStopGliding)
+	case *pb.Action_PlayerStartFlying:
+		m.handlePlayerStartFlying(p, correlationID, kind.PlayerStartFlying)
+	case *pb.Action_PlayerStopFlying:
+		m.handlePlayerStopFlying(p, correlationID, kind.PlayerStopFlying)
+	case *pb.Action_PlayerSetImmobile:
+		m.handlePlayerSetImmobile(p, correlationID, kind.PlayerSetImmobile)
+	case *pb.Action_PlayerSetMobile:
+		m.handlePlayerSetMobile(p, correlationID, kind.PlayerSetMobile)
+	case *pb.Action_PlayerSetSpeed:
+		m.handlePlayerSetSpeed(p, correlationID, kind.PlayerSetSpeed)
+	case *pb.Action_PlayerSetFlightSpeed:
+		m.handlePlayerSetFlightSpeed(p, correlationID, kind.PlayerSetFlightSpeed)
+	case *pb.Action_PlayerSetVerticalFlightSpeed:
+		m.handlePlayerSetVerticalFlightSpeed(p, correlationID, kind.PlayerSetVerticalFlightSpeed)
+	case *pb.Action_PlayerSetAbsorption:
+		m.handlePlayerSetAbsorption(p, correlationID, kind.PlayerSetAbsorption)
+	case *pb.Action_PlayerSetOnFire:
+		m.handlePlayerSetOnFire(p, correlationID, kind.PlayerSetOnFire)
+	case *pb.Action_PlayerExtinguish:
+		m.handlePlayerExtinguish(p, correlationID, kind.PlayerExtinguish)
+	case *pb.Action_PlayerSetInvisible:
+		m.handlePlayerSetInvisible(p, correlationID, kind.PlayerSetInvisible)
+	case *pb.Action_PlayerSetVisible:
+		m.handlePlayerSetVisible(p, correlationID, kind.PlayerSetVisible)
+	case *pb.Action_PlayerSetScale:
+		m.handlePlayerSetScale(p, correlationID, kind.PlayerSetScale)
+	case *pb.Action_PlayerSetHeldSlot:
+		m.handlePlayerSetHeldSlot(p, correlationID, kind.PlayerSetHeldSlot)
+	case *pb.Action_PlayerSendToast:
+		m.handlePlayerSendToast(p, correlationID, kind.PlayerSendToast)
+	case *pb.Action_PlayerSendJukeboxPopup:
+		m.handlePlayerSendJukeboxPopup(p, correlationID, kind.PlayerSendJukeboxPopup)
+	case *pb.Action_PlayerShowCoordinates:
+		m.handlePlayerShowCoordinates(p, correlationID, kind.PlayerShowCoordinates)
+	case *pb.Action_PlayerHideCoordinates:
+		m.handlePlayerHideCoordinates(p, correlationID, kind.PlayerHideCoordinates)
+	case *pb.Action_PlayerEnableInstantRespawn:
+		m.handlePlayerEnableInstantRespawn(p, correlationID, kind.PlayerEnableInstantRespawn)
+	case *pb.Action_PlayerDisableInstantRespawn:
+		m.handlePlayerDisableInstantRespawn(p, correlationID, kind.PlayerDisableInstantRespawn)
+	case *pb.Action_PlayerSetNameTag:
+		m.handlePlayerSetNameTag(p, correlationID, kind.PlayerSetNameTag)
+	case *pb.Action_PlayerSetScoreTag:
+		m.handlePlayerSetScoreTag(p, correlationID, kind.PlayerSetScoreTag)
+	case *pb.Action_PlayerShowParticle:
+		m.handlePlayerShowParticle(p, correlationID, kind.PlayerShowParticle)
+	case *pb.Action_PlayerSendScoreboard:
+		m.handlePlayerSendScoreboard(p, correlationID, kind.PlayerSendScoreboard)
+	case *pb.Action_PlayerRemoveScoreboard:
+		m.handlePlayerRemoveScoreboard(p, correlationID, kind.PlayerRemoveScoreboard)
+	case *pb.Action_PlayerSendMenuForm:
+		m.handlePlayerSendMenuForm(p, correlationID, kind.PlayerSendMenuForm)
+	case *pb.Action_PlayerSendModalForm:
+		m.handlePlayerSendModalForm(p, correlationID, kind.PlayerSendModalForm)
+	case *pb.Action_PlayerSendDialogue:
+		m.handlePlayerSendDialogue(p, correlationID, kind.PlayerSendDialogue)
+	case *pb.Action_PlayerSendCustomForm:
+		m.handlePlayerSendCustomForm(p, correlationID, kind.PlayerSendCustomForm)
+	case *pb.Action_PlayerRespawn:
+		m.handlePlayerRespawn(p, correlationID, kind.PlayerRespawn)
+	case *pb.Action_PlayerTransfer:
+		m.handlePlayerTransferAction(p, correlationID, kind.PlayerTransfer)
+	case *pb.Action_PlayerKnockBack:
+		m.handlePlayerKnockBack(p, correlationID, kind.PlayerKnockBack)
+	case *pb.Action_PlayerSwingArm:
+		m.handlePlayerSwingArm(p, correlationID, kind.PlayerSwingArm)
+	case *pb.Action_PlayerPunchAir:
+		m.handlePlayerPunchAirAction(p, correlationID, kind.PlayerPunchAir)
+	case *pb.Action_PlayerSendBossBar:
+		m.handlePlayerSendBossBar(p, correlationID, kind.PlayerSendBossBar)
+	case *pb.Action_PlayerRemoveBossBar:
+		m.handlePlayerRemoveBossBar(p, correlationID, kind.PlayerRemoveBossBar)
+	case *pb.Action_PlayerShowHudElement:
+		m.handlePlayerShowHudElement(p, correlationID, kind.PlayerShowHudElement)
+	case *pb.Action_PlayerHideHudElement:
+		m.handlePlayerHideHudElement(p, correlationID, kind.PlayerHideHudElement)
+	case *pb.Action_PlayerCloseDialogue:
+		m.handlePlayerCloseDialogue(p, correlationID, kind.PlayerCloseDialogue)
+	case *pb.Action_PlayerCloseForm:
+		m.handlePlayerCloseForm(p, correlationID, kind.PlayerCloseForm)
+	case *pb.Action_PlayerOpenSign:
+		m.handlePlayerOpenSign(p, correlationID, kind.PlayerOpenSign)
+	case *pb.Action_PlayerEditSign:
+		m.handlePlayerEditSign(p, correlationID, kind.PlayerEditSign)
+	case *pb.Action_PlayerTurnLecternPage:
+		m.handlePlayerTurnLecternPage(p, correlationID, kind.PlayerTurnLecternPage)
+	case *pb.Action_PlayerHidePlayer:
+		m.handlePlayerHidePlayer(p, correlationID, kind.PlayerHidePlayer)
+	case *pb.Action_PlayerShowPlayer:
+		m.handlePlayerShowPlayer(p, correlationID, kind.PlayerShowPlayer)
+	case *pb.Action_PlayerRemoveAllDebugShapes:
+		m.handlePlayerRemoveAllDebugShapes(p, correlationID, kind.PlayerRemoveAllDebugShapes)
+	case *pb.Action_PlayerOpenBlockContainer:
+		m.handlePlayerOpenBlockContainer(p, correlationID, kind.PlayerOpenBlockContainer)
+	case *pb.Action_PlayerDropItem:
+		m.handlePlayerDropItem(p, correlationID, kind.PlayerDropItem)
+	case *pb.Action_PlayerSetItemCooldown:
+		m.handlePlayerSetItemCooldown(p, correlationID, kind.PlayerSetItemCooldown)
+	case *pb.Action_InventoryQuery:
+		m.handleInventoryQuery(p, correlationID, kind.InventoryQuery)
+	case *pb.Action_InventorySetSlot:
+		m.handleInventorySetSlot(p, correlationID, kind.InventorySetSlot)
+	case *pb.Action_InventoryRemoveItem:
+		m.handleInventoryRemoveItem(p, correlationID, kind.InventoryRemoveItem)
+	case *pb.Action_InventorySwapSlots:
+		m.handleInventorySwapSlots(p, correlationID, kind.InventorySwapSlots)
+	case *pb.Action_InventoryClearRange:
+		m.handleInventoryClearRange(p, correlationID, kind.InventoryClearRange)
+	case *pb.Action_WorldSpawnEntity:
+		m.handleWorldSpawnEntity(p, correlationID, kind.WorldSpawnEntity)
+	case *pb.Action_EntityRemove:
+		m.handleEntityRemove(p, correlationID, kind.EntityRemove)
+	case *pb.Action_EntityTeleport:
+		m.handleEntityTeleport(p, correlationID, kind.EntityTeleport)
+	case *pb.Action_EntitySetVelocity:
+		m.handleEntitySetVelocity(p, correlationID, kind.EntitySetVelocity)
+	case *pb.Action_EntitySetNameTag:
+		m.handleEntitySetNameTag(p, correlationID, kind.EntitySetNameTag)
+	case *pb.Action_PlayerOpenVirtualInventory:
+		m.handlePlayerOpenVirtualInventory(p, correlationID, kind.PlayerOpenVirtualInventory)
+	case *pb.Action_PlayerSetVirtualInventorySlots:
+		m.handlePlayerSetVirtualInventorySlots(p, correlationID, kind.PlayerSetVirtualInventorySlots)
+	case *pb.Action_PlayerCloseVirtualInventory:
+		m.handlePlayerCloseVirtualInventory(p, correlationID, kind.PlayerCloseVirtualInventory)
+	}
+}
+
+func (m *Manager) handleSendChat(p *pluginProcess, correlationID string, act *pb.SendChatAction) {
 	if act.TargetUuid == "" {
-		for p := range m.srv.Players(nil) {
-			p.Message(act.Message)
+		for other := range m.srv.Players(nil) {
+			other.Message(act.Message)
 		}
 		chat.Global.WriteString(act.Message)
+		m.sendActionOK(p, correlationID)
 		return
 	}
-	id, err := uuid.Parse(act.TargetUuid)
-	if err != nil {
-		return
-	}
-
-	m.execMethod(id, func(pl *player.Player) {
+	m.execPlayer(p, correlationID, act.TargetUuid, func(pl *player.Player) {
 		pl.Message(act.Message)
 	})
 }
 
-func (m *Manager) handleTeleport(act *pb.TeleportAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handleTeleport(p *pluginProcess, correlationID string, act *pb.TeleportAction) {
+	pos, ok := vec3FromProto(act.Position)
+	if !ok || !finiteVec3(pos) {
+		m.sendActionError(p, correlationID, "invalid position")
 		return
 	}
-
-	m.execMethod(id, func(pl *player.Player) {
-		pos, ok := vec3FromProto(act.Position)
-		if ok {
-			pl.Teleport(pos)
-		}
-		rot, ok := vec3FromProto(act.Rotation)
-		if ok {
+	rot, hasRot := vec3FromProto(act.Rotation)
+	if hasRot && !finiteVec3(rot) {
+		m.sendActionError(p, correlationID, "invalid rotation")
+		return
+	}
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
+		pl.Teleport(pos)
+		if hasRot {
 			playerRot := pl.Rotation()
 			deltaYaw := rot[1] - playerRot.Yaw()
 			deltaPitch := rot[0] - playerRot.Pitch()
@@ -292,81 +335,78 @@ func (m *Manager) handleTeleport(act *pb.TeleportAction) {
 	})
 }
 
-func (m *Manager) handleKick(act *pb.KickAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleKick(p *pluginProcess, correlationID string, act *pb.KickAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		pl.Disconnect(act.Reason)
 	})
 }
 
-func (m *Manager) handleSetGameMode(act *pb.SetGameModeAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handleSetGameMode(p *pluginProcess, correlationID string, act *pb.SetGameModeAction) {
 	gameMode, ok := world.GameModeByID(int(act.GameMode))
 	if !ok {
+		m.sendActionError(p, correlationID, "unknown game mode")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		pl.SetGameMode(gameMode)
 	})
 }
 
-func (m *Manager) handleGiveItem(act *pb.GiveItemAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handleGiveItem(p *pluginProcess, correlationID string, act *pb.GiveItemAction) {
+	stack, ok := convertProtoItemStackValue(act.Item)
+	if !ok {
+		m.sendActionError(p, correlationID, "invalid item")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		if stack, ok := convertProtoItemStackValue(act.Item); ok {
-			_, _ = pl.Inventory().AddItem(stack)
-		}
+	m.execPlayerResult(p, correlationID, act.PlayerUuid, func(pl *player.Player) (*pb.ActionResult, error) {
+		added, _ := pl.Inventory().AddItem(stack)
+		return &pb.ActionResult{Result: &pb.ActionResult_GiveItem{GiveItem: &pb.GiveItemResult{
+			Added:    int32(added),
+			Leftover: int32(stack.Count() - added),
+		}}}, nil
 	})
 }
 
-func (m *Manager) handleClearInventory(act *pb.ClearInventoryAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
-		_ = pl.Inventory().Clear()
+func (m *Manager) handleClearInventory(p *pluginProcess, correlationID string, act *pb.ClearInventoryAction) {
+	m.execPlayerResult(p, correlationID, act.PlayerUuid, func(pl *player.Player) (*pb.ActionResult, error) {
+		removed := 0
+		for _, stack := range pl.Inventory().Clear() {
+			removed += stack.Count()
+		}
+		_, off := pl.HeldItems()
+		removed += off.Count()
 		pl.SetHeldItems(item.Stack{}, item.Stack{})
+		return &pb.ActionResult{Result: &pb.ActionResult_ClearInventory{ClearInventory: &pb.ClearInventoryResult{
+			Removed: int32(removed),
+		}}}, nil
 	})
 }
 
-func (m *Manager) handleSetHeldItem(act *pb.SetHeldItemAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handleSetHeldItem(p *pluginProcess, correlationID string, act *pb.SetHeldItemAction) {
+	newMain, mainOK := convertProtoItemStackValue(act.Main)
+	if act.Main != nil && act.Main.Name != "" && !mainOK {
+		m.sendActionError(p, correlationID, "invalid main hand item")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
+	newOff, offOK := convertProtoItemStackValue(act.Offhand)
+	if act.Offhand != nil && act.Offhand.Name != "" && !offOK {
+		m.sendActionError(p, correlationID, "invalid offhand item")
+		return
+	}
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		main, off := pl.HeldItems()
-
-		if act.Main != nil {
-			if s, ok := convertProtoItemStackValue(act.Main); ok {
-				main = s
-			}
+		if mainOK {
+			main = newMain
 		}
-		if act.Offhand != nil {
-			if s, ok := convertProtoItemStackValue(act.Offhand); ok {
-				off = s
-			}
+		if offOK {
+			off = newOff
 		}
 		pl.SetHeldItems(main, off)
 	})
 }
 
-func (m *Manager) handleSetHealth(act *pb.SetHealthAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleSetHealth(p *pluginProcess, correlationID string, act *pb.SetHealthAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		if act.MaxHealth != nil {
 			pl.SetMaxHealth(*act.MaxHealth)
 		}
@@ -380,22 +420,14 @@ func (m *Manager) handleSetHealth(act *pb.SetHealthAction) {
 	})
 }
 
-func (m *Manager) handleSetFood(act *pb.SetFoodAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleSetFood(p *pluginProcess, correlationID string, act *pb.SetFoodAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		pl.SetFood(int(act.Food))
 	})
 }
 
-func (m *Manager) handleSetExperience(act *pb.SetExperienceAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleSetExperience(p *pluginProcess, correlationID string, act *pb.SetExperienceAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		if act.Level != nil {
 			pl.SetExperienceLevel(int(*act.Level))
 		}
@@ -413,28 +445,24 @@ func (m *Manager) handleSetExperience(act *pb.SetExperienceAction) {
 	})
 }
 
-func (m *Manager) handleSetVelocity(act *pb.SetVelocityAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handleSetVelocity(p *pluginProcess, correlationID string, act *pb.SetVelocityAction) {
+	v, ok := vec3FromProto(act.Velocity)
+	if !ok {
+		m.sendActionError(p, correlationID, "missing velocity")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		if v, ok := vec3FromProto(act.Velocity); ok {
-			pl.SetVelocity(v)
-		}
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
+		pl.SetVelocity(v)
 	})
 }
 
-func (m *Manager) handleAddEffect(act *pb.AddEffectAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handleAddEffect(p *pluginProcess, correlationID string, act *pb.AddEffectAction) {
+	t, ok := effect.ByID(int(act.EffectType))
+	if !ok {
+		m.sendActionError(p, correlationID, "unknown effect type")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		t, ok := effect.ByID(int(act.EffectType))
-		if !ok {
-			return
-		}
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		var e effect.Effect
 		if lt, ok := t.(effect.LastingType); ok {
 			d := time.Duration(act.DurationMs) * time.Millisecond
@@ -453,68 +481,45 @@ func (m *Manager) handleAddEffect(act *pb.AddEffectAction) {
 	})
 }
 
-func (m *Manager) handleRemoveEffect(act *pb.RemoveEffectAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handleRemoveEffect(p *pluginProcess, correlationID string, act *pb.RemoveEffectAction) {
+	t, ok := effect.ByID(int(act.EffectType))
+	if !ok {
+		m.sendActionError(p, correlationID, "unknown effect type")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		t, ok := effect.ByID(int(act.EffectType))
-		if !ok {
-			return
-		}
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		pl.RemoveEffect(t)
 	})
 }
 
-func (m *Manager) handleSendTitle(act *pb.SendTitleAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleSendTitle(p *pluginProcess, correlationID string, act *pb.SendTitleAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		t := playerTitleFromAction(act)
 		pl.SendTitle(t)
 	})
 }
 
-func (m *Manager) handleSendPopup(act *pb.SendPopupAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleSendPopup(p *pluginProcess, correlationID string, act *pb.SendPopupAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		pl.SendPopup(act.Message)
 	})
 }
 
-func (m *Manager) handleSendTip(act *pb.SendTipAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleSendTip(p *pluginProcess, correlationID string, act *pb.SendTipAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		pl.SendTip(act.Message)
 	})
 }
 
-func (m *Manager) handlePlaySound(act *pb.PlaySoundAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handlePlaySound(p *pluginProcess, correlationID string, act *pb.PlaySoundAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		s := soundFromProto(act.Sound)
 		pl.PlaySound(s)
 	})
 }
 
-func (m *Manager) handleExecuteCommand(act *pb.ExecuteCommandAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handleExecuteCommand(p *pluginProcess, correlationID string, act *pb.ExecuteCommandAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		cmd := act.Command
 		if cmd != "" && !strings.HasPrefix(cmd, "/") {
 			cmd = "/" + cmd
@@ -558,7 +563,7 @@ func (m *Manager) handleWorldBuildStructure(p *pluginProcess, correlationID stri
 		return
 	}
 	origin := cube.Pos{int(act.Origin.X), int(act.Origin.Y), int(act.Origin.Z)}
-	<-w.Exec(func(tx *world.Tx) {
+	m.execWorld(p, w, func(tx *world.Tx) {
 		tx.BuildStructure(origin, ps)
 	})
 	m.sendActionOK(p, correlationID)
@@ -580,319 +585,180 @@ func (m *Manager) handleWorldSetDifficulty(p *pluginProcess, correlationID strin
 }
 
 // Player movement toggles
-func (m *Manager) handlePlayerStartSprinting(act *pb.PlayerStartSprintingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StartSprinting() })
+func (m *Manager) handlePlayerStartSprinting(p *pluginProcess, correlationID string, act *pb.PlayerStartSprintingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StartSprinting() })
 }
-func (m *Manager) handlePlayerStopSprinting(act *pb.PlayerStopSprintingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StopSprinting() })
+func (m *Manager) handlePlayerStopSprinting(p *pluginProcess, correlationID string, act *pb.PlayerStopSprintingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StopSprinting() })
 }
-func (m *Manager) handlePlayerStartSneaking(act *pb.PlayerStartSneakingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StartSneaking() })
+func (m *Manager) handlePlayerStartSneaking(p *pluginProcess, correlationID string, act *pb.PlayerStartSneakingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StartSneaking() })
 }
-func (m *Manager) handlePlayerStopSneaking(act *pb.PlayerStopSneakingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StopSneaking() })
+func (m *Manager) handlePlayerStopSneaking(p *pluginProcess, correlationID string, act *pb.PlayerStopSneakingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StopSneaking() })
 }
-func (m *Manager) handlePlayerStartSwimming(act *pb.PlayerStartSwimmingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StartSwimming() })
+func (m *Manager) handlePlayerStartSwimming(p *pluginProcess, correlationID string, act *pb.PlayerStartSwimmingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StartSwimming() })
 }
-func (m *Manager) handlePlayerStopSwimming(act *pb.PlayerStopSwimmingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StopSwimming() })
+func (m *Manager) handlePlayerStopSwimming(p *pluginProcess, correlationID string, act *pb.PlayerStopSwimmingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StopSwimming() })
 }
-func (m *Manager) handlePlayerStartCrawling(act *pb.PlayerStartCrawlingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StartCrawling() })
+func (m *Manager) handlePlayerStartCrawling(p *pluginProcess, correlationID string, act *pb.PlayerStartCrawlingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StartCrawling() })
 }
-func (m *Manager) handlePlayerStopCrawling(act *pb.PlayerStopCrawlingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StopCrawling() })
+func (m *Manager) handlePlayerStopCrawling(p *pluginProcess, correlationID string, act *pb.PlayerStopCrawlingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StopCrawling() })
 }
-func (m *Manager) handlePlayerStartGliding(act *pb.PlayerStartGlidingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StartGliding() })
+func (m *Manager) handlePlayerStartGliding(p *pluginProcess, correlationID string, act *pb.PlayerStartGlidingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StartGliding() })
 }
-func (m *Manager) handlePlayerStopGliding(act *pb.PlayerStopGlidingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StopGliding() })
+func (m *Manager) handlePlayerStopGliding(p *pluginProcess, correlationID string, act *pb.PlayerStopGlidingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StopGliding() })
 }
-func (m *Manager) handlePlayerStartFlying(act *pb.PlayerStartFlyingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StartFlying() })
+func (m *Manager) handlePlayerStartFlying(p *pluginProcess, correlationID string, act *pb.PlayerStartFlyingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StartFlying() })
 }
-func (m *Manager) handlePlayerStopFlying(act *pb.PlayerStopFlyingAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.StopFlying() })
+func (m *Manager) handlePlayerStopFlying(p *pluginProcess, correlationID string, act *pb.PlayerStopFlyingAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.StopFlying() })
 }
 
 // Player mobility lock
-func (m *Manager) handlePlayerSetImmobile(act *pb.PlayerSetImmobileAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetImmobile() })
+func (m *Manager) handlePlayerSetImmobile(p *pluginProcess, correlationID string, act *pb.PlayerSetImmobileAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetImmobile() })
 }
-func (m *Manager) handlePlayerSetMobile(act *pb.PlayerSetMobileAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetMobile() })
+func (m *Manager) handlePlayerSetMobile(p *pluginProcess, correlationID string, act *pb.PlayerSetMobileAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetMobile() })
 }
 
 // Player movement attributes
-func (m *Manager) handlePlayerSetSpeed(act *pb.PlayerSetSpeedAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetSpeed(act.Speed) })
+func (m *Manager) handlePlayerSetSpeed(p *pluginProcess, correlationID string, act *pb.PlayerSetSpeedAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetSpeed(act.Speed) })
 }
-func (m *Manager) handlePlayerSetFlightSpeed(act *pb.PlayerSetFlightSpeedAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetFlightSpeed(act.FlightSpeed) })
+func (m *Manager) handlePlayerSetFlightSpeed(p *pluginProcess, correlationID string, act *pb.PlayerSetFlightSpeedAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetFlightSpeed(act.FlightSpeed) })
 }
-func (m *Manager) handlePlayerSetVerticalFlightSpeed(act *pb.PlayerSetVerticalFlightSpeedAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetVerticalFlightSpeed(act.VerticalFlightSpeed) })
+func (m *Manager) handlePlayerSetVerticalFlightSpeed(p *pluginProcess, correlationID string, act *pb.PlayerSetVerticalFlightSpeedAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetVerticalFlightSpeed(act.VerticalFlightSpeed) })
 }
 
 // Player health/status
-func (m *Manager) handlePlayerSetAbsorption(act *pb.PlayerSetAbsorptionAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetAbsorption(act.Absorption) })
+func (m *Manager) handlePlayerSetAbsorption(p *pluginProcess, correlationID string, act *pb.PlayerSetAbsorptionAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetAbsorption(act.Absorption) })
 }
-func (m *Manager) handlePlayerSetOnFire(act *pb.PlayerSetOnFireAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerSetOnFire(p *pluginProcess, correlationID string, act *pb.PlayerSetOnFireAction) {
 	d := time.Duration(act.DurationMs) * time.Millisecond
-	m.execMethod(id, func(pl *player.Player) { pl.SetOnFire(d) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetOnFire(d) })
 }
-func (m *Manager) handlePlayerExtinguish(act *pb.PlayerExtinguishAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.Extinguish() })
+func (m *Manager) handlePlayerExtinguish(p *pluginProcess, correlationID string, act *pb.PlayerExtinguishAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.Extinguish() })
 }
-func (m *Manager) handlePlayerSetInvisible(act *pb.PlayerSetInvisibleAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetInvisible() })
+func (m *Manager) handlePlayerSetInvisible(p *pluginProcess, correlationID string, act *pb.PlayerSetInvisibleAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetInvisible() })
 }
-func (m *Manager) handlePlayerSetVisible(act *pb.PlayerSetVisibleAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetVisible() })
+func (m *Manager) handlePlayerSetVisible(p *pluginProcess, correlationID string, act *pb.PlayerSetVisibleAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetVisible() })
 }
 
 // Player misc attributes
-func (m *Manager) handlePlayerSetScale(act *pb.PlayerSetScaleAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SetScale(act.Scale) })
+func (m *Manager) handlePlayerSetScale(p *pluginProcess, correlationID string, act *pb.PlayerSetScaleAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetScale(act.Scale) })
 }
-func (m *Manager) handlePlayerSetHeldSlot(act *pb.PlayerSetHeldSlotAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handlePlayerSetHeldSlot(p *pluginProcess, correlationID string, act *pb.PlayerSetHeldSlotAction) {
+	slot := int(act.Slot)
+	if slot < 0 || slot > 8 {
+		m.sendActionError(p, correlationID, "slot must be between 0 and 8")
 		return
 	}
-	slot := int(act.Slot)
-	m.execMethod(id, func(pl *player.Player) { _ = pl.SetHeldSlot(slot) })
+	m.execPlayerResult(p, correlationID, act.PlayerUuid, func(pl *player.Player) (*pb.ActionResult, error) {
+		return nil, pl.SetHeldSlot(slot)
+	})
 }
 
 // Player UI
-func (m *Manager) handlePlayerSendToast(act *pb.PlayerSendToastAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerSendToast(p *pluginProcess, correlationID string, act *pb.PlayerSendToastAction) {
 	titleText := act.Title
 	message := act.Message
-	m.execMethod(id, func(pl *player.Player) { pl.SendToast(titleText, message) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SendToast(titleText, message) })
 }
-func (m *Manager) handlePlayerSendJukeboxPopup(act *pb.PlayerSendJukeboxPopupAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerSendJukeboxPopup(p *pluginProcess, correlationID string, act *pb.PlayerSendJukeboxPopupAction) {
 	msg := act.Message
-	m.execMethod(id, func(pl *player.Player) { pl.SendJukeboxPopup(msg) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SendJukeboxPopup(msg) })
 }
-func (m *Manager) handlePlayerShowCoordinates(act *pb.PlayerShowCoordinatesAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.ShowCoordinates() })
+func (m *Manager) handlePlayerShowCoordinates(p *pluginProcess, correlationID string, act *pb.PlayerShowCoordinatesAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.ShowCoordinates() })
 }
-func (m *Manager) handlePlayerHideCoordinates(act *pb.PlayerHideCoordinatesAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.HideCoordinates() })
+func (m *Manager) handlePlayerHideCoordinates(p *pluginProcess, correlationID string, act *pb.PlayerHideCoordinatesAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.HideCoordinates() })
 }
-func (m *Manager) handlePlayerEnableInstantRespawn(act *pb.PlayerEnableInstantRespawnAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.EnableInstantRespawn() })
+func (m *Manager) handlePlayerEnableInstantRespawn(p *pluginProcess, correlationID string, act *pb.PlayerEnableInstantRespawnAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.EnableInstantRespawn() })
 }
-func (m *Manager) handlePlayerDisableInstantRespawn(act *pb.PlayerDisableInstantRespawnAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.DisableInstantRespawn() })
+func (m *Manager) handlePlayerDisableInstantRespawn(p *pluginProcess, correlationID string, act *pb.PlayerDisableInstantRespawnAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.DisableInstantRespawn() })
 }
-func (m *Manager) handlePlayerSetNameTag(act *pb.PlayerSetNameTagAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerSetNameTag(p *pluginProcess, correlationID string, act *pb.PlayerSetNameTagAction) {
 	name := act.NameTag
-	m.execMethod(id, func(pl *player.Player) { pl.SetNameTag(name) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetNameTag(name) })
 }
-func (m *Manager) handlePlayerSetScoreTag(act *pb.PlayerSetScoreTagAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerSetScoreTag(p *pluginProcess, correlationID string, act *pb.PlayerSetScoreTagAction) {
 	text := act.ScoreTag
-	m.execMethod(id, func(pl *player.Player) { pl.SetScoreTag(text) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SetScoreTag(text) })
 }
 
 // Player visuals
-func (m *Manager) handlePlayerShowParticle(act *pb.PlayerShowParticleAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerShowParticle(p *pluginProcess, correlationID string, act *pb.PlayerShowParticleAction) {
 	pos, ok := vec3FromProto(act.Position)
 	if !ok {
+		m.sendActionError(p, correlationID, "missing position")
 		return
 	}
 	part, ok := particleFromPlayerAction(act)
 	if !ok {
+		m.sendActionError(p, correlationID, "unknown particle")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) { pl.ShowParticle(pos, part) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.ShowParticle(pos, part) })
 }
 
 // Player lifecycle/control
-func (m *Manager) handlePlayerRespawn(act *pb.PlayerRespawnAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { _ = pl.Respawn() })
+func (m *Manager) handlePlayerRespawn(p *pluginProcess, correlationID string, act *pb.PlayerRespawnAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { _ = pl.Respawn() })
 }
-func (m *Manager) handlePlayerTransferAction(act *pb.PlayerTransferAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerTransferAction(p *pluginProcess, correlationID string, act *pb.PlayerTransferAction) {
 	addr := parseProtoAddress(act.Address)
 	if addr == nil {
+		m.sendActionError(p, correlationID, "invalid address")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) { _ = pl.Transfer(addr.String()) })
-}
-func (m *Manager) handlePlayerKnockBack(act *pb.PlayerKnockBackAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+	// Transfer only fails on an address it cannot resolve, which is checked before any batch applies.
+	if _, err := net.ResolveUDPAddr("udp", addr.String()); err != nil {
+		m.sendActionError(p, correlationID, "invalid address")
 		return
 	}
+	m.execPlayerResult(p, correlationID, act.PlayerUuid, func(pl *player.Player) (*pb.ActionResult, error) {
+		return nil, pl.Transfer(addr.String())
+	})
+}
+func (m *Manager) handlePlayerKnockBack(p *pluginProcess, correlationID string, act *pb.PlayerKnockBackAction) {
 	src, ok := vec3FromProto(act.Source)
 	if !ok {
+		m.sendActionError(p, correlationID, "missing source")
 		return
 	}
 	force := act.Force
 	height := act.Height
-	m.execMethod(id, func(pl *player.Player) { pl.KnockBack(src, force, height) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.KnockBack(src, force, height) })
 }
-func (m *Manager) handlePlayerSwingArm(act *pb.PlayerSwingArmAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.SwingArm() })
+func (m *Manager) handlePlayerSwingArm(p *pluginProcess, correlationID string, act *pb.PlayerSwingArmAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.SwingArm() })
 }
-func (m *Manager) handlePlayerPunchAirAction(act *pb.PlayerPunchAirAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.PunchAir() })
+func (m *Manager) handlePlayerPunchAirAction(p *pluginProcess, correlationID string, act *pb.PlayerPunchAirAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.PunchAir() })
 }
 
 // Player boss bar
-func (m *Manager) handlePlayerSendBossBar(act *pb.PlayerSendBossBarAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handlePlayerSendBossBar(p *pluginProcess, correlationID string, act *pb.PlayerSendBossBarAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		bar := bossbar.New(act.Text)
 		if act.HealthPercentage != nil {
 			h := float64(*act.HealthPercentage)
@@ -911,192 +777,185 @@ func (m *Manager) handlePlayerSendBossBar(act *pb.PlayerSendBossBarAction) {
 	})
 }
 
-func (m *Manager) handlePlayerRemoveBossBar(act *pb.PlayerRemoveBossBarAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.RemoveBossBar() })
+func (m *Manager) handlePlayerRemoveBossBar(p *pluginProcess, correlationID string, act *pb.PlayerRemoveBossBarAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.RemoveBossBar() })
 }
 
 // Player HUD
-func (m *Manager) handlePlayerShowHudElement(act *pb.PlayerShowHudElementAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handlePlayerShowHudElement(p *pluginProcess, correlationID string, act *pb.PlayerShowHudElementAction) {
+	el, ok := convertHudElement(act.Element)
+	if !ok {
+		m.sendActionError(p, correlationID, "unknown hud element")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		if el, ok := convertHudElement(act.Element); ok {
-			pl.ShowHudElement(el)
-		}
-	})
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.ShowHudElement(el) })
 }
 
-func (m *Manager) handlePlayerHideHudElement(act *pb.PlayerHideHudElementAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handlePlayerHideHudElement(p *pluginProcess, correlationID string, act *pb.PlayerHideHudElementAction) {
+	el, ok := convertHudElement(act.Element)
+	if !ok {
+		m.sendActionError(p, correlationID, "unknown hud element")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		if el, ok := convertHudElement(act.Element); ok {
-			pl.HideHudElement(el)
-		}
-	})
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.HideHudElement(el) })
 }
 
 // UI closers
-func (m *Manager) handlePlayerCloseDialogue(act *pb.PlayerCloseDialogueAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.CloseDialogue() })
+func (m *Manager) handlePlayerCloseDialogue(p *pluginProcess, correlationID string, act *pb.PlayerCloseDialogueAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.CloseDialogue() })
 }
 
-func (m *Manager) handlePlayerCloseForm(act *pb.PlayerCloseFormAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.CloseForm() })
+func (m *Manager) handlePlayerCloseForm(p *pluginProcess, correlationID string, act *pb.PlayerCloseFormAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.CloseForm() })
 }
 
 // Signs & Lecterns
-func (m *Manager) handlePlayerOpenSign(act *pb.PlayerOpenSignAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerOpenSign(p *pluginProcess, correlationID string, act *pb.PlayerOpenSignAction) {
 	if act.Position == nil {
+		m.sendActionError(p, correlationID, "missing position")
 		return
 	}
 	pos := cube.Pos{int(act.Position.X), int(act.Position.Y), int(act.Position.Z)}
-	m.execMethod(id, func(pl *player.Player) { pl.OpenSign(pos, act.FrontSide) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.OpenSign(pos, act.FrontSide) })
 }
 
-func (m *Manager) handlePlayerEditSign(act *pb.PlayerEditSignAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerEditSign(p *pluginProcess, correlationID string, act *pb.PlayerEditSignAction) {
 	if act.Position == nil {
+		m.sendActionError(p, correlationID, "missing position")
 		return
 	}
 	pos := cube.Pos{int(act.Position.X), int(act.Position.Y), int(act.Position.Z)}
-	m.execMethod(id, func(pl *player.Player) { _ = pl.EditSign(pos, act.FrontText, act.BackText) })
+	check := func(pl *player.Player) error {
+		if _, ok := pl.Tx().Block(pos).(block.Sign); !ok {
+			return errors.New("no sign at position")
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		return nil, pl.EditSign(pos, act.FrontText, act.BackText)
+	})
 }
 
-func (m *Manager) handlePlayerTurnLecternPage(act *pb.PlayerTurnLecternPageAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerTurnLecternPage(p *pluginProcess, correlationID string, act *pb.PlayerTurnLecternPageAction) {
 	if act.Position == nil {
+		m.sendActionError(p, correlationID, "missing position")
 		return
 	}
 	pos := cube.Pos{int(act.Position.X), int(act.Position.Y), int(act.Position.Z)}
 	page := int(act.Page)
-	m.execMethod(id, func(pl *player.Player) { _ = pl.TurnLecternPage(pos, page) })
+	check := func(pl *player.Player) error {
+		if _, ok := pl.Tx().Block(pos).(block.Lectern); !ok {
+			return errors.New("no lectern at position")
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		return nil, pl.TurnLecternPage(pos, page)
+	})
 }
 
 // Entity visibility (players)
-func (m *Manager) handlePlayerHidePlayer(act *pb.PlayerHidePlayerAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerHidePlayer(p *pluginProcess, correlationID string, act *pb.PlayerHidePlayerAction) {
 	targetID, err := uuid.Parse(act.TargetUuid)
 	if err != nil {
+		m.sendActionError(p, correlationID, "invalid target_uuid")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		for p := range m.srv.Players(nil) {
-			if p.UUID() == targetID {
-				pl.HideEntity(p)
-				break
+	check := func(*player.Player) error {
+		if _, ok := m.srv.Player(targetID); !ok {
+			return errTargetNotFound
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		for other := range m.srv.Players(nil) {
+			if other.UUID() == targetID {
+				pl.HideEntity(other)
+				return nil, nil
 			}
 		}
+		return nil, errTargetNotFound
 	})
 }
 
-func (m *Manager) handlePlayerShowPlayer(act *pb.PlayerShowPlayerAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerShowPlayer(p *pluginProcess, correlationID string, act *pb.PlayerShowPlayerAction) {
 	targetID, err := uuid.Parse(act.TargetUuid)
 	if err != nil {
+		m.sendActionError(p, correlationID, "invalid target_uuid")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		for p := range m.srv.Players(nil) {
-			if p.UUID() == targetID {
-				pl.ShowEntity(p)
-				break
+	check := func(*player.Player) error {
+		if _, ok := m.srv.Player(targetID); !ok {
+			return errTargetNotFound
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		for other := range m.srv.Players(nil) {
+			if other.UUID() == targetID {
+				pl.ShowEntity(other)
+				return nil, nil
 			}
 		}
+		return nil, errTargetNotFound
 	})
 }
 
 // Debug shapes
-func (m *Manager) handlePlayerRemoveAllDebugShapes(act *pb.PlayerRemoveAllDebugShapesAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) { pl.RemoveAllDebugShapes() })
+func (m *Manager) handlePlayerRemoveAllDebugShapes(p *pluginProcess, correlationID string, act *pb.PlayerRemoveAllDebugShapesAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) { pl.RemoveAllDebugShapes() })
 }
 
 // Interaction extras
-func (m *Manager) handlePlayerOpenBlockContainer(act *pb.PlayerOpenBlockContainerAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
+func (m *Manager) handlePlayerOpenBlockContainer(p *pluginProcess, correlationID string, act *pb.PlayerOpenBlockContainerAction) {
 	if act.Position == nil {
+		m.sendActionError(p, correlationID, "missing position")
 		return
 	}
 	pos := cube.Pos{int(act.Position.X), int(act.Position.Y), int(act.Position.Z)}
-	m.execMethod(id, func(pl *player.Player) { pl.OpenBlockContainer(pos, pl.Tx()) })
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
+		tx := pl.Tx()
+		m.handleContainerInventory(tx, pos, tx.Block(pos))
+		pl.OpenBlockContainer(pos, tx)
+	})
 }
 
-func (m *Manager) handlePlayerDropItem(act *pb.PlayerDropItemAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
+func (m *Manager) handlePlayerDropItem(p *pluginProcess, correlationID string, act *pb.PlayerDropItemAction) {
+	stack, ok := convertProtoItemStackValue(act.Item)
+	if act.Item != nil && !ok {
+		m.sendActionError(p, correlationID, "invalid item")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		var s item.Stack
-		if act.Item != nil {
-			if stack, ok := convertProtoItemStackValue(act.Item); ok {
-				s = stack
-			} else {
-				return
-			}
-		} else {
+	check := func(pl *player.Player) error {
+		if held, _ := pl.HeldItems(); act.Item == nil && held.Empty() {
+			return errNoHeldItem
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		s := stack
+		if act.Item == nil {
 			held, _ := pl.HeldItems()
 			if held.Empty() {
-				return
+				return nil, errNoHeldItem
 			}
 			s = held
 		}
-		_ = pl.Drop(s)
+		return &pb.ActionResult{Result: &pb.ActionResult_PlayerDropItem{PlayerDropItem: &pb.PlayerDropItemResult{
+			Dropped: int32(pl.Drop(s)),
+		}}}, nil
 	})
 }
 
-func (m *Manager) handlePlayerSetItemCooldown(act *pb.PlayerSetItemCooldownAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	if act.Item == nil {
+func (m *Manager) handlePlayerSetItemCooldown(p *pluginProcess, correlationID string, act *pb.PlayerSetItemCooldownAction) {
+	stack, ok := convertProtoItemStackValue(act.Item)
+	if !ok {
+		m.sendActionError(p, correlationID, "invalid item")
 		return
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		if stack, ok := convertProtoItemStackValue(act.Item); ok {
-			d := time.Duration(act.DurationMs) * time.Millisecond
-			pl.SetCooldown(stack.Item(), d)
-		}
+	d := time.Duration(act.DurationMs) * time.Millisecond
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
+		pl.SetCooldown(stack.Item(), d)
 	})
 }
 
@@ -1155,22 +1014,6 @@ func convertHudElement(e pb.HudElement) (hud.Element, bool) {
 	}
 }
 
-// Local no-op submittables for forms/dialogues.
-type formMenuNoop struct{}
-
-func (formMenuNoop) Submit(form.Submitter, form.Button, *world.Tx) {}
-
-type formModalNoop struct {
-	Yes form.Button
-	No  form.Button
-}
-
-func (formModalNoop) Submit(form.Submitter, form.Button, *world.Tx) {}
-
-type dialogueNoop struct{}
-
-func (dialogueNoop) Submit(dialogue.Submitter, dialogue.Button, *world.Tx) {}
-
 func resolveWorldEntity(pl *player.Player, ref *pb.EntityRef) world.Entity {
 	if ref == nil || ref.Uuid == "" {
 		return nil
@@ -1186,12 +1029,18 @@ func resolveWorldEntity(pl *player.Player, ref *pb.EntityRef) world.Entity {
 }
 
 // Player armour
-func (m *Manager) handlePlayerSetArmour(act *pb.PlayerSetArmourAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
+func (m *Manager) handlePlayerSetArmour(p *pluginProcess, correlationID string, act *pb.PlayerSetArmourAction) {
+	// Stacks without a name clear their slot, anything else must be a known item.
+	for _, s := range []*pb.ItemStack{act.Helmet, act.Chestplate, act.Leggings, act.Boots} {
+		if s == nil || s.Name == "" {
+			continue
+		}
+		if _, ok := convertProtoItemStackValue(s); !ok {
+			m.sendActionError(p, correlationID, "invalid item")
+			return
+		}
 	}
-	m.execMethod(id, func(pl *player.Player) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		if act.Helmet != nil {
 			if s, ok := convertProtoItemStackValue(act.Helmet); ok {
 				pl.Armour().SetHelmet(s)
@@ -1224,12 +1073,8 @@ func (m *Manager) handlePlayerSetArmour(act *pb.PlayerSetArmourAction) {
 }
 
 // Player scoreboard
-func (m *Manager) handlePlayerSendScoreboard(act *pb.PlayerSendScoreboardAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handlePlayerSendScoreboard(p *pluginProcess, correlationID string, act *pb.PlayerSendScoreboardAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		sb := scoreboard.New(act.Title)
 		if act.Padding != nil && !*act.Padding {
 			sb.RemovePadding()
@@ -1246,76 +1091,79 @@ func (m *Manager) handlePlayerSendScoreboard(act *pb.PlayerSendScoreboardAction)
 	})
 }
 
-func (m *Manager) handlePlayerRemoveScoreboard(act *pb.PlayerRemoveScoreboardAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
+func (m *Manager) handlePlayerRemoveScoreboard(p *pluginProcess, correlationID string, act *pb.PlayerRemoveScoreboardAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
 		pl.RemoveScoreboard()
 	})
 }
 
 // Player forms (show)
-func (m *Manager) handlePlayerSendMenuForm(act *pb.PlayerSendMenuFormAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
-		sub := formMenuNoop{}
-		menu := form.NewMenu(sub, act.Title)
-		if act.Body != nil {
-			menu = menu.WithBody(*act.Body)
-		}
-		btns := make([]form.Button, len(act.Buttons))
-		for i := range act.Buttons {
-			btns[i] = form.NewButton(act.Buttons[i], "")
-		}
-		menu = menu.WithButtons(btns...)
-		pl.SendForm(menu)
+func (m *Manager) handlePlayerSendMenuForm(p *pluginProcess, correlationID string, act *pb.PlayerSendMenuFormAction) {
+	responder := newMenuFormResponder(m, p.id, formResponseID(act.FormId, correlationID), act)
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
+		pl.SendForm(responder)
+	})
+}
+
+func (m *Manager) handlePlayerSendModalForm(p *pluginProcess, correlationID string, act *pb.PlayerSendModalFormAction) {
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
+		pl.SendForm(formModalResponder{
+			mgr:      m,
+			pluginID: p.id,
+			formID:   formResponseID(act.FormId, correlationID),
+			title:    act.Title,
+			body:     act.Body,
+			yes:      act.YesText,
+			no:       act.NoText,
+		})
 	})
 }
 
-func (m *Manager) handlePlayerSendModalForm(act *pb.PlayerSendModalFormAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		return
-	}
-	m.execMethod(id, func(pl *player.Player) {
-		sub := formModalNoop{Yes: form.NewButton(act.YesText, ""), No: form.NewButton(act.NoText, "")}
-		modal := form.NewModal(sub, act.Title).WithBody(act.Body)
-		pl.SendForm(modal)
+func (m *Manager) handlePlayerSendCustomForm(p *pluginProcess, correlationID string, act *pb.PlayerSendCustomFormAction) {
+	elements := make([]form.Element, 0, len(act.Elements))
+	for i, elem := range act.Elements {
+		converted, ok := convertFormElement(elem)
+		if !ok {
+			m.sendActionError(p, correlationID, fmt.Sprintf("invalid form element at index %d", i))
+			return
+		}
+		elements = append(elements, converted)
+	}
+	m.execPlayer(p, correlationID, act.PlayerUuid, func(pl *player.Player) {
+		pl.SendForm(customFormResponder{
+			mgr:      m,
+			pluginID: p.id,
+			formID:   formResponseID(act.FormId, correlationID),
+			title:    act.Title,
+			elements: elements,
+		})
 	})
 }
 
 // Player dialogue (show)
 func (m *Manager) handlePlayerSendDialogue(p *pluginProcess, correlationID string, act *pb.PlayerSendDialogueAction) {
-	id, err := uuid.Parse(act.PlayerUuid)
-	if err != nil {
-		m.sendActionError(p, correlationID, "invalid player_uuid")
-		return
+	check := func(pl *player.Player) error {
+		if resolveWorldEntity(pl, act.Entity) == nil {
+			return errEntityNotFound
+		}
+		return nil
 	}
-	m.execMethod(id, func(pl *player.Player) {
-		sub := dialogueNoop{}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		// Clamp to 6
+		texts := act.Buttons[:min(len(act.Buttons), 6)]
+		sub := newDialogueResponder(m, p.id, formResponseID(act.FormId, correlationID), texts)
 		d := dialogue.New(sub, act.Title)
 		if act.Body != nil {
 			d = d.WithBody(*act.Body)
 		}
-		// Clamp to 6
-		max := min(len(act.Buttons), 6)
-		btns := make([]dialogue.Button, max)
-		for i := range max {
-			btns[i] = dialogue.Button{Text: act.Buttons[i]}
-		}
-		d = d.WithButtons(btns...)
+		d = d.WithButtons(sub.buttons...)
 
 		e := resolveWorldEntity(pl, act.Entity)
 		if e == nil {
-			m.sendActionError(p, correlationID, "entity not found")
-			return
+			return nil, errEntityNotFound
 		}
 		pl.SendDialogue(d, e)
+		return nil, nil
 	})
 }
 
@@ -1353,7 +1201,7 @@ func (m *Manager) handleWorldSetBlock(p *pluginProcess, correlationID string, ac
 			return
 		}
 	}
-	<-w.Exec(func(tx *world.Tx) {
+	m.execWorld(p, w, func(tx *world.Tx) {
 		tx.SetBlock(pos, blk, nil)
 	})
 	m.sendActionOK(p, correlationID)
@@ -1371,7 +1219,7 @@ func (m *Manager) handleWorldPlaySound(p *pluginProcess, correlationID string, a
 		return
 	}
 	s := soundFromProto(act.Sound)
-	<-w.Exec(func(tx *world.Tx) {
+	m.execWorld(p, w, func(tx *world.Tx) {
 		tx.PlaySound(pos, s)
 	})
 	m.sendActionOK(p, correlationID)
@@ -1393,7 +1241,7 @@ func (m *Manager) handleWorldAddParticle(p *pluginProcess, correlationID string,
 		m.sendActionError(p, correlationID, "unknown particle")
 		return
 	}
-	<-w.Exec(func(tx *world.Tx) {
+	m.execWorld(p, w, func(tx *world.Tx) {
 		tx.AddParticle(pos, part)
 	})
 	m.sendActionOK(p, correlationID)
@@ -1560,41 +1408,143 @@ func (m *Manager) handleWorldQueryPlayerSpawn(p *pluginProcess, correlationID st
 	})
 }
 
-func (m *Manager) execMethod(id uuid.UUID, method func(pl *player.Player)) {
+// execMethod runs check and then method on the player with the given UUID in the player's world
+// transaction. method only runs if check, which may be nil, returns no error; the error is reported
+// for correlationID otherwise. In an atomic batch the call is recorded and run when the batch is
+// applied. It reports whether the player was found.
+func (m *Manager) execMethod(p *pluginProcess, correlationID string, id uuid.UUID, check func(pl *player.Player) error, method func(pl *player.Player)) bool {
 	if m.srv == nil {
-		return
+		return false
+	}
+	handle, ok := m.srv.Player(id)
+	if !ok {
+		return false
+	}
+	if p.atomic != nil {
+		p.atomic.add(playerOp(handle, check, method))
+		return true
 	}
-	if handle, ok := m.srv.Player(id); ok {
-		handle.ExecWorld(func(tx *world.Tx, e world.Entity) {
-			if pl, ok := e.(*player.Player); ok {
-				method(pl)
+	return handle.ExecWorld(func(tx *world.Tx, e world.Entity) {
+		pl, ok := e.(*player.Player)
+		if !ok {
+			return
+		}
+		if check != nil {
+			if err := check(pl); err != nil {
+				m.sendActionError(p, correlationID, err.Error())
+				return
 			}
-		})
+		}
+		method(pl)
+	})
+}
+
+var (
+	errPlayerNotFound = errors.New("player not found")
+	errTargetNotFound = errors.New("target not found")
+	errEntityNotFound = errors.New("entity not found")
+	errNoHeldItem     = errors.New("no held item")
+)
+
+// execPlayer runs method on the player with the UUID playerUUID and acknowledges the action once it
+// ran.
+func (m *Manager) execPlayer(p *pluginProcess, correlationID, playerUUID string, method func(pl *player.Player)) {
+	m.execPlayerResult(p, correlationID, playerUUID, func(pl *player.Player) (*pb.ActionResult, error) {
+		method(pl)
+		return nil, nil
+	})
+}
+
+// execPlayerResult runs method on the player with the UUID playerUUID. method returns the result to
+// send, nil to acknowledge the action, or an error to fail it. An invalid UUID or a player that is
+// not online fails the action.
+func (m *Manager) execPlayerResult(p *pluginProcess, correlationID, playerUUID string, method func(pl *player.Player) (*pb.ActionResult, error)) {
+	m.execPlayerChecked(p, correlationID, playerUUID, nil, method)
+}
+
+// execPlayerChecked is execPlayerResult with a check that fails the action before method runs. Any
+// condition method can fail on belongs in check, which must not change anything: in an atomic batch
+// the checks of every action run before any action is applied.
+func (m *Manager) execPlayerChecked(p *pluginProcess, correlationID, playerUUID string, check func(pl *player.Player) error, method func(pl *player.Player) (*pb.ActionResult, error)) {
+	id, err := uuid.Parse(playerUUID)
+	if err != nil {
+		m.sendActionError(p, correlationID, "invalid player_uuid")
+		return
+	}
+	found := m.execMethod(p, correlationID, id, check, func(pl *player.Player) {
+		result, err := method(pl)
+		m.reportActionResult(p, correlationID, result, err)
+	})
+	if !found {
+		m.sendActionError(p, correlationID, errPlayerNotFound.Error())
 	}
 }
 
-func (m *Manager) sendActionResult(p *pluginProcess, result *pb.ActionResult) {
-	if p == nil || result == nil || result.CorrelationId == "" {
+// reportActionResult sends the outcome of an action: an error if err is set, result with an ok
+// status if one was produced and a plain ok otherwise.
+func (m *Manager) reportActionResult(p *pluginProcess, correlationID string, result *pb.ActionResult, err error) {
+	switch {
+	case err != nil:
+		m.sendActionError(p, correlationID, err.Error())
+	case result != nil:
+		result.CorrelationId = correlationID
+		if result.Status == nil {
+			result.Status = &pb.ActionStatus{Ok: true}
+		}
+		m.sendActionResult(p, result)
+	default:
+		m.sendActionOK(p, correlationID)
+	}
+}
+
+// execWorld runs fn in a transaction of w and waits for it to finish. In an atomic batch the call
+// is recorded and run when the batch is applied.
+func (m *Manager) execWorld(p *pluginProcess, w *world.World, fn func(tx *world.Tx)) {
+	m.execWorldChecked(p, "", w, nil, fn)
+}
+
+// execWorldChecked is execWorld with a check that, if it returns an error, fails the action with
+// correlationID instead of running fn. Like the check of execPlayerChecked, it must not change
+// anything.
+func (m *Manager) execWorldChecked(p *pluginProcess, correlationID string, w *world.World, check func(tx *world.Tx) error, fn func(tx *world.Tx)) {
+	if p.atomic != nil {
+		p.atomic.add(atomicOp{world: w, check: check, apply: fn})
 		return
 	}
-	p.queue(&pb.HostToPlugin{
-		PluginId: p.id,
-		Payload:  &pb.HostToPlugin_ActionResult{ActionResult: result},
+	<-w.Exec(func(tx *world.Tx) {
+		if check != nil {
+			if err := check(tx); err != nil {
+				m.sendActionError(p, correlationID, err.Error())
+				return
+			}
+		}
+		fn(tx)
 	})
 }
 
-func (m *Manager) sendActionOK(p *pluginProcess, correlationID string) {
-	if correlationID == "" {
+// sendActionResult sends the result of an action with a correlation ID. While an atomic batch is
+// applied, results are collected for the combined batch result instead.
+func (m *Manager) sendActionResult(p *pluginProcess, result *pb.ActionResult) {
+	if p == nil || result == nil {
+		return
+	}
+	if p.atomic != nil {
+		p.atomic.record(result)
 		return
 	}
+	p.queueActionResult(result)
+}
+
+func (m *Manager) sendActionOK(p *pluginProcess, correlationID string) {
 	m.sendActionResult(p, &pb.ActionResult{CorrelationId: correlationID, Status: &pb.ActionStatus{Ok: true}})
 }
 
 func (m *Manager) sendActionError(p *pluginProcess, correlationID, msg string) {
-	if correlationID == "" {
-		return
-	}
-	m.sendActionResult(p, &pb.ActionResult{CorrelationId: correlationID, Status: &pb.ActionStatus{Ok: false, Error: &msg}})
+	m.sendActionResult(p, actionError(correlationID, msg))
+}
+
+func actionError(correlationID, msg string) *pb.ActionResult {
+	return &pb.ActionResult{CorrelationId: correlationID, Status: &pb.ActionStatus{Ok: false, Error: &msg}}
 }
 
 func soundFromProto(s pb.Sound) world.Sound {
@@ -2016,7 +1966,7 @@ func (m *Manager) handleWorldSetBiome(p *pluginProcess, correlationID string, ac
 		}
 	}
 	pos := cube.Pos{int(act.Position.X), int(act.Position.Y), int(act.Position.Z)}
-	<-w.Exec(func(tx *world.Tx) {
+	m.execWorld(p, w, func(tx *world.Tx) {
 		tx.SetBiome(pos, biome)
 	})
 	m.sendActionOK(p, correlationID)
@@ -2047,7 +1997,7 @@ func (m *Manager) handleWorldSetLiquid(p *pluginProcess, correlationID string, a
 			return
 		}
 	}
-	<-w.Exec(func(tx *world.Tx) {
+	m.execWorld(p, w, func(tx *world.Tx) {
 		tx.SetLiquid(pos, liquid)
 	})
 	m.sendActionOK(p, correlationID)
@@ -2074,7 +2024,7 @@ func (m *Manager) handleWorldScheduleBlockUpdate(p *pluginProcess, correlationID
 	}
 	pos := cube.Pos{int(act.Position.X), int(act.Position.Y), int(act.Position.Z)}
 	delay := time.Duration(act.DelayMs) * time.Millisecond
-	<-w.Exec(func(tx *world.Tx) {
+	m.execWorld(p, w, func(tx *world.Tx) {
 		tx.ScheduleBlockUpdate(pos, blk, delay)
 	})
 	m.sendActionOK(p, correlationID)
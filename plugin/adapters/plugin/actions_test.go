@@ -0,0 +1,98 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestPlayerActionErrors(t *testing.T) {
+	offline := uuid.NewString()
+	pos := &pb.Vec3{X: 1, Y: 64, Z: 1}
+	nan := math.NaN()
+	diamond := &pb.ItemStack{Name: "minecraft:diamond", Count: 1}
+	unknown := &pb.ItemStack{Name: "minecraft:not_an_item", Count: 1}
+	tests := []struct {
+		name   string
+		action *pb.Action
+		want   string
+	}{
+		{
+			name:   "teleport without position",
+			action: &pb.Action{Kind: &pb.Action_Teleport{Teleport: &pb.TeleportAction{PlayerUuid: offline}}},
+			want:   "invalid position",
+		},
+		{
+			name:   "teleport to NaN",
+			action: &pb.Action{Kind: &pb.Action_Teleport{Teleport: &pb.TeleportAction{PlayerUuid: offline, Position: &pb.Vec3{X: nan}}}},
+			want:   "invalid position",
+		},
+		{
+			name:   "teleport with NaN rotation",
+			action: &pb.Action{Kind: &pb.Action_Teleport{Teleport: &pb.TeleportAction{PlayerUuid: offline, Position: pos, Rotation: &pb.Vec3{Y: nan}}}},
+			want:   "invalid rotation",
+		},
+		{
+			name:   "teleport malformed UUID",
+			action: &pb.Action{Kind: &pb.Action_Teleport{Teleport: &pb.TeleportAction{PlayerUuid: "steve", Position: pos}}},
+			want:   "invalid player_uuid",
+		},
+		{
+			name:   "teleport offline player",
+			action: &pb.Action{Kind: &pb.Action_Teleport{Teleport: &pb.TeleportAction{PlayerUuid: offline, Position: pos}}},
+			want:   "player not found",
+		},
+		{
+			name:   "kick offline player",
+			action: &pb.Action{Kind: &pb.Action_Kick{Kick: &pb.KickAction{PlayerUuid: offline}}},
+			want:   "player not found",
+		},
+		{
+			name:   "unknown game mode",
+			action: &pb.Action{Kind: &pb.Action_SetGameMode{SetGameMode: &pb.SetGameModeAction{PlayerUuid: offline, GameMode: 99}}},
+			want:   "unknown game mode",
+		},
+		{
+			name:   "give invalid item",
+			action: &pb.Action{Kind: &pb.Action_GiveItem{GiveItem: &pb.GiveItemAction{PlayerUuid: offline, Item: unknown}}},
+			want:   "invalid item",
+		},
+		{
+			name:   "give to offline player",
+			action: &pb.Action{Kind: &pb.Action_GiveItem{GiveItem: &pb.GiveItemAction{PlayerUuid: offline, Item: diamond}}},
+			want:   "player not found",
+		},
+		{
+			name:   "hold invalid item",
+			action: &pb.Action{Kind: &pb.Action_SetHeldItem{SetHeldItem: &pb.SetHeldItemAction{PlayerUuid: offline, Main: unknown}}},
+			want:   "invalid main hand item",
+		},
+		{
+			name:   "clear offline inventory",
+			action: &pb.Action{Kind: &pb.Action_ClearInventory{ClearInventory: &pb.ClearInventoryAction{PlayerUuid: offline}}},
+			want:   "player not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "test")
+			correlationID := "action"
+			tt.action.CorrelationId = &correlationID
+
+			m.applyActions(p, &pb.ActionBatch{Actions: []*pb.Action{tt.action}})
+			results := actionResults(p)
+			if len(results) != 1 {
+				t.Fatalf("received %d results, want 1", len(results))
+			}
+			if res := results[0]; res.CorrelationId != correlationID || res.GetStatus().GetOk() || res.GetStatus().GetError() != tt.want {
+				t.Errorf("result = %v, want error %q", res, tt.want)
+			}
+		})
+	}
+}
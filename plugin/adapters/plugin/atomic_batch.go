@@ -0,0 +1,284 @@
+package plugin
+
+import (
+	"errors"
+
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// atomicBatch collects the operations and results of an atomic ActionBatch. Handlers run as usual
+// while the batch is validated, but execMethod and execWorldChecked record their callbacks as
+// operations instead of running them, so nothing is applied until every action passed validation.
+type atomicBatch struct {
+	// current is the index of the action whose handler or operation is running.
+	current int
+	ops     []atomicOp
+	results []*pb.ActionResult
+	// applied holds, by action index, whether an operation of the action was applied.
+	applied []bool
+}
+
+// atomicOp is a recorded callback of an action, run on a player or in a world transaction.
+type atomicOp struct {
+	index int
+
+	// player or world selects the transaction the operation runs in.
+	player *world.EntityHandle
+	world  *world.World
+
+	// check reports why the operation cannot be applied and may be nil. It must not change anything,
+	// as the checks of every operation run before any operation is applied.
+	check func(tx *world.Tx) error
+	apply func(tx *world.Tx)
+}
+
+// playerOp returns an operation running check and method on the player of handle.
+func playerOp(handle *world.EntityHandle, check func(pl *player.Player) error, method func(pl *player.Player)) atomicOp {
+	resolve := func(tx *world.Tx) (*player.Player, bool) {
+		e, ok := handle.Entity(tx)
+		if !ok {
+			return nil, false
+		}
+		pl, ok := e.(*player.Player)
+		return pl, ok
+	}
+	op := atomicOp{player: handle, apply: func(tx *world.Tx) {
+		if pl, ok := resolve(tx); ok {
+			method(pl)
+		}
+	}}
+	if check != nil {
+		op.check = func(tx *world.Tx) error {
+			pl, ok := resolve(tx)
+			if !ok {
+				return errPlayerNotFound
+			}
+			return check(pl)
+		}
+	}
+	return op
+}
+
+// runsIn reports whether op runs in the transaction tx.
+func (op atomicOp) runsIn(tx *world.Tx) bool {
+	if op.player != nil {
+		_, ok := op.player.Entity(tx)
+		return ok
+	}
+	return tx.World() == op.world
+}
+
+func (b *atomicBatch) add(op atomicOp) {
+	op.index = b.current
+	b.ops = append(b.ops, op)
+}
+
+// record stores the result of the current action. The first failure of an action is kept.
+func (b *atomicBatch) record(result *pb.ActionResult) {
+	if failed(b.results[b.current]) {
+		return
+	}
+	b.results[b.current] = result
+}
+
+func failed(result *pb.ActionResult) bool {
+	return result != nil && result.Status != nil && !result.Status.Ok
+}
+
+// atomicSupported reports whether an action can be part of an atomic batch. World settings are not
+// transactional and queries have no meaning before the batch is applied.
+func atomicSupported(action *pb.Action) bool {
+	switch kind := action.Kind.(type) {
+	case *pb.Action_SendChat:
+		// Broadcasts are written to every player directly rather than in a transaction.
+		return kind.SendChat.TargetUuid != ""
+	case *pb.Action_WorldSetDefaultGameMode, *pb.Action_WorldSetDifficulty, *pb.Action_WorldSetTickRange,
+		*pb.Action_WorldSetTime, *pb.Action_WorldStopTime, *pb.Action_WorldStartTime, *pb.Action_WorldSetSpawn:
+		return false
+	case *pb.Action_InventoryQuery:
+		// The inventory is read in the transaction, so the result reflects the earlier actions.
+		return true
+	}
+	return actionFamily(action) != "world.query"
+}
+
+// applyAtomicBatch validates every action of batch and, only if all of them are valid, applies
+// them. Actions on the same world run in a single transaction in batch order. One ActionResult
+// with a BatchResult holding the result of every action is sent for the batch.
+func (m *Manager) applyAtomicBatch(p *pluginProcess, batch *pb.ActionBatch) {
+	b := &atomicBatch{
+		results: make([]*pb.ActionResult, len(batch.Actions)),
+		applied: make([]bool, len(batch.Actions)),
+	}
+	p.atomic = b
+	defer func() { p.atomic = nil }()
+
+	valid := true
+	for i, action := range batch.Actions {
+		b.current = i
+		switch {
+		case action == nil:
+			m.sendActionError(p, "", "invalid action")
+		case !atomicSupported(action):
+			m.sendActionError(p, action.GetCorrelationId(), "action not supported in atomic batch")
+		default:
+			if ok, reason := p.permissions.check(actionFamily(action)); !ok {
+				m.sendActionError(p, action.GetCorrelationId(), reason)
+				break
+			}
+			ops := len(b.ops)
+			m.applyAction(p, action)
+			if len(b.ops) == ops && b.results[i] == nil {
+				// The handler rejected the action without reporting why, e.g. for a malformed UUID.
+				m.sendActionError(p, action.GetCorrelationId(), "invalid action")
+			}
+		}
+		if failed(b.results[i]) {
+			valid = false
+		}
+	}
+
+	if !valid {
+		p.log.Warn("rejected atomic batch", "reason", "validation failed", "correlation_id", batch.GetCorrelationId())
+		b.notApplied()
+	} else if index, err := b.run(); err != nil {
+		p.log.Warn("rejected atomic batch", "reason", err.Error(), "correlation_id", batch.GetCorrelationId())
+		b.fail(index, err)
+	}
+
+	for i, action := range batch.Actions {
+		if b.results[i] == nil {
+			b.results[i] = &pb.ActionResult{Status: &pb.ActionStatus{Ok: true}}
+		}
+		b.results[i].CorrelationId = action.GetCorrelationId()
+	}
+	p.queueActionResult(batchResult(batch, b.results))
+}
+
+// fail records err as the failure of the action at index, unless it already failed, and marks the
+// actions that were not applied.
+func (b *atomicBatch) fail(index int, err error) {
+	if !failed(b.results[index]) {
+		b.results[index] = actionError("", err.Error())
+	}
+	b.notApplied()
+}
+
+// notApplied marks every action without a failure that was not applied as "not applied".
+func (b *atomicBatch) notApplied() {
+	for i, result := range b.results {
+		if !failed(result) && !b.applied[i] {
+			b.results[i] = actionError("", "not applied")
+		}
+	}
+}
+
+// run runs the recorded operations of a validated batch. Every operation is checked first, and
+// only if all checks pass are the operations checked again and applied. On a single world both
+// happen in one transaction, so nothing is applied unless every check passed. A batch spanning
+// several worlds can still fail on one world after another was applied, if the world changed
+// between the checks and applying. An action may also fail while it is applied, after the actions
+// before it on its world were: the effects of these actions are kept and their results stay ok.
+// run stops at the first failure and returns the index of the failed action and the reason.
+func (b *atomicBatch) run() (int, error) {
+	if index, err := b.pass(false); err != nil {
+		return index, err
+	}
+	return b.pass(true)
+}
+
+// pass runs the checks of every operation and, if apply is set, applies the operations of each
+// world once all of its checks passed. Each transaction is opened for the first remaining
+// operation and handles every remaining operation of the same world, in batch order.
+func (b *atomicBatch) pass(apply bool) (int, error) {
+	pending := b.ops
+	for len(pending) > 0 {
+		first := pending[0]
+		var group, rest []atomicOp
+		index, err := first.index, error(nil)
+		run := func(tx *world.Tx) {
+			for _, op := range pending {
+				if op.runsIn(tx) {
+					group = append(group, op)
+				} else {
+					rest = append(rest, op)
+				}
+			}
+			for _, op := range group {
+				if op.check == nil {
+					continue
+				}
+				if err = op.check(tx); err != nil {
+					index = op.index
+					return
+				}
+			}
+			if !apply {
+				return
+			}
+			for _, op := range group {
+				b.current = op.index
+				op.apply(tx)
+				b.applied[op.index] = true
+				if result := b.results[op.index]; failed(result) {
+					index, err = op.index, errors.New(result.Status.GetError())
+					return
+				}
+			}
+		}
+		if first.player != nil {
+			first.player.ExecWorld(func(tx *world.Tx, _ world.Entity) { run(tx) })
+		} else {
+			<-first.world.Exec(run)
+		}
+		if len(group) == 0 {
+			// The player of the first operation left after validation.
+			return first.index, errPlayerNotFound
+		}
+		if err != nil {
+			return index, err
+		}
+		pending = rest
+	}
+	return 0, nil
+}
+
+// batchResult returns the combined result of an atomic batch. It is ok only if every action is. A
+// failed batch in which some actions still succeeded was partially applied: an action failed once
+// the actions before it on its world were applied, which reverting is not possible for.
+func batchResult(batch *pb.ActionBatch, results []*pb.ActionResult) *pb.ActionResult {
+	var ok, fail int
+	for _, result := range results {
+		if failed(result) {
+			fail++
+		} else {
+			ok++
+		}
+	}
+	status := &pb.ActionStatus{Ok: fail == 0}
+	if fail > 0 {
+		msg := "atomic batch failed"
+		if ok > 0 {
+			msg = "atomic batch partially applied"
+		}
+		status.Error = &msg
+	}
+	return &pb.ActionResult{
+		CorrelationId: batch.GetCorrelationId(),
+		Status:        status,
+		Result:        &pb.ActionResult_Batch{Batch: &pb.BatchResult{Results: results}},
+	}
+}
+
+// queueActionResult queues result for the plugin if it has a correlation ID.
+func (p *pluginProcess) queueActionResult(result *pb.ActionResult) {
+	if result.CorrelationId == "" {
+		return
+	}
+	p.queue(&pb.HostToPlugin{
+		PluginId: p.id,
+		Payload:  &pb.HostToPlugin_ActionResult{ActionResult: result},
+	})
+}
@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/df-mc/dragonfly/server/world"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// testOp describes a recorded operation of an action in TestAtomicBatchRun.
+type testOp struct {
+	world int
+	// checkErr fails the check of the operation, applyErr the operation once applied.
+	checkErr string
+	applyErr string
+}
+
+func TestAtomicBatchRun(t *testing.T) {
+	worlds := []*world.World{world.Config{}.New(), world.Config{}.New()}
+	t.Cleanup(func() {
+		for _, w := range worlds {
+			_ = w.Close()
+		}
+	})
+
+	tests := []struct {
+		name string
+		ops  []testOp
+		// applied lists the actions applied, in order.
+		applied []int
+		// failed is the index of the failed action, or -1.
+		failed  int
+		results []string
+		// status is the error of the combined result of the batch.
+		status string
+	}{
+		{
+			name:    "all applied",
+			ops:     []testOp{{}, {}, {}},
+			applied: []int{0, 1, 2},
+			failed:  -1,
+			results: []string{"", "", ""},
+		},
+		{
+			name:    "check fails",
+			ops:     []testOp{{}, {checkErr: "no sign at position"}, {}},
+			failed:  1,
+			results: []string{"not applied", "no sign at position", "not applied"},
+			status:  "atomic batch failed",
+		},
+		{
+			name:    "check fails on second world",
+			ops:     []testOp{{world: 0}, {world: 1}, {world: 0}, {world: 1, checkErr: "owner not found"}},
+			failed:  3,
+			results: []string{"not applied", "not applied", "not applied", "owner not found"},
+			status:  "atomic batch failed",
+		},
+		{
+			name:    "worlds applied in turn",
+			ops:     []testOp{{world: 1}, {world: 0}, {world: 1}},
+			applied: []int{0, 2, 1},
+			failed:  -1,
+			results: []string{"", "", ""},
+		},
+		{
+			name:    "apply fails",
+			ops:     []testOp{{world: 0}, {world: 0, applyErr: "entity not found"}, {world: 0}, {world: 1}},
+			applied: []int{0, 1},
+			failed:  1,
+			results: []string{"", "entity not found", "not applied", "not applied"},
+			status:  "atomic batch partially applied",
+		},
+		{
+			name:    "first apply fails",
+			ops:     []testOp{{world: 0, applyErr: "entity not found"}, {world: 0}},
+			applied: []int{0},
+			failed:  0,
+			results: []string{"entity not found", "not applied"},
+			status:  "atomic batch failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &atomicBatch{
+				results: make([]*pb.ActionResult, len(tt.ops)),
+				applied: make([]bool, len(tt.ops)),
+			}
+			var applied []int
+			for i, op := range tt.ops {
+				b.current = i
+				b.add(atomicOp{
+					world: worlds[op.world],
+					check: func(*world.Tx) error {
+						if op.checkErr != "" {
+							return errors.New(op.checkErr)
+						}
+						return nil
+					},
+					apply: func(*world.Tx) {
+						applied = append(applied, i)
+						if op.applyErr != "" {
+							b.record(actionError("", op.applyErr))
+						}
+					},
+				})
+			}
+
+			index, err := b.run()
+			if err != nil {
+				b.fail(index, err)
+			} else {
+				index = -1
+			}
+
+			if index != tt.failed {
+				t.Errorf("failed action = %d, want %d", index, tt.failed)
+			}
+			if !reflect.DeepEqual(applied, tt.applied) {
+				t.Errorf("applied = %v, want %v", applied, tt.applied)
+			}
+			results := make([]string, len(b.results))
+			for i, result := range b.results {
+				results[i] = result.GetStatus().GetError()
+			}
+			if !reflect.DeepEqual(results, tt.results) {
+				t.Errorf("results = %q, want %q", results, tt.results)
+			}
+			status := batchResult(&pb.ActionBatch{}, b.results).GetStatus()
+			if status.GetOk() != (tt.status == "") || status.GetError() != tt.status {
+				t.Errorf("batch status = %v, want error %q", status, tt.status)
+			}
+		})
+	}
+}
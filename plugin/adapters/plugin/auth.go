@@ -0,0 +1,53 @@
+package plugin
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/secmc/plugin/plugin/config"
+)
+
+// tokenMetadataKey is the gRPC metadata key a plugin may use to send its token instead of
+// PluginToHost.auth_token.
+const tokenMetadataKey = "df-plugin-token"
+
+// pluginToken returns the connection secret for a plugin: the configured token, or a generated
+// one for plugins the host launches itself. External plugins without a token get none.
+func pluginToken(cfg config.PluginConfig) string {
+	if cfg.Token != "" || cfg.Command == "" {
+		return cfg.Token
+	}
+	return rand.Text()
+}
+
+// checkAuthentication reports an error if connections of the plugin configured by pc could not be
+// authenticated: an external plugin needs a token or mutual TLS, unless it opts out with insecure.
+func (m *Manager) checkAuthentication(pc config.PluginConfig) error {
+	if pc.Command != "" || pc.Token != "" || m.cfg.TLS.ClientCAFile != "" || pc.Insecure {
+		return nil
+	}
+	return fmt.Errorf("external plugin %q has no token: set token, configure tls.client_ca_file or set insecure: true", pc.ID)
+}
+
+// authenticate reports whether token is the plugin's secret. Plugins without a secret accept
+// any token.
+func (p *pluginProcess) authenticate(token string) bool {
+	if p.token == "" {
+		return true
+	}
+	return subtle.ConstantTimeCompare([]byte(token), []byte(p.token)) == 1
+}
+
+// pluginForIdentities returns the ID of the first loaded plugin named by a client certificate
+// identity, or "" if there is none.
+func (m *Manager) pluginForIdentities(identities []string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, id := range identities {
+		if _, ok := m.plugins[id]; ok {
+			return id
+		}
+	}
+	return ""
+}
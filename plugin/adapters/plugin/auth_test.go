@@ -0,0 +1,33 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/secmc/plugin/plugin/config"
+)
+
+func TestCheckAuthentication(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugin  config.PluginConfig
+		mtls    bool
+		wantErr bool
+	}{
+		{"launched", config.PluginConfig{ID: "a", Command: "node"}, false, false},
+		{"external with token", config.PluginConfig{ID: "a", Token: "secret"}, false, false},
+		{"external without token", config.PluginConfig{ID: "a"}, false, true},
+		{"external with mutual tls", config.PluginConfig{ID: "a"}, true, false},
+		{"insecure external", config.PluginConfig{ID: "a", Insecure: true}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Manager{}
+			if tt.mtls {
+				m.cfg.TLS = config.TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem", ClientCAFile: "ca.pem"}
+			}
+			if err := m.checkAuthentication(tt.plugin); (err != nil) != tt.wantErr {
+				t.Errorf("checkAuthentication = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"time"
+
+	"github.com/secmc/plugin/plugin/adapters/grpc"
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+const (
+	defaultActionQueueSize   = 1024
+	defaultQueueBlockTimeout = 100 * time.Millisecond
+)
+
+// queueLimits are the queue sizes and overflow policy of a plugin.
+type queueLimits struct {
+	eventSize    int
+	actionSize   int
+	overflow     string
+	blockTimeout time.Duration
+}
+
+func newQueueLimits(cfg config.QueueConfig) queueLimits {
+	l := queueLimits{
+		eventSize:    cfg.EventSize,
+		actionSize:   cfg.ActionSize,
+		overflow:     cfg.Overflow,
+		blockTimeout: time.Duration(cfg.BlockTimeoutMs) * time.Millisecond,
+	}
+	if l.eventSize <= 0 {
+		l.eventSize = sendChannelBuffer
+	}
+	if l.actionSize <= 0 {
+		l.actionSize = defaultActionQueueSize
+	}
+	if l.overflow == "" {
+		l.overflow = config.OverflowDropNewest
+	}
+	if l.blockTimeout <= 0 {
+		l.blockTimeout = defaultQueueBlockTimeout
+	}
+	return l
+}
+
+// sendOverflow handles a message that did not fit into the full send queue.
+func (p *pluginProcess) sendOverflow(msg *pb.HostToPlugin) {
+	switch p.limits.overflow {
+	case config.OverflowDropOldest:
+		select {
+		case old := <-p.sendCh:
+			p.dropMessage(old)
+		default:
+		}
+		select {
+		case p.sendCh <- msg:
+		default:
+			p.dropMessage(msg)
+		}
+	case config.OverflowBlock:
+		timer := time.NewTimer(p.limits.blockTimeout)
+		defer timer.Stop()
+		select {
+		case p.sendCh <- msg:
+		case <-timer.C:
+			p.dropMessage(msg)
+		case <-p.done:
+		}
+	case config.OverflowDisconnect:
+		p.dropMessage(msg)
+		p.disconnectOverflow("send queue full")
+	default:
+		p.dropMessage(msg)
+	}
+}
+
+// dropMessage counts a message dropped from or instead of the send queue. Dropped events are
+// reported to the plugin with an EventsDropped notice once the queue drains.
+func (p *pluginProcess) dropMessage(msg *pb.HostToPlugin) {
+	var events uint64
+	switch {
+	case msg.GetEvent() != nil:
+		events = 1
+	case msg.GetEventBatch() != nil:
+		events = uint64(len(msg.GetEventBatch().Events))
+	}
+	if events == 0 {
+		p.log.Warn("dropping message", "reason", "queue full")
+		return
+	}
+	p.droppedEvents.Add(events)
+	if p.missedEvents.Add(events) == events {
+		p.log.Warn("dropping events", "reason", "queue full", "policy", p.limits.overflow)
+	}
+}
+
+// sendDroppedNotice tells the plugin how many events it missed since the last notice, if any.
+// It is called by sendLoop, so the notice bypasses the queue.
+func (p *pluginProcess) sendDroppedNotice(stream *grpc.GrpcStream) bool {
+	n := p.missedEvents.Swap(0)
+	if n == 0 {
+		return true
+	}
+	total := p.droppedEvents.Load()
+	p.log.Warn("plugin missed events", "count", n, "total", total)
+	return p.send(stream, &pb.HostToPlugin{
+		PluginId: p.id,
+		Payload: &pb.HostToPlugin_EventsDropped{
+			EventsDropped: &pb.EventsDropped{Count: n, Total: total},
+		},
+	})
+}
+
+// makeRoomForActions applies the overflow policy when the action queue is full. It must be called
+// with actionsMu held and returns with it held. ok reports whether the new batch may be queued;
+// dropped is the oldest batch if the policy evicted it, to be rejected once the lock is released.
+func (p *pluginProcess) makeRoomForActions() (dropped *pb.ActionBatch, ok bool) {
+	if len(p.actionsQueue) < p.limits.actionSize {
+		return nil, true
+	}
+	switch p.limits.overflow {
+	case config.OverflowDropOldest:
+		dropped = p.actionsQueue[0]
+		p.actionsQueue = p.actionsQueue[1:]
+		p.actionsPending.Add(-1)
+		return dropped, true
+	case config.OverflowBlock:
+		// Blocking the receive loop pushes back on the plugin through gRPC flow control.
+		deadline := time.Now().Add(p.limits.blockTimeout)
+		for len(p.actionsQueue) >= p.limits.actionSize {
+			wait := time.Until(deadline)
+			if wait <= 0 {
+				return nil, false
+			}
+			p.actionsMu.Unlock()
+			timer := time.NewTimer(wait)
+			select {
+			case <-p.actionsFreed:
+			case <-timer.C:
+			case <-p.done:
+			}
+			timer.Stop()
+			p.actionsMu.Lock()
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// rejectActions counts the actions of a dropped batch and fails those with a correlation ID. A
+// dropped atomic batch gets a single combined result instead. The results are queued directly as
+// the caller is not the actions worker.
+func (p *pluginProcess) rejectActions(batch *pb.ActionBatch) {
+	total := p.droppedActions.Add(uint64(len(batch.GetActions())))
+	p.log.Warn("dropping actions", "reason", "queue full", "policy", p.limits.overflow,
+		"count", len(batch.GetActions()), "total", total)
+	if batch.GetAtomic() {
+		results := make([]*pb.ActionResult, len(batch.GetActions()))
+		for i, action := range batch.GetActions() {
+			results[i] = actionError(action.GetCorrelationId(), "action queue full")
+		}
+		p.queueActionResult(batchResult(batch, results))
+		return
+	}
+	for _, action := range batch.GetActions() {
+		p.queueActionResult(actionError(action.GetCorrelationId(), "action queue full"))
+	}
+}
+
+// disconnectOverflow closes the plugin's stream because a queue overflowed. The plugin may
+// reconnect and then receives the messages that are still queued.
+func (p *pluginProcess) disconnectOverflow(reason string) {
+	if !p.connected.Load() {
+		return
+	}
+	p.log.Warn("disconnecting plugin", "reason", reason,
+		"dropped_events", p.droppedEvents.Load(), "dropped_actions", p.droppedActions.Load())
+	p.clearStream()
+}
+
+// droppedCounts returns how many events and actions of a plugin were dropped since it was loaded.
+func (m *Manager) droppedCounts(id string) (events, actions uint64) {
+	m.mu.RLock()
+	proc, ok := m.plugins[id]
+	m.mu.RUnlock()
+	if !ok {
+		return 0, 0
+	}
+	return proc.droppedEvents.Load(), proc.droppedActions.Load()
+}
@@ -0,0 +1,195 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestNewQueueLimits(t *testing.T) {
+	l := newQueueLimits(config.QueueConfig{})
+	if l.eventSize != sendChannelBuffer || l.actionSize != defaultActionQueueSize || l.overflow != config.OverflowDropNewest || l.blockTimeout != defaultQueueBlockTimeout {
+		t.Errorf("newQueueLimits of an empty config = %+v, want the defaults", l)
+	}
+	l = newQueueLimits(config.QueueConfig{EventSize: 8, ActionSize: 4, Overflow: config.OverflowBlock, BlockTimeoutMs: 20})
+	if l.eventSize != 8 || l.actionSize != 4 || l.overflow != config.OverflowBlock || l.blockTimeout != 20*time.Millisecond {
+		t.Errorf("newQueueLimits = %+v, want the configured limits", l)
+	}
+}
+
+// newQueueTestPlugin returns a test plugin whose queues hold two events and one action batch,
+// overflowing with the policy passed.
+func newQueueTestPlugin(overflow string, blockTimeout time.Duration) *pluginProcess {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	p := newTestPlugin(m, "test")
+	p.sendCh = make(chan *pb.HostToPlugin, 2)
+	p.actionsNotify = make(chan struct{}, 1)
+	p.actionsFreed = make(chan struct{}, 1)
+	p.limits = queueLimits{eventSize: 2, actionSize: 1, overflow: overflow, blockTimeout: blockTimeout}
+	return p
+}
+
+// testEvent returns an event message with the event ID passed.
+func testEvent(id string) *pb.HostToPlugin {
+	return &pb.HostToPlugin{Payload: &pb.HostToPlugin_Event{Event: &pb.EventEnvelope{EventId: id}}}
+}
+
+// testBatch returns a batch of a single action with the correlation ID passed.
+func testBatch(correlationID string) *pb.ActionBatch {
+	return &pb.ActionBatch{Actions: []*pb.Action{{CorrelationId: &correlationID}}}
+}
+
+func TestSendOverflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		overflow string
+		// read takes a message off the full queue while the overflowing event waits.
+		read          bool
+		want          []string
+		wantDropped   uint64
+		wantConnected bool
+	}{
+		{name: "drop newest", overflow: config.OverflowDropNewest, want: []string{"1", "2"}, wantDropped: 1, wantConnected: true},
+		{name: "drop oldest", overflow: config.OverflowDropOldest, want: []string{"2", "3"}, wantDropped: 1, wantConnected: true},
+		{name: "block until timeout", overflow: config.OverflowBlock, want: []string{"1", "2"}, wantDropped: 1, wantConnected: true},
+		{name: "block until read", overflow: config.OverflowBlock, read: true, want: []string{"2", "3"}, wantConnected: true},
+		{name: "disconnect", overflow: config.OverflowDisconnect, want: []string{"1", "2"}, wantDropped: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeout := 20 * time.Millisecond
+			if tt.read {
+				timeout = 5 * time.Second
+			}
+			p := newQueueTestPlugin(tt.overflow, timeout)
+			p.queue(testEvent("1"))
+			p.queue(testEvent("2"))
+
+			var read []string
+			if tt.read {
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					time.Sleep(10 * time.Millisecond)
+					read = append(read, (<-p.sendCh).GetEvent().EventId)
+				}()
+				p.queue(testEvent("3"))
+				<-done
+				if !slices.Equal(read, []string{"1"}) {
+					t.Fatalf("read %v off the queue, want the first event", read)
+				}
+			} else {
+				p.queue(testEvent("3"))
+			}
+
+			var queued []string
+			for _, evt := range events(p) {
+				queued = append(queued, evt.EventId)
+			}
+			if !slices.Equal(queued, tt.want) {
+				t.Errorf("queued events %v, want %v", queued, tt.want)
+			}
+			if got := p.droppedEvents.Load(); got != tt.wantDropped {
+				t.Errorf("dropped %d events, want %d", got, tt.wantDropped)
+			}
+			if got := p.missedEvents.Load(); got != tt.wantDropped {
+				t.Errorf("missed %d events, want %d", got, tt.wantDropped)
+			}
+			if got := p.connected.Load(); got != tt.wantConnected {
+				t.Errorf("connected = %v, want %v", got, tt.wantConnected)
+			}
+		})
+	}
+}
+
+func TestDropMessage(t *testing.T) {
+	p := newQueueTestPlugin(config.OverflowDropNewest, time.Millisecond)
+	p.dropMessage(&pb.HostToPlugin{Payload: &pb.HostToPlugin_PluginMessage{PluginMessage: &pb.PluginMessage{}}})
+	if got := p.droppedEvents.Load(); got != 0 {
+		t.Errorf("dropped %d events for a plugin message, want 0", got)
+	}
+	p.dropMessage(&pb.HostToPlugin{Payload: &pb.HostToPlugin_EventBatch{EventBatch: &pb.EventBatch{
+		Events: []*pb.EventEnvelope{{}, {}, {}},
+	}}})
+	if got := p.droppedEvents.Load(); got != 3 {
+		t.Errorf("dropped %d events for a batch of 3, want 3", got)
+	}
+}
+
+func TestEnqueueActionsOverflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		overflow string
+		// queued is the correlation ID of the batch left in the queue.
+		queued        string
+		rejected      string
+		wantConnected bool
+	}{
+		{name: "drop newest", overflow: config.OverflowDropNewest, queued: "1", rejected: "2", wantConnected: true},
+		{name: "drop oldest", overflow: config.OverflowDropOldest, queued: "2", rejected: "1", wantConnected: true},
+		{name: "block until timeout", overflow: config.OverflowBlock, queued: "1", rejected: "2", wantConnected: true},
+		{name: "disconnect", overflow: config.OverflowDisconnect, queued: "1", rejected: "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newQueueTestPlugin(tt.overflow, 20*time.Millisecond)
+			for _, id := range []string{"1", "2"} {
+				p.enqueueActions(testBatch(id))
+			}
+
+			if len(p.actionsQueue) != 1 || p.actionsQueue[0].Actions[0].GetCorrelationId() != tt.queued {
+				t.Errorf("queued %v, want the batch %s", p.actionsQueue, tt.queued)
+			}
+			if got := p.actionsPending.Load(); got != 1 {
+				t.Errorf("%d batches pending, want 1", got)
+			}
+			if got := p.droppedActions.Load(); got != 1 {
+				t.Errorf("dropped %d actions, want 1", got)
+			}
+			var rejected []*pb.ActionResult
+			for {
+				select {
+				case msg := <-p.sendCh:
+					rejected = append(rejected, msg.GetActionResult())
+					continue
+				default:
+				}
+				break
+			}
+			if len(rejected) != 1 || rejected[0].CorrelationId != tt.rejected || rejected[0].GetStatus().GetError() != "action queue full" {
+				t.Errorf("rejected %v, want the batch %s", rejected, tt.rejected)
+			}
+			if got := p.connected.Load(); got != tt.wantConnected {
+				t.Errorf("connected = %v, want %v", got, tt.wantConnected)
+			}
+		})
+	}
+}
+
+func TestEnqueueActionsBlockUntilFreed(t *testing.T) {
+	p := newQueueTestPlugin(config.OverflowBlock, 5*time.Second)
+	p.enqueueActions(testBatch("1"))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		p.actionsMu.Lock()
+		p.actionsQueue = p.actionsQueue[1:]
+		p.actionsPending.Add(-1)
+		p.actionsMu.Unlock()
+		p.actionsFreed <- struct{}{}
+	}()
+	p.enqueueActions(testBatch("2"))
+
+	p.actionsMu.Lock()
+	defer p.actionsMu.Unlock()
+	if len(p.actionsQueue) != 1 || p.actionsQueue[0].Actions[0].GetCorrelationId() != "2" {
+		t.Errorf("queued %v, want the second batch once the first was taken", p.actionsQueue)
+	}
+	if got := p.droppedActions.Load(); got != 0 {
+		t.Errorf("dropped %d actions, want 0", got)
+	}
+}
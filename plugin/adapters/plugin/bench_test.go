@@ -73,7 +73,7 @@ func recvHostToPlugin(t testing.TB, cs grpc.ClientStream) *pb.HostToPlugin {
 func setupManagerAndPlugin(t testing.TB) (*Manager, grpc.ClientConnInterface, grpc.ClientStream, func()) {
 	t.Helper()
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
-	m := NewManager(nil, logger, nil, nil)
+	m := NewManager(nil, logger, nil, nil, nil)
 
 	// Start manager on an ephemeral TCP port with one plugin slot (no process launch).
 	const pluginID = "bench-plugin"
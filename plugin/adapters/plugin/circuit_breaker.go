@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+const (
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 10 * time.Second
+)
+
+// circuitBreaker tracks consecutive event result timeouts for a plugin. Once the threshold is
+// reached the breaker opens and the host stops waiting for the plugin's results. After the
+// cooldown a single event is awaited again as a probe; a reply closes the breaker.
+type circuitBreaker struct {
+	disabled  bool
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	open     bool
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(cfg config.CircuitBreakerConfig) *circuitBreaker {
+	b := &circuitBreaker{
+		disabled:  cfg.Disabled,
+		threshold: cfg.Threshold,
+		cooldown:  time.Duration(cfg.CooldownMs) * time.Millisecond,
+	}
+	if b.threshold <= 0 {
+		b.threshold = defaultBreakerThreshold
+	}
+	if b.cooldown <= 0 {
+		b.cooldown = defaultBreakerCooldown
+	}
+	return b
+}
+
+// allow reports whether the host should wait for the plugin's event result.
+func (b *circuitBreaker) allow() bool {
+	if b.disabled {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open {
+		return true
+	}
+	if !b.probing && time.Since(b.openedAt) >= b.cooldown {
+		b.probing = true
+		return true
+	}
+	return false
+}
+
+// recordSuccess resets the failure count and reports whether the breaker closed.
+func (b *circuitBreaker) recordSuccess() (closed bool) {
+	if b.disabled {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	closed = b.open
+	b.open, b.probing = false, false
+	return closed
+}
+
+// recordTimeout counts a timeout and reports whether it opened the breaker.
+func (b *circuitBreaker) recordTimeout() (opened bool, failures int) {
+	if b.disabled {
+		return false, 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.open {
+		// A failed probe keeps the breaker open for another cooldown.
+		b.openedAt, b.probing = time.Now(), false
+		return false, b.failures
+	}
+	if b.failures >= b.threshold {
+		b.open, b.openedAt = true, time.Now()
+		return true, b.failures
+	}
+	return false, b.failures
+}
+
+// parseEventTimeouts converts per-event timeout overrides keyed by EventType name.
+// Unknown names are returned separately so the caller can report them.
+func parseEventTimeouts(raw map[string]int) (map[pb.EventType]time.Duration, []string) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	out := make(map[pb.EventType]time.Duration, len(raw))
+	var unknown []string
+	for name, ms := range raw {
+		v, ok := pb.EventType_value[strings.ToUpper(name)]
+		if !ok || ms <= 0 {
+			unknown = append(unknown, name)
+			continue
+		}
+		out[pb.EventType(v)] = time.Duration(ms) * time.Millisecond
+	}
+	return out, unknown
+}
+
+// eventTimeout returns how long to wait for the plugin's result to an event of type t.
+func (p *pluginProcess) eventTimeout(t pb.EventType) time.Duration {
+	if d, ok := p.eventTimeouts[t]; ok {
+		return d
+	}
+	if p.cfg.EventTimeoutMs > 0 {
+		return time.Duration(p.cfg.EventTimeoutMs) * time.Millisecond
+	}
+	return eventResponseTimeout
+}
+
+// recordEventTimeout feeds a result timeout into the plugin's circuit breaker.
+func (m *Manager) recordEventTimeout(p *pluginProcess) {
+	opened, failures := p.breaker.recordTimeout()
+	if !opened {
+		return
+	}
+	p.log.Warn("circuit breaker opened, sending events without waiting for results", "consecutive_timeouts", failures)
+	m.broadcastCircuitState(p, true, failures)
+}
+
+// recordEventSuccess feeds a received result into the plugin's circuit breaker.
+func (m *Manager) recordEventSuccess(p *pluginProcess) {
+	if !p.breaker.recordSuccess() {
+		return
+	}
+	p.log.Info("circuit breaker closed, plugin is responding again")
+	m.broadcastCircuitState(p, false, 0)
+}
+
+func (m *Manager) broadcastCircuitState(p *pluginProcess, open bool, failures int) {
+	m.broadcastEvent(&pb.EventEnvelope{
+		Type: pb.EventType_PLUGIN_CIRCUIT_STATE,
+		Payload: &pb.EventEnvelope_PluginCircuitState{
+			PluginCircuitState: &pb.PluginCircuitStateEvent{
+				PluginId:            p.id,
+				Open:                open,
+				ConsecutiveTimeouts: int32(failures),
+			},
+		},
+	})
+}
+
+// fireAndForget returns a copy of envelope that does not ask the plugin for a result.
+func fireAndForget(envelope *pb.EventEnvelope) *pb.EventEnvelope {
+	out := proto.Clone(envelope).(*pb.EventEnvelope)
+	out.ExpectsResponse = false
+	return out
+}
@@ -0,0 +1,173 @@
+package plugin
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestNewCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           config.CircuitBreakerConfig
+		wantThreshold int
+		wantCooldown  time.Duration
+	}{
+		{"defaults", config.CircuitBreakerConfig{}, defaultBreakerThreshold, defaultBreakerCooldown},
+		{"configured", config.CircuitBreakerConfig{Threshold: 2, CooldownMs: 500}, 2, 500 * time.Millisecond},
+		{"negative", config.CircuitBreakerConfig{Threshold: -1, CooldownMs: -1}, defaultBreakerThreshold, defaultBreakerCooldown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newCircuitBreaker(tt.cfg)
+			if b.threshold != tt.wantThreshold || b.cooldown != tt.wantCooldown {
+				t.Errorf("breaker has threshold %d and cooldown %v, want %d and %v", b.threshold, b.cooldown, tt.wantThreshold, tt.wantCooldown)
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	// step is something that happens to the breaker, followed by the outcome expected of it.
+	type step struct {
+		// do is one of "timeout", "success", "allow" or "cooldown". cooldown makes the cooldown of
+		// the breaker pass.
+		do string
+		// want is the result of timeout (opened), success (closed) or allow.
+		want bool
+	}
+	tests := []struct {
+		name     string
+		disabled bool
+		steps    []step
+	}{
+		{
+			name: "opens at the threshold",
+			steps: []step{
+				{"timeout", false}, {"allow", true},
+				{"timeout", false}, {"allow", true},
+				{"timeout", true}, {"allow", false},
+			},
+		},
+		{
+			name: "success resets the failures",
+			steps: []step{
+				{"timeout", false}, {"timeout", false}, {"success", false},
+				{"timeout", false}, {"timeout", false}, {"allow", true},
+			},
+		},
+		{
+			name: "probe after the cooldown",
+			steps: []step{
+				{"timeout", false}, {"timeout", false}, {"timeout", true},
+				{"cooldown", false},
+				// A single event is awaited as a probe.
+				{"allow", true}, {"allow", false},
+				{"success", true}, {"allow", true}, {"allow", true},
+			},
+		},
+		{
+			name: "failed probe",
+			steps: []step{
+				{"timeout", false}, {"timeout", false}, {"timeout", true},
+				{"cooldown", false}, {"allow", true},
+				// The breaker stays open and waits another cooldown.
+				{"timeout", false}, {"allow", false},
+				{"cooldown", false}, {"allow", true},
+			},
+		},
+		{
+			name:     "disabled",
+			disabled: true,
+			steps: []step{
+				{"timeout", false}, {"timeout", false}, {"timeout", false}, {"timeout", false},
+				{"allow", true}, {"success", false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newCircuitBreaker(config.CircuitBreakerConfig{Disabled: tt.disabled, Threshold: 3, CooldownMs: 60000})
+			for i, s := range tt.steps {
+				var got bool
+				switch s.do {
+				case "timeout":
+					got, _ = b.recordTimeout()
+				case "success":
+					got = b.recordSuccess()
+				case "allow":
+					got = b.allow()
+				case "cooldown":
+					b.mu.Lock()
+					b.openedAt = b.openedAt.Add(-b.cooldown)
+					b.mu.Unlock()
+				}
+				if got != s.want {
+					t.Errorf("step %d: %s = %v, want %v", i, s.do, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseEventTimeouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         map[string]int
+		want        map[pb.EventType]time.Duration
+		wantUnknown []string
+	}{
+		{name: "empty"},
+		{
+			name: "names in any case",
+			raw:  map[string]int{"CHAT": 100, "player_join": 2000},
+			want: map[pb.EventType]time.Duration{
+				pb.EventType_CHAT:        100 * time.Millisecond,
+				pb.EventType_PLAYER_JOIN: 2 * time.Second,
+			},
+		},
+		{
+			name:        "unknown and invalid",
+			raw:         map[string]int{"CHAT": 100, "NOT_AN_EVENT": 100, "PLAYER_JUMP": 0, "PLAYER_QUIT": -5},
+			want:        map[pb.EventType]time.Duration{pb.EventType_CHAT: 100 * time.Millisecond},
+			wantUnknown: []string{"NOT_AN_EVENT", "PLAYER_JUMP", "PLAYER_QUIT"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, unknown := parseEventTimeouts(tt.raw)
+			if len(got) != len(tt.want) {
+				t.Errorf("parseEventTimeouts = %v, want %v", got, tt.want)
+			}
+			for eventType, d := range tt.want {
+				if got[eventType] != d {
+					t.Errorf("timeout of %v = %v, want %v", eventType, got[eventType], d)
+				}
+			}
+			slices.Sort(unknown)
+			if !slices.Equal(unknown, tt.wantUnknown) {
+				t.Errorf("unknown = %v, want %v", unknown, tt.wantUnknown)
+			}
+		})
+	}
+}
+
+func TestEventTimeout(t *testing.T) {
+	p := &pluginProcess{
+		cfg:           config.PluginConfig{EventTimeoutMs: 300},
+		eventTimeouts: map[pb.EventType]time.Duration{pb.EventType_CHAT: 50 * time.Millisecond},
+	}
+	if got := p.eventTimeout(pb.EventType_CHAT); got != 50*time.Millisecond {
+		t.Errorf("timeout of CHAT = %v, want the override of 50ms", got)
+	}
+	if got := p.eventTimeout(pb.EventType_PLAYER_JOIN); got != 300*time.Millisecond {
+		t.Errorf("timeout of PLAYER_JOIN = %v, want the plugin timeout of 300ms", got)
+	}
+	p.cfg.EventTimeoutMs = 0
+	if got := p.eventTimeout(pb.EventType_PLAYER_JOIN); got != eventResponseTimeout {
+		t.Errorf("timeout of PLAYER_JOIN = %v, want the default of %v", got, eventResponseTimeout)
+	}
+}
@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"maps"
 	"slices"
 	"sort"
 	"strings"
@@ -11,7 +12,12 @@ import (
 	pb "github.com/secmc/plugin/proto/generated/go"
 )
 
+// registerCommands binds the commands declared in a plugin's Hello. Bindings left over from an
+// earlier Hello of the same plugin are replaced in one step, so a restarted plugin never has a
+// mix of old and new commands.
 func (m *Manager) registerCommands(p *pluginProcess, specs []*pb.CommandSpec) {
+	bindings := make(map[string]commandBinding)
+	var commands []cmd.Command
 	for _, spec := range specs {
 		if spec == nil || spec.Name == "" {
 			continue
@@ -28,12 +34,10 @@ func (m *Manager) registerCommands(p *pluginProcess, specs []*pb.CommandSpec) {
 		}
 
 		binding := commandBinding{pluginID: p.id, command: name, descriptor: spec}
-		m.mu.Lock()
-		m.commands[name] = binding
+		bindings[name] = binding
 		for _, alias := range aliases {
-			m.commands[alias] = binding
+			bindings[alias] = binding
 		}
-		m.mu.Unlock()
 
 		pc := pluginCommand{
 			mgr:      m,
@@ -41,7 +45,20 @@ func (m *Manager) registerCommands(p *pluginProcess, specs []*pb.CommandSpec) {
 			name:     name,
 			params:   buildParamInfo(spec),
 		}
-		cmd.Register(cmd.New(name, spec.Description, aliases, pc))
+		commands = append(commands, cmd.New(name, spec.Description, aliases, pc))
+	}
+
+	m.mu.Lock()
+	for name, binding := range m.commands {
+		if binding.pluginID == p.id {
+			delete(m.commands, name)
+		}
+	}
+	maps.Copy(m.commands, bindings)
+	m.mu.Unlock()
+
+	for _, c := range commands {
+		cmd.Register(c)
 	}
 }
 
@@ -64,6 +81,15 @@ func (c pluginCommand) Run(src cmd.Source, output *cmd.Output, tx *world.Tx) {
 	// No-op: PlayerHandler.HandleCommandExecution emits command events
 }
 
+// Allow reports whether the command is still bound to the plugin. Dragonfly has no way to
+// unregister a command, so commands of unloaded plugins are hidden and rejected instead.
+func (c pluginCommand) Allow(cmd.Source) bool {
+	c.mgr.mu.RLock()
+	binding, ok := c.mgr.commands[c.name]
+	c.mgr.mu.RUnlock()
+	return ok && binding.pluginID == c.pluginID
+}
+
 // DescribeParams exposes parameter info to Dragonfly so the client can render usage and enums.
 func (c pluginCommand) DescribeParams(src cmd.Source) []cmd.ParamInfo {
 	return c.params
@@ -0,0 +1,142 @@
+package plugin
+
+import (
+	"sync"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/session"
+	"github.com/google/uuid"
+	"github.com/sandertv/gophertunnel/minecraft"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+)
+
+// playerConn wraps the connection of a player to observe the container windows it opens and closes,
+// which Dragonfly has no handlers for, and to drive the virtual inventories of the player.
+type playerConn struct {
+	session.Conn
+	// raw is the wrapped connection, which the listener needs to disconnect the player.
+	raw *minecraft.Conn
+	m   *Manager
+	id  uuid.UUID
+
+	mu sync.Mutex
+	// open, window and pos describe the container block the session has open.
+	open   bool
+	window byte
+	pos    cube.Pos
+	// virtual is the virtual inventory the player has open, if any.
+	virtual *virtualInventory
+
+	// after holds functions to run on the next read, once the session handled the last packet read.
+	// It is only accessed by the goroutine reading packets.
+	after []func()
+}
+
+// WrapConn wraps the connection of a player that is about to join. The listener passes every
+// accepted connection through it.
+func (m *Manager) WrapConn(conn *minecraft.Conn) session.Conn {
+	id, err := uuid.Parse(conn.IdentityData().Identity)
+	if err != nil {
+		return conn
+	}
+	c := &playerConn{Conn: conn, raw: conn, m: m, id: id}
+	m.mu.Lock()
+	m.conns[id] = c
+	m.mu.Unlock()
+	return c
+}
+
+// playerConn returns the wrapped connection of a player, or nil if it was not wrapped.
+func (m *Manager) playerConn(id uuid.UUID) *playerConn {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.conns[id]
+}
+
+// Unwrap returns the underlying connection.
+func (c *playerConn) Unwrap() *minecraft.Conn {
+	return c.raw
+}
+
+// ReadPacket reads the next packet for the session. Packets of virtual inventories are handled here
+// and never reach the session.
+func (c *playerConn) ReadPacket() (packet.Packet, error) {
+	for {
+		for _, f := range c.after {
+			f()
+		}
+		c.after = nil
+
+		pk, err := c.Conn.ReadPacket()
+		if err != nil {
+			c.closed()
+			return pk, err
+		}
+		switch pk := pk.(type) {
+		case *packet.ContainerClose:
+			if c.closeVirtualWindow(pk.WindowID) {
+				continue
+			}
+			c.mu.Lock()
+			if c.open && pk.WindowID == c.window {
+				c.open = false
+				pos := c.pos
+				// Reported once the session closed the container, so that it can be opened again.
+				c.after = append(c.after, func() { c.m.containerClosed(c.id, pos, false) })
+			}
+			c.mu.Unlock()
+		case *packet.ItemStackRequest:
+			c.handleVirtualClicks(pk)
+		}
+		return pk, nil
+	}
+}
+
+// WritePacket writes a packet of the session to the connection.
+func (c *playerConn) WritePacket(pk packet.Packet) error {
+	switch pk := pk.(type) {
+	case *packet.ContainerOpen:
+		// Window 0 is the player's own inventory. Lecterns are never closed by the client.
+		if pk.WindowID != 0 && pk.ContainerType != protocol.ContainerTypeLectern {
+			c.mu.Lock()
+			c.open, c.window = true, pk.WindowID
+			c.pos = cube.Pos{int(pk.ContainerPosition.X()), int(pk.ContainerPosition.Y()), int(pk.ContainerPosition.Z())}
+			replaced := c.virtual
+			c.virtual = nil
+			c.mu.Unlock()
+			if replaced != nil {
+				_ = c.Conn.WritePacket(&packet.ContainerClose{WindowID: virtualWindowID, ServerSide: true})
+				// The session writes from a transaction, which the virtual inventory cannot be closed in.
+				go c.m.virtualClosed(c.id, replaced)
+			}
+		}
+	case *packet.ContainerClose:
+		c.mu.Lock()
+		closed := c.open && pk.WindowID == c.window
+		c.open = false
+		pos := c.pos
+		c.mu.Unlock()
+		if closed {
+			// The server closes containers from a transaction, which the event cannot be emitted in.
+			go c.m.containerClosed(c.id, pos, true)
+		}
+	}
+	return c.Conn.WritePacket(pk)
+}
+
+// closed forgets the connection once it was closed.
+func (c *playerConn) closed() {
+	c.mu.Lock()
+	virtual := c.virtual
+	c.virtual = nil
+	c.mu.Unlock()
+	if virtual != nil {
+		c.m.sendVirtualClose(c.id, virtual)
+	}
+	c.m.mu.Lock()
+	if c.m.conns[c.id] == c {
+		delete(c.m.conns, c.id)
+	}
+	c.m.mu.Unlock()
+}
@@ -0,0 +1,212 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/session"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/google/uuid"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// fakeConn is a connection that reads the packets queued with read and records the packets written
+// to it. Reading fails with io.EOF once no packets are left.
+type fakeConn struct {
+	session.Conn
+
+	mu      sync.Mutex
+	reads   []packet.Packet
+	written []packet.Packet
+}
+
+func (c *fakeConn) read(pks ...packet.Packet) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reads = append(c.reads, pks...)
+}
+
+func (c *fakeConn) ReadPacket() (packet.Packet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.reads) == 0 {
+		return nil, io.EOF
+	}
+	pk := c.reads[0]
+	c.reads = c.reads[1:]
+	return pk, nil
+}
+
+func (c *fakeConn) WritePacket(pk packet.Packet) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.written = append(c.written, pk)
+	return nil
+}
+
+// packets returns the packets written since the last call.
+func (c *fakeConn) packets() []packet.Packet {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pks := c.written
+	c.written = nil
+	return pks
+}
+
+// newTestConn registers a wrapped connection of the player with the UUID passed, which writes to a
+// fakeConn.
+func newTestConn(m *Manager, id uuid.UUID) (*playerConn, *fakeConn) {
+	fake := &fakeConn{}
+	c := &playerConn{Conn: fake, m: m, id: id}
+	m.mu.Lock()
+	m.conns[id] = c
+	m.mu.Unlock()
+	return c, fake
+}
+
+// joinTestPlayer spawns a player in a test world that the manager knows, with a wrapped connection.
+func joinTestPlayer(t *testing.T, m *Manager) (*world.World, *world.EntityHandle, *playerConn, *fakeConn) {
+	w, h := newTestWorld(t)
+	inTx(w, h, func(tx *world.Tx, p *player.Player) {
+		m.players[p.UUID()] = p
+	})
+	c, fake := newTestConn(m, h.UUID())
+	return w, h, c, fake
+}
+
+// waitEvents waits for n events to be queued for p.
+func waitEvents(t *testing.T, p *pluginProcess, n int) []*pb.EventEnvelope {
+	t.Helper()
+	var evts []*pb.EventEnvelope
+	deadline := time.After(time.Second)
+	for len(evts) < n {
+		select {
+		case msg := <-p.sendCh:
+			if evt := msg.GetEvent(); evt != nil {
+				evts = append(evts, evt)
+			}
+		case <-deadline:
+			t.Fatalf("received %d events, want %d", len(evts), n)
+		}
+	}
+	return evts
+}
+
+func TestPlayerConnReadPacket(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	shop := newTestPlugin(m, "shop")
+	monitor := newTestPlugin(m, "monitor")
+	subscribe(monitor, pb.EventType_CONTAINER_CLOSE, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+	w, h, c, fake := joinTestPlayer(t, m)
+
+	chest := cube.Pos{4, 64, 4}
+	inTx(w, h, func(tx *world.Tx, p *player.Player) {
+		tx.SetBlock(chest, block.NewChest(), nil)
+	})
+	c.virtual = &virtualInventory{pluginID: "shop", id: "menu", pos: cube.Pos{0, 66, 0}}
+	c.open, c.window, c.pos = true, 3, chest
+	fake.read(
+		&packet.ContainerClose{WindowID: virtualWindowID},
+		&packet.ContainerClose{WindowID: 3},
+		&packet.Text{Message: "hi"},
+	)
+
+	// The close of the virtual window never reaches the session.
+	pk, err := c.ReadPacket()
+	if closed, ok := pk.(*packet.ContainerClose); err != nil || !ok || closed.WindowID != 3 {
+		t.Fatalf("ReadPacket = %#v, %v, want the close of window 3", pk, err)
+	}
+	if c.virtual != nil || c.open {
+		t.Errorf("virtual inventory %v and container open %v after both windows closed", c.virtual, c.open)
+	}
+	if evts := events(shop); len(evts) != 1 || evts[0].GetVirtualInventoryClose().GetInventoryId() != "menu" {
+		t.Errorf("shop received %v, want the close of its virtual inventory", evts)
+	}
+	if evts := events(monitor); len(evts) != 0 {
+		t.Errorf("CONTAINER_CLOSE emitted before the session handled the close: %v", evts)
+	}
+
+	// The container close is reported once the session read the packet.
+	pk, err = c.ReadPacket()
+	if _, ok := pk.(*packet.Text); err != nil || !ok {
+		t.Fatalf("ReadPacket = %#v, %v, want the text packet", pk, err)
+	}
+	if evts := events(monitor); len(evts) != 1 || evts[0].GetContainerClose().GetServerSide() {
+		t.Errorf("monitor received %v, want a CONTAINER_CLOSE by the player", evts)
+	}
+
+	if _, err := c.ReadPacket(); err != io.EOF {
+		t.Fatalf("ReadPacket error = %v, want io.EOF", err)
+	}
+	if m.playerConn(h.UUID()) != nil {
+		t.Error("connection still registered after it was closed")
+	}
+}
+
+func TestPlayerConnReadClosesVirtual(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	shop := newTestPlugin(m, "shop")
+	_, h, c, _ := joinTestPlayer(t, m)
+	c.virtual = &virtualInventory{pluginID: "shop", id: "menu"}
+
+	if _, err := c.ReadPacket(); err != io.EOF {
+		t.Fatalf("ReadPacket error = %v, want io.EOF", err)
+	}
+	if evts := events(shop); len(evts) != 1 || evts[0].GetVirtualInventoryClose().GetPlayerUuid() != h.UUID().String() {
+		t.Errorf("shop received %v, want the close of its virtual inventory", evts)
+	}
+}
+
+func TestPlayerConnWritePacket(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	shop := newTestPlugin(m, "shop")
+	monitor := newTestPlugin(m, "monitor")
+	subscribe(monitor, pb.EventType_CONTAINER_CLOSE, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+	w, h, c, fake := joinTestPlayer(t, m)
+	chest := cube.Pos{4, 64, 4}
+	inTx(w, h, func(tx *world.Tx, p *player.Player) {
+		tx.SetBlock(chest, block.NewChest(), nil)
+	})
+	c.virtual = &virtualInventory{pluginID: "shop", id: "menu", pos: cube.Pos{0, 66, 0}}
+
+	// The player's own inventory and lecterns are not tracked.
+	_ = c.WritePacket(&packet.ContainerOpen{WindowID: 0})
+	_ = c.WritePacket(&packet.ContainerOpen{WindowID: 1, ContainerType: protocol.ContainerTypeLectern})
+	if c.open || c.virtual == nil {
+		t.Fatalf("tracked the player's inventory or a lectern as an open container")
+	}
+	fake.packets()
+
+	// A container opened by the session replaces the virtual inventory.
+	_ = c.WritePacket(&packet.ContainerOpen{WindowID: 2, ContainerPosition: blockPos(chest)})
+	if !c.open || c.window != 2 || c.pos != chest || c.virtual != nil {
+		t.Fatalf("after opening window 2: open %v, window %d, pos %v, virtual %v", c.open, c.window, c.pos, c.virtual)
+	}
+	pks := fake.packets()
+	if len(pks) != 2 {
+		t.Fatalf("wrote %d packets, want the virtual window closed and the container opened", len(pks))
+	}
+	if closed, ok := pks[0].(*packet.ContainerClose); !ok || closed.WindowID != virtualWindowID || !closed.ServerSide {
+		t.Errorf("first packet = %#v, want the virtual window closed", pks[0])
+	}
+	if evts := waitEvents(t, shop, 1); evts[0].GetVirtualInventoryClose().GetInventoryId() != "menu" {
+		t.Errorf("shop received %v, want the close of its virtual inventory", evts)
+	}
+
+	_ = c.WritePacket(&packet.ContainerClose{WindowID: 2, ServerSide: true})
+	if c.open {
+		t.Error("container still open after the session closed it")
+	}
+	if evts := waitEvents(t, monitor, 1); !evts[0].GetContainerClose().GetServerSide() {
+		t.Errorf("monitor received %v, want a CONTAINER_CLOSE by the server", evts)
+	}
+}
@@ -0,0 +1,347 @@
+package plugin
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/df-mc/dragonfly/server/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+var (
+	errEntityIsPlayer        = errors.New("entity is a player")
+	errOwnerNotFound         = errors.New("owner not found")
+	errEntityNotTeleportable = errors.New("entity cannot be teleported")
+	errProjectileTeleport    = errors.New("projectiles cannot be teleported")
+	errNoVelocity            = errors.New("entity has no velocity")
+	errNoNameTag             = errors.New("entity has no name tag")
+)
+
+// defaultTNTFuse is the fuse of spawned TNT without fuse_ms, as for TNT lit by a player.
+const defaultTNTFuse = 4 * time.Second
+
+func (m *Manager) handleWorldSpawnEntity(p *pluginProcess, correlationID string, act *pb.WorldSpawnEntityAction) {
+	w := m.worldFromRef(act.GetWorld())
+	if w == nil {
+		m.sendActionError(p, correlationID, "world not found")
+		return
+	}
+	pos, ok := vec3FromProto(act.Position)
+	if !ok {
+		m.sendActionError(p, correlationID, "invalid position")
+		return
+	}
+	opts := world.EntitySpawnOpts{Position: pos, NameTag: act.GetNameTag()}
+	if rot := act.Rotation; rot != nil {
+		opts.Rotation = cube.Rotation{float64(rot.Yaw), float64(rot.Pitch)}
+	}
+	if vel, ok := vec3FromProto(act.Velocity); ok {
+		opts.Velocity = vel
+	}
+
+	var (
+		check func(tx *world.Tx) error
+		spawn func(tx *world.Tx) (*world.EntityHandle, error)
+	)
+	switch kind := act.Entity.(type) {
+	case *pb.WorldSpawnEntityAction_Item:
+		stack, ok := convertProtoItemStackValue(kind.Item.GetItem())
+		if !ok {
+			m.sendActionError(p, correlationID, "invalid item")
+			return
+		}
+		delay := time.Duration(kind.Item.GetPickupDelayMs()) * time.Millisecond
+		spawn = func(*world.Tx) (*world.EntityHandle, error) {
+			return entity.NewItemPickupDelay(opts, stack, delay), nil
+		}
+	case *pb.WorldSpawnEntityAction_FallingBlock:
+		b, ok := blockFromProto(kind.FallingBlock.GetBlock())
+		if !ok {
+			m.sendActionError(p, correlationID, "unknown block")
+			return
+		}
+		spawn = func(*world.Tx) (*world.EntityHandle, error) {
+			return entity.NewFallingBlock(opts, b), nil
+		}
+	case *pb.WorldSpawnEntityAction_Tnt:
+		fuse := defaultTNTFuse
+		if kind.Tnt.FuseMs != nil {
+			fuse = time.Duration(kind.Tnt.GetFuseMs()) * time.Millisecond
+		}
+		spawn = func(*world.Tx) (*world.EntityHandle, error) {
+			return entity.NewTNT(opts, fuse), nil
+		}
+	case *pb.WorldSpawnEntityAction_Arrow:
+		var ownerID uuid.UUID
+		if kind.Arrow.OwnerUuid != nil {
+			var err error
+			if ownerID, err = uuid.Parse(kind.Arrow.GetOwnerUuid()); err != nil {
+				m.sendActionError(p, correlationID, "invalid owner_uuid")
+				return
+			}
+		}
+		// Same as entity.NewArrowWithDamage, which requires an owner.
+		conf := entity.ProjectileBehaviourConfig{
+			Gravity:               0.05,
+			Drag:                  0.01,
+			Damage:                2.0,
+			Sound:                 sound.ArrowHit{},
+			SurviveBlockCollision: true,
+		}
+		if kind.Arrow.Damage != nil {
+			conf.Damage = kind.Arrow.GetDamage()
+		}
+		if ownerID != uuid.Nil {
+			check = func(tx *world.Tx) error {
+				if _, ok := findPlayer(tx, ownerID); !ok {
+					return errOwnerNotFound
+				}
+				return nil
+			}
+		}
+		spawn = func(tx *world.Tx) (*world.EntityHandle, error) {
+			if ownerID != uuid.Nil {
+				owner, ok := findPlayer(tx, ownerID)
+				if !ok {
+					return nil, errOwnerNotFound
+				}
+				conf.Owner = owner.H()
+			}
+			return opts.New(entity.ArrowType, conf), nil
+		}
+	case *pb.WorldSpawnEntityAction_ExperienceOrb:
+		if kind.ExperienceOrb.GetExperience() <= 0 {
+			m.sendActionError(p, correlationID, "experience must be positive")
+			return
+		}
+		spawn = func(*world.Tx) (*world.EntityHandle, error) {
+			return entity.NewExperienceOrb(opts, int(kind.ExperienceOrb.GetExperience())), nil
+		}
+	case *pb.WorldSpawnEntityAction_Lightning:
+		spawn = func(*world.Tx) (*world.EntityHandle, error) {
+			return entity.NewLightning(opts), nil
+		}
+	case *pb.WorldSpawnEntityAction_Text:
+		spawn = func(*world.Tx) (*world.EntityHandle, error) {
+			return entity.NewText(kind.Text.GetText(), pos), nil
+		}
+	default:
+		m.sendActionError(p, correlationID, "missing entity")
+		return
+	}
+
+	m.execWorldChecked(p, correlationID, w, check, func(tx *world.Tx) {
+		handle, err := spawn(tx)
+		if err != nil {
+			m.reportActionResult(p, correlationID, nil, err)
+			return
+		}
+		e := tx.AddEntity(handle)
+		m.reportActionResult(p, correlationID, &pb.ActionResult{Result: &pb.ActionResult_WorldSpawnEntity{
+			WorldSpawnEntity: &pb.WorldSpawnEntityResult{Entity: protoEntityRef(e)},
+		}}, nil)
+	})
+}
+
+func (m *Manager) handleEntityRemove(p *pluginProcess, correlationID string, act *pb.EntityRemoveAction) {
+	m.execEntity(p, correlationID, act.EntityUuid, act.World, nil, func(_ *world.Tx, e world.Entity) error {
+		return e.Close()
+	})
+}
+
+func (m *Manager) handleEntityTeleport(p *pluginProcess, correlationID string, act *pb.EntityTeleportAction) {
+	pos, ok := vec3FromProto(act.Position)
+	if !ok {
+		m.sendActionError(p, correlationID, "invalid position")
+		return
+	}
+	m.execEntity(p, correlationID, act.EntityUuid, act.World, checkTeleportable, func(tx *world.Tx, e world.Entity) error {
+		rot := e.Rotation()
+		if act.Rotation != nil {
+			rot = cube.Rotation{float64(act.Rotation.Yaw), float64(act.Rotation.Pitch)}
+		}
+		return teleportEntity(tx, e, pos, rot)
+	})
+}
+
+func (m *Manager) handleEntitySetVelocity(p *pluginProcess, correlationID string, act *pb.EntitySetVelocityAction) {
+	v, ok := vec3FromProto(act.Velocity)
+	if !ok {
+		m.sendActionError(p, correlationID, "missing velocity")
+		return
+	}
+	check := func(e world.Entity) error {
+		if _, ok := e.(interface{ SetVelocity(v mgl64.Vec3) }); !ok {
+			return errNoVelocity
+		}
+		return nil
+	}
+	m.execEntity(p, correlationID, act.EntityUuid, act.World, check, func(_ *world.Tx, e world.Entity) error {
+		e.(interface{ SetVelocity(v mgl64.Vec3) }).SetVelocity(v)
+		return nil
+	})
+}
+
+func (m *Manager) handleEntitySetNameTag(p *pluginProcess, correlationID string, act *pb.EntitySetNameTagAction) {
+	check := func(e world.Entity) error {
+		if _, ok := e.(interface{ SetNameTag(s string) }); !ok {
+			return errNoNameTag
+		}
+		return nil
+	}
+	m.execEntity(p, correlationID, act.EntityUuid, act.World, check, func(_ *world.Tx, e world.Entity) error {
+		e.(interface{ SetNameTag(s string) }).SetNameTag(act.NameTag)
+		return nil
+	})
+}
+
+// execEntity runs check, which may be nil, and then fn with the non-player entity with the UUID
+// entityUUID and reports the result. The entity is looked up in the world of ref or, if ref is unset,
+// in every world. Atomic batches need the world, as their operations are bound to one.
+func (m *Manager) execEntity(p *pluginProcess, correlationID, entityUUID string, ref *pb.WorldRef, check func(e world.Entity) error, fn func(tx *world.Tx, e world.Entity) error) {
+	id, err := uuid.Parse(entityUUID)
+	if err != nil {
+		m.sendActionError(p, correlationID, "invalid entity_uuid")
+		return
+	}
+	checkEntity := func(tx *world.Tx) (world.Entity, error) {
+		e, err := findEntity(tx, id)
+		if err == nil && check != nil {
+			err = check(e)
+		}
+		return e, err
+	}
+	if ref != nil {
+		w := m.worldFromRef(ref)
+		if w == nil {
+			m.sendActionError(p, correlationID, "world not found")
+			return
+		}
+		m.execWorldChecked(p, correlationID, w, func(tx *world.Tx) error {
+			_, err := checkEntity(tx)
+			return err
+		}, func(tx *world.Tx) {
+			e, err := findEntity(tx, id)
+			if err == nil {
+				err = fn(tx, e)
+			}
+			m.reportActionResult(p, correlationID, nil, err)
+		})
+		return
+	}
+	if p.atomic != nil {
+		m.sendActionError(p, correlationID, "missing world")
+		return
+	}
+	// The entity is searched in every world at once, so the action waits for the slowest world
+	// rather than for all of them in turn. At most one world holds an entity with the UUID.
+	var found atomic.Bool
+	worlds := m.registeredWorlds()
+	done := make([]<-chan struct{}, 0, len(worlds))
+	for _, w := range worlds {
+		done = append(done, w.Exec(func(tx *world.Tx) {
+			e, err := checkEntity(tx)
+			if errors.Is(err, errEntityNotFound) {
+				return
+			}
+			found.Store(true)
+			if err == nil {
+				err = fn(tx, e)
+			}
+			m.reportActionResult(p, correlationID, nil, err)
+		}))
+	}
+	for _, c := range done {
+		<-c
+	}
+	if !found.Load() {
+		m.sendActionError(p, correlationID, errEntityNotFound.Error())
+	}
+}
+
+// registeredWorlds returns every world registered with the manager.
+func (m *Manager) registeredWorlds() []*world.World {
+	m.worldMu.RLock()
+	defer m.worldMu.RUnlock()
+	worlds := make([]*world.World, 0, len(m.worldsByID))
+	for _, w := range m.worldsByID {
+		worlds = append(worlds, w)
+	}
+	return worlds
+}
+
+// findEntity returns the non-player entity with the UUID passed. Players are controlled through the
+// player actions instead, which are subject to their own permissions.
+func findEntity(tx *world.Tx, id uuid.UUID) (world.Entity, error) {
+	for e := range tx.Entities() {
+		if e.H().UUID() != id {
+			continue
+		}
+		if _, ok := e.(*player.Player); ok {
+			return nil, errEntityIsPlayer
+		}
+		return e, nil
+	}
+	return nil, errEntityNotFound
+}
+
+func findPlayer(tx *world.Tx, id uuid.UUID) (*player.Player, bool) {
+	for e := range tx.Players() {
+		if pl, ok := e.(*player.Player); ok && pl.UUID() == id {
+			return pl, true
+		}
+	}
+	return nil, false
+}
+
+// checkTeleportable fails for entities that teleportEntity cannot move. Projectiles are refused, as
+// their owner is not part of their NBT and would be lost.
+func checkTeleportable(e world.Entity) error {
+	ent, ok := e.(*entity.Ent)
+	if !ok {
+		return errEntityNotTeleportable
+	}
+	if _, ok := ent.Behaviour().(*entity.ProjectileBehaviour); ok {
+		return errProjectileTeleport
+	}
+	return nil
+}
+
+// teleportEntity moves a non-player entity to pos. Dragonfly entities cannot be moved from outside,
+// so the entity is replaced by one with the same UUID, type and state at the new position. Its age
+// restarts.
+func teleportEntity(tx *world.Tx, e world.Entity, pos mgl64.Vec3, rot cube.Rotation) error {
+	if err := checkTeleportable(e); err != nil {
+		return err
+	}
+	ent := e.(*entity.Ent)
+	t := ent.H().Type()
+	data := t.EncodeNBT(&world.EntityData{Data: ent.Behaviour()})
+	opts := world.EntitySpawnOpts{
+		Position: pos,
+		Rotation: rot,
+		Velocity: ent.Velocity(),
+		ID:       ent.H().UUID(),
+		NameTag:  ent.NameTag(),
+	}
+	_ = ent.Close()
+	tx.AddEntity(opts.New(t, entityNBTConfig{t: t, data: data}))
+	return nil
+}
+
+// entityNBTConfig configures a new entity from the NBT of another entity of the same type.
+type entityNBTConfig struct {
+	t    world.EntityType
+	data map[string]any
+}
+
+func (c entityNBTConfig) Apply(data *world.EntityData) {
+	c.t.DecodeNBT(c.data, data)
+}
@@ -0,0 +1,444 @@
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/entity"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// newRegisteredWorld returns a test world that the manager knows, its player and a reference to it.
+func newRegisteredWorld(t *testing.T, m *Manager) (*world.World, *world.EntityHandle, *pb.WorldRef) {
+	w, h := newTestWorld(t)
+	m.registerWorld(w)
+	return w, h, &pb.WorldRef{Id: fmt.Sprintf("%p", w)}
+}
+
+// applyAction applies act alone and returns its result.
+func applyAction(t *testing.T, m *Manager, p *pluginProcess, act *pb.Action) *pb.ActionResult {
+	t.Helper()
+	correlationID := "action"
+	act.CorrelationId = &correlationID
+	m.applyActions(p, &pb.ActionBatch{Actions: []*pb.Action{act}})
+	results := actionResults(p)
+	if len(results) != 1 || results[0].CorrelationId != correlationID {
+		t.Fatalf("received %v, want the result of the action", results)
+	}
+	return results[0]
+}
+
+// withEntity runs fn with the entity with the UUID passed in a transaction of w, or with nil if w
+// has no such entity.
+func withEntity(w *world.World, id uuid.UUID, fn func(tx *world.Tx, e world.Entity)) {
+	<-w.Exec(func(tx *world.Tx) {
+		for e := range tx.Entities() {
+			if e.H().UUID() == id {
+				fn(tx, e)
+				return
+			}
+		}
+		fn(tx, nil)
+	})
+}
+
+// spawnItem adds a dropped diamond to w and returns its UUID.
+func spawnItem(w *world.World, pos mgl64.Vec3) uuid.UUID {
+	id := uuid.New()
+	<-w.Exec(func(tx *world.Tx) {
+		tx.AddEntity(entity.NewItem(world.EntitySpawnOpts{Position: pos, ID: id}, item.NewStack(item.Diamond{}, 1)))
+	})
+	return id
+}
+
+func TestWorldSpawnEntity(t *testing.T) {
+	tests := []struct {
+		name   string
+		entity func(owner uuid.UUID) *pb.WorldSpawnEntityAction
+		typ    string
+		// owned is set if the entity is credited to the player of the world.
+		owned bool
+	}{
+		{
+			name: "item",
+			entity: func(uuid.UUID) *pb.WorldSpawnEntityAction {
+				return &pb.WorldSpawnEntityAction{Entity: &pb.WorldSpawnEntityAction_Item{Item: &pb.SpawnItemEntity{
+					Item: &pb.ItemStack{Name: "minecraft:diamond", Count: 3},
+				}}}
+			},
+			typ: "minecraft:item",
+		},
+		{
+			name: "falling block",
+			entity: func(uuid.UUID) *pb.WorldSpawnEntityAction {
+				return &pb.WorldSpawnEntityAction{Entity: &pb.WorldSpawnEntityAction_FallingBlock{FallingBlock: &pb.SpawnFallingBlockEntity{
+					Block: &pb.BlockState{Name: "minecraft:sand"},
+				}}}
+			},
+			typ: "minecraft:falling_block",
+		},
+		{
+			name: "tnt",
+			entity: func(uuid.UUID) *pb.WorldSpawnEntityAction {
+				fuse := int64(60_000)
+				return &pb.WorldSpawnEntityAction{Entity: &pb.WorldSpawnEntityAction_Tnt{Tnt: &pb.SpawnTNTEntity{FuseMs: &fuse}}}
+			},
+			typ: "minecraft:tnt",
+		},
+		{
+			name: "arrow",
+			entity: func(uuid.UUID) *pb.WorldSpawnEntityAction {
+				return &pb.WorldSpawnEntityAction{Entity: &pb.WorldSpawnEntityAction_Arrow{Arrow: &pb.SpawnArrowEntity{}}}
+			},
+			typ: "minecraft:arrow",
+		},
+		{
+			name: "arrow with owner",
+			entity: func(owner uuid.UUID) *pb.WorldSpawnEntityAction {
+				id := owner.String()
+				return &pb.WorldSpawnEntityAction{Entity: &pb.WorldSpawnEntityAction_Arrow{Arrow: &pb.SpawnArrowEntity{OwnerUuid: &id}}}
+			},
+			typ:   "minecraft:arrow",
+			owned: true,
+		},
+		{
+			name: "experience orb",
+			entity: func(uuid.UUID) *pb.WorldSpawnEntityAction {
+				return &pb.WorldSpawnEntityAction{Entity: &pb.WorldSpawnEntityAction_ExperienceOrb{ExperienceOrb: &pb.SpawnExperienceOrbEntity{Experience: 5}}}
+			},
+			typ: "minecraft:xp_orb",
+		},
+		{
+			name: "text",
+			entity: func(uuid.UUID) *pb.WorldSpawnEntityAction {
+				return &pb.WorldSpawnEntityAction{Entity: &pb.WorldSpawnEntityAction_Text{Text: &pb.SpawnTextEntity{Text: "Tagged"}}}
+			},
+			typ: "dragonfly:text",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "test")
+			w, h, ref := newRegisteredWorld(t, m)
+			spawn := tt.entity(h.UUID())
+			nameTag := "Tagged"
+			spawn.World, spawn.Position, spawn.NameTag = ref, &pb.Vec3{X: 3.5, Y: 80, Z: -2.5}, &nameTag
+
+			res := applyAction(t, m, p, &pb.Action{Kind: &pb.Action_WorldSpawnEntity{WorldSpawnEntity: spawn}})
+			spawned := res.GetWorldSpawnEntity().GetEntity()
+			if !res.GetStatus().GetOk() || spawned == nil {
+				t.Fatalf("result = %v, want the spawned entity", res)
+			}
+			id, err := uuid.Parse(spawned.Uuid)
+			if err != nil {
+				t.Fatalf("spawned entity has UUID %q: %v", spawned.Uuid, err)
+			}
+			withEntity(w, id, func(tx *world.Tx, e world.Entity) {
+				if e == nil {
+					t.Fatal("spawned entity not in the world")
+				}
+				if typ := e.H().Type().EncodeEntity(); typ != tt.typ {
+					t.Errorf("spawned %s, want %s", typ, tt.typ)
+				}
+				if pos := e.Position(); pos != (mgl64.Vec3{3.5, 80, -2.5}) {
+					t.Errorf("spawned at %v, want the position of the action", pos)
+				}
+				if tag := e.(interface{ NameTag() string }).NameTag(); tag != nameTag {
+					t.Errorf("name tag = %q, want %q", tag, nameTag)
+				}
+				if proj, ok := e.(*entity.Ent).Behaviour().(*entity.ProjectileBehaviour); ok && (proj.Owner() == h) != tt.owned {
+					t.Errorf("arrow owned by %v, want owned by the player %v", proj.Owner(), tt.owned)
+				}
+			})
+		})
+	}
+}
+
+func TestWorldSpawnEntityErrors(t *testing.T) {
+	pos := &pb.Vec3{X: 1, Y: 70, Z: 1}
+	orb := &pb.WorldSpawnEntityAction_ExperienceOrb{ExperienceOrb: &pb.SpawnExperienceOrbEntity{Experience: 1}}
+	badOwner, missingOwner := "steve", uuid.NewString()
+	tests := []struct {
+		name  string
+		spawn *pb.WorldSpawnEntityAction
+		// otherWorld targets a world that is not registered.
+		otherWorld bool
+		want       string
+	}{
+		{name: "unknown world", spawn: &pb.WorldSpawnEntityAction{Position: pos, Entity: orb}, otherWorld: true, want: "world not found"},
+		{name: "missing position", spawn: &pb.WorldSpawnEntityAction{Entity: orb}, want: "invalid position"},
+		{name: "missing entity", spawn: &pb.WorldSpawnEntityAction{Position: pos}, want: "missing entity"},
+		{
+			name: "invalid item",
+			spawn: &pb.WorldSpawnEntityAction{Position: pos, Entity: &pb.WorldSpawnEntityAction_Item{Item: &pb.SpawnItemEntity{
+				Item: &pb.ItemStack{Name: "minecraft:not_an_item", Count: 1},
+			}}},
+			want: "invalid item",
+		},
+		{
+			name: "unknown block",
+			spawn: &pb.WorldSpawnEntityAction{Position: pos, Entity: &pb.WorldSpawnEntityAction_FallingBlock{FallingBlock: &pb.SpawnFallingBlockEntity{
+				Block: &pb.BlockState{Name: "minecraft:not_a_block"},
+			}}},
+			want: "unknown block",
+		},
+		{
+			name:  "no experience",
+			spawn: &pb.WorldSpawnEntityAction{Position: pos, Entity: &pb.WorldSpawnEntityAction_ExperienceOrb{ExperienceOrb: &pb.SpawnExperienceOrbEntity{}}},
+			want:  "experience must be positive",
+		},
+		{
+			name:  "malformed owner",
+			spawn: &pb.WorldSpawnEntityAction{Position: pos, Entity: &pb.WorldSpawnEntityAction_Arrow{Arrow: &pb.SpawnArrowEntity{OwnerUuid: &badOwner}}},
+			want:  "invalid owner_uuid",
+		},
+		{
+			name:  "owner not in world",
+			spawn: &pb.WorldSpawnEntityAction{Position: pos, Entity: &pb.WorldSpawnEntityAction_Arrow{Arrow: &pb.SpawnArrowEntity{OwnerUuid: &missingOwner}}},
+			want:  "owner not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "test")
+			w, _, ref := newRegisteredWorld(t, m)
+			tt.spawn.World = ref
+			if tt.otherWorld {
+				tt.spawn.World = &pb.WorldRef{Name: "nether"}
+			}
+
+			res := applyAction(t, m, p, &pb.Action{Kind: &pb.Action_WorldSpawnEntity{WorldSpawnEntity: tt.spawn}})
+			if res.GetStatus().GetOk() || res.GetStatus().GetError() != tt.want {
+				t.Errorf("result = %v, want error %q", res, tt.want)
+			}
+			<-w.Exec(func(tx *world.Tx) {
+				for e := range tx.Entities() {
+					if e.H().Type().EncodeEntity() != "minecraft:player" {
+						t.Errorf("spawned %s despite the error", e.H().Type().EncodeEntity())
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestEntityActions(t *testing.T) {
+	tests := []struct {
+		name string
+		// action returns the action on the entity with the UUID passed in the world of ref.
+		action func(id string, ref *pb.WorldRef) *pb.Action
+		// check is run with the entity afterwards, which is nil if it is gone.
+		check func(t *testing.T, e world.Entity)
+	}{
+		{
+			name: "remove",
+			action: func(id string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: id, World: ref}}}
+			},
+			check: func(t *testing.T, e world.Entity) {
+				if e != nil {
+					t.Error("entity not removed")
+				}
+			},
+		},
+		{
+			name: "remove in any world",
+			action: func(id string, _ *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: id}}}
+			},
+			check: func(t *testing.T, e world.Entity) {
+				if e != nil {
+					t.Error("entity not removed")
+				}
+			},
+		},
+		{
+			name: "set velocity",
+			action: func(id string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntitySetVelocity{EntitySetVelocity: &pb.EntitySetVelocityAction{
+					EntityUuid: id, World: ref, Velocity: &pb.Vec3{X: 0.5, Y: 1, Z: -0.25},
+				}}}
+			},
+			check: func(t *testing.T, e world.Entity) {
+				if v := e.(*entity.Ent).Velocity(); v != (mgl64.Vec3{0.5, 1, -0.25}) {
+					t.Errorf("velocity = %v, want the velocity of the action", v)
+				}
+			},
+		},
+		{
+			name: "set name tag in any world",
+			action: func(id string, _ *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntitySetNameTag{EntitySetNameTag: &pb.EntitySetNameTagAction{EntityUuid: id, NameTag: "Shiny"}}}
+			},
+			check: func(t *testing.T, e world.Entity) {
+				if tag := e.(*entity.Ent).NameTag(); tag != "Shiny" {
+					t.Errorf("name tag = %q, want Shiny", tag)
+				}
+			},
+		},
+		{
+			name: "teleport",
+			action: func(id string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityTeleport{EntityTeleport: &pb.EntityTeleportAction{
+					EntityUuid: id, World: ref, Position: &pb.Vec3{X: 10, Y: 90, Z: 10}, Rotation: &pb.Rotation{Yaw: 90, Pitch: 45},
+				}}}
+			},
+			check: func(t *testing.T, e world.Entity) {
+				if e == nil {
+					t.Fatal("teleported entity not in the world under its UUID")
+				}
+				if pos, rot := e.Position(), e.Rotation(); pos != (mgl64.Vec3{10, 90, 10}) || rot != (cube.Rotation{90, 45}) {
+					t.Errorf("entity at %v facing %v, want the position and rotation of the action", pos, rot)
+				}
+				if typ := e.H().Type().EncodeEntity(); typ != "minecraft:item" {
+					t.Errorf("teleported entity is %s, want the item", typ)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "test")
+			// The entity is in the second of two worlds.
+			newRegisteredWorld(t, m)
+			w, _, ref := newRegisteredWorld(t, m)
+			id := spawnItem(w, mgl64.Vec3{1, 70, 1})
+
+			res := applyAction(t, m, p, tt.action(id.String(), ref))
+			if !res.GetStatus().GetOk() {
+				t.Fatalf("result = %v, want ok", res)
+			}
+			withEntity(w, id, func(tx *world.Tx, e world.Entity) {
+				tt.check(t, e)
+			})
+		})
+	}
+}
+
+func TestEntityActionErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		// action returns the action for the item with the UUID passed, the player and the arrow in
+		// the world of ref.
+		action func(item, player, arrow string, ref *pb.WorldRef) *pb.Action
+		atomic bool
+		want   string
+	}{
+		{
+			name: "malformed UUID",
+			action: func(_, _, _ string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: "item", World: ref}}}
+			},
+			want: "invalid entity_uuid",
+		},
+		{
+			name: "not in any world",
+			action: func(string, string, string, *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: uuid.NewString()}}}
+			},
+			want: "entity not found",
+		},
+		{
+			name: "not in the world",
+			action: func(_, _, _ string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: uuid.NewString(), World: ref}}}
+			},
+			want: "entity not found",
+		},
+		{
+			name: "unknown world",
+			action: func(item, _, _ string, _ *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: item, World: &pb.WorldRef{Name: "nether"}}}}
+			},
+			want: "world not found",
+		},
+		{
+			name: "player",
+			action: func(_, player, _ string, _ *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: player}}}
+			},
+			want: "entity is a player",
+		},
+		{
+			name: "missing velocity",
+			action: func(item, _, _ string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntitySetVelocity{EntitySetVelocity: &pb.EntitySetVelocityAction{EntityUuid: item, World: ref}}}
+			},
+			want: "missing velocity",
+		},
+		{
+			name: "teleport without position",
+			action: func(item, _, _ string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityTeleport{EntityTeleport: &pb.EntityTeleportAction{EntityUuid: item, World: ref}}}
+			},
+			want: "invalid position",
+		},
+		{
+			name: "teleport projectile",
+			action: func(_, _, arrow string, ref *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityTeleport{EntityTeleport: &pb.EntityTeleportAction{
+					EntityUuid: arrow, World: ref, Position: &pb.Vec3{X: 5, Y: 70, Z: 5},
+				}}}
+			},
+			want: "projectiles cannot be teleported",
+		},
+		{
+			name: "atomic without world",
+			action: func(item, _, _ string, _ *pb.WorldRef) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{EntityUuid: item}}}
+			},
+			atomic: true,
+			want:   "missing world",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "test")
+			newRegisteredWorld(t, m)
+			w, h, ref := newRegisteredWorld(t, m)
+			item := spawnItem(w, mgl64.Vec3{1, 70, 1})
+			arrow := uuid.New()
+			<-w.Exec(func(tx *world.Tx) {
+				tx.AddEntity(world.EntitySpawnOpts{Position: mgl64.Vec3{2, 70, 2}, ID: arrow}.New(entity.ArrowType, entity.ProjectileBehaviourConfig{}))
+			})
+			correlationID := "action"
+			act := tt.action(item.String(), h.UUID().String(), arrow.String(), ref)
+			act.CorrelationId = &correlationID
+
+			batch := &pb.ActionBatch{Actions: []*pb.Action{act}}
+			if tt.atomic {
+				batchID := "batch"
+				batch.Atomic, batch.CorrelationId = true, &batchID
+			}
+			m.applyActions(p, batch)
+			results := actionResults(p)
+			if len(results) != 1 {
+				t.Fatalf("received %d results, want 1", len(results))
+			}
+			res := results[0]
+			if tt.atomic {
+				res = res.GetBatch().GetResults()[0]
+			}
+			if res.CorrelationId != correlationID || res.GetStatus().GetOk() || res.GetStatus().GetError() != tt.want {
+				t.Errorf("result = %v, want error %q", res, tt.want)
+			}
+			withEntity(w, item, func(tx *world.Tx, e world.Entity) {
+				if e == nil {
+					t.Error("item removed by a failed action")
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package plugin
+
+import (
+	"time"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+const (
+	// eventBatchWindow is how long sendLoop waits for more events after the first event of a batch.
+	eventBatchWindow = 2 * time.Millisecond
+	// eventBatchMaxEvents caps the number of events sent in one EventBatch.
+	eventBatchMaxEvents = 128
+)
+
+// batchable reports whether msg is an event that may be delivered as part of an EventBatch.
+// Events that expect a response are always sent on their own so they keep their latency.
+func batchable(msg *pb.HostToPlugin) bool {
+	evt := msg.GetEvent()
+	return evt != nil && !evt.ExpectsResponse
+}
+
+// supportsEventBatch reports whether the plugin advertised EventBatch support in its hello.
+func (p *pluginProcess) supportsEventBatch() bool {
+	hello := p.helloInfo()
+	return hello != nil && hello.SupportsEventBatch
+}
+
+// batchEvents returns the message to send for msg and the message to send after it, if any. msg is
+// batched with the events queued after it only if it is batchable and the plugin opted in.
+func (p *pluginProcess) batchEvents(msg *pb.HostToPlugin) (out, next *pb.HostToPlugin) {
+	if !batchable(msg) || !p.supportsEventBatch() {
+		return msg, nil
+	}
+	return p.collectEventBatch(msg)
+}
+
+// collectEventBatch gathers the batchable events queued after first, until the batch window
+// elapses, the size cap is reached or a message that cannot be batched is queued. It returns the
+// message to send, which is first itself if nothing else was queued, and the message that ended
+// the batch, if any, which must be sent after it.
+func (p *pluginProcess) collectEventBatch(first *pb.HostToPlugin) (msg, next *pb.HostToPlugin) {
+	events := []*pb.EventEnvelope{first.GetEvent()}
+	timer := time.NewTimer(eventBatchWindow)
+	defer timer.Stop()
+
+collect:
+	for len(events) < eventBatchMaxEvents {
+		select {
+		case <-p.done:
+			break collect
+		case <-timer.C:
+			break collect
+		case queued := <-p.sendCh:
+			if queued == nil {
+				continue
+			}
+			if !batchable(queued) {
+				next = queued
+				break collect
+			}
+			events = append(events, queued.GetEvent())
+		}
+	}
+	if len(events) == 1 {
+		return first, next
+	}
+	return &pb.HostToPlugin{
+		PluginId: p.id,
+		Payload: &pb.HostToPlugin_EventBatch{
+			EventBatch: &pb.EventBatch{Events: events},
+		},
+	}, next
+}
@@ -0,0 +1,193 @@
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// newBatchTestPlugin returns a test plugin with room for size queued messages that opted in to
+// event batches if batches is set.
+func newBatchTestPlugin(batches bool, size int) *pluginProcess {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	p := newTestPlugin(m, "batch")
+	p.sendCh = make(chan *pb.HostToPlugin, size)
+	p.setHello(&pb.PluginHello{Name: "batch", SupportsEventBatch: batches})
+	return p
+}
+
+// batchedIDs returns the IDs of the events sent in msg, which is either an event or a batch.
+func batchedIDs(msg *pb.HostToPlugin) []string {
+	if evt := msg.GetEvent(); evt != nil {
+		return []string{evt.EventId}
+	}
+	var ids []string
+	for _, evt := range msg.GetEventBatch().GetEvents() {
+		ids = append(ids, evt.EventId)
+	}
+	return ids
+}
+
+// queuedIDs drains the send queue of p and returns the event IDs queued, "-" for other messages.
+func queuedIDs(p *pluginProcess) []string {
+	var ids []string
+	for {
+		select {
+		case msg := <-p.sendCh:
+			if evt := msg.GetEvent(); evt != nil {
+				ids = append(ids, evt.EventId)
+			} else {
+				ids = append(ids, "-")
+			}
+		default:
+			return ids
+		}
+	}
+}
+
+// responseEvent returns an event with the ID passed that expects a response.
+func responseEvent(id string) *pb.HostToPlugin {
+	msg := testEvent(id)
+	msg.GetEvent().ExpectsResponse = true
+	return msg
+}
+
+func TestBatchEvents(t *testing.T) {
+	actionResult := &pb.HostToPlugin{Payload: &pb.HostToPlugin_ActionResult{ActionResult: &pb.ActionResult{CorrelationId: "r"}}}
+	tests := []struct {
+		name    string
+		batches bool
+		first   *pb.HostToPlugin
+		queued  []*pb.HostToPlugin
+		want    []string
+		batch   bool
+		next    string
+		// left are the messages still queued afterwards.
+		left []string
+	}{
+		{
+			name:   "not opted in",
+			first:  testEvent("1"),
+			queued: []*pb.HostToPlugin{testEvent("2"), testEvent("3")},
+			want:   []string{"1"},
+			left:   []string{"2", "3"},
+		},
+		{
+			name:    "batched",
+			batches: true,
+			first:   testEvent("1"),
+			queued:  []*pb.HostToPlugin{testEvent("2"), nil, testEvent("3")},
+			want:    []string{"1", "2", "3"},
+			batch:   true,
+		},
+		{
+			name:    "nothing else queued",
+			batches: true,
+			first:   testEvent("1"),
+			want:    []string{"1"},
+		},
+		{
+			name:    "first expects a response",
+			batches: true,
+			first:   responseEvent("1"),
+			queued:  []*pb.HostToPlugin{testEvent("2")},
+			want:    []string{"1"},
+			left:    []string{"2"},
+		},
+		{
+			name:    "ended by an event that expects a response",
+			batches: true,
+			first:   testEvent("1"),
+			queued:  []*pb.HostToPlugin{testEvent("2"), responseEvent("3"), testEvent("4")},
+			want:    []string{"1", "2"},
+			batch:   true,
+			next:    "3",
+			left:    []string{"4"},
+		},
+		{
+			name:    "ended by another message",
+			batches: true,
+			first:   testEvent("1"),
+			queued:  []*pb.HostToPlugin{actionResult, testEvent("2")},
+			want:    []string{"1"},
+			next:    "-",
+			left:    []string{"2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newBatchTestPlugin(tt.batches, 16)
+			for _, msg := range tt.queued {
+				p.sendCh <- msg
+			}
+			msg, next := p.batchEvents(tt.first)
+			if got := batchedIDs(msg); !slices.Equal(got, tt.want) {
+				t.Errorf("sent events %v, want %v", got, tt.want)
+			}
+			if batch := msg.GetEventBatch() != nil; batch != tt.batch {
+				t.Errorf("sent a batch %v, want %v", batch, tt.batch)
+			} else if batch && msg.PluginId != "batch" {
+				t.Errorf("batch sent for plugin %q, want batch", msg.PluginId)
+			}
+			switch {
+			case tt.next == "" && next != nil:
+				t.Errorf("next = %v, want none", next)
+			case tt.next == "-" && next != actionResult:
+				t.Errorf("next = %v, want the action result", next)
+			case tt.next != "" && tt.next != "-" && next.GetEvent().GetEventId() != tt.next:
+				t.Errorf("next = %v, want event %s", next, tt.next)
+			}
+			if left := queuedIDs(p); !slices.Equal(left, tt.left) {
+				t.Errorf("left %v queued, want %v", left, tt.left)
+			}
+		})
+	}
+}
+
+func TestCollectEventBatchCap(t *testing.T) {
+	p := newBatchTestPlugin(true, eventBatchMaxEvents+2)
+	for i := range eventBatchMaxEvents + 1 {
+		p.sendCh <- testEvent(fmt.Sprint(i + 1))
+	}
+	msg, next := p.collectEventBatch(testEvent("0"))
+	if next != nil {
+		t.Errorf("next = %v, want none", next)
+	}
+	ids := batchedIDs(msg)
+	if len(ids) != eventBatchMaxEvents || ids[0] != "0" || ids[len(ids)-1] != fmt.Sprint(eventBatchMaxEvents-1) {
+		t.Errorf("batched %d events from %s to %s, want the first %d", len(ids), ids[0], ids[len(ids)-1], eventBatchMaxEvents)
+	}
+	if left := queuedIDs(p); !slices.Equal(left, []string{"128", "129"}) {
+		t.Errorf("left %v queued, want the events past the cap", left)
+	}
+}
+
+func TestCollectEventBatchWindow(t *testing.T) {
+	p := newBatchTestPlugin(true, 16)
+	go func() {
+		time.Sleep(eventBatchWindow + 100*time.Millisecond)
+		p.sendCh <- testEvent("late")
+	}()
+
+	start := time.Now()
+	msg, next := p.collectEventBatch(testEvent("1"))
+	if elapsed := time.Since(start); elapsed < eventBatchWindow {
+		t.Errorf("collected for %v, want at least the batch window of %v", elapsed, eventBatchWindow)
+	}
+	if ids := batchedIDs(msg); msg.GetEvent() == nil || !slices.Equal(ids, []string{"1"}) || next != nil {
+		t.Errorf("sent %v and %v, want the first event alone", msg, next)
+	}
+	select {
+	case msg := <-p.sendCh:
+		if msg.GetEvent().GetEventId() != "late" {
+			t.Errorf("queued %v, want the late event", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("late event not queued")
+	}
+}
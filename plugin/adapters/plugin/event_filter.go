@@ -0,0 +1,246 @@
+package plugin
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-gl/mathgl/mgl64"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// eventFilter is the host-side form of an EventFilter. Empty criteria match every event.
+type eventFilter struct {
+	worlds   map[string]struct{}
+	players  map[string]struct{}
+	blocks   map[string]struct{}
+	items    map[string]struct{}
+	commands map[string]struct{}
+
+	hasRegion            bool
+	regionMin, regionMax mgl64.Vec3
+}
+
+// newEventFilter compiles f, returning nil if it has no criteria.
+func newEventFilter(f *pb.EventFilter) *eventFilter {
+	if f == nil {
+		return nil
+	}
+	filter := &eventFilter{
+		worlds:   stringSet(f.Worlds, strings.ToLower),
+		players:  stringSet(f.PlayerUuids, strings.ToLower),
+		blocks:   stringSet(f.BlockNames, normalizeIdentifier),
+		items:    stringSet(f.ItemNames, normalizeIdentifier),
+		commands: stringSet(f.Commands, normalizeCommandName),
+	}
+	if r := f.Region; r != nil && r.Min != nil && r.Max != nil {
+		a := mgl64.Vec3{r.Min.X, r.Min.Y, r.Min.Z}
+		b := mgl64.Vec3{r.Max.X, r.Max.Y, r.Max.Z}
+		filter.hasRegion = true
+		filter.regionMin = mgl64.Vec3{min(a[0], b[0]), min(a[1], b[1]), min(a[2], b[2])}
+		filter.regionMax = mgl64.Vec3{max(a[0], b[0]), max(a[1], b[1]), max(a[2], b[2])}
+	}
+	if filter.worlds == nil && filter.players == nil && filter.blocks == nil && filter.items == nil &&
+		filter.commands == nil && !filter.hasRegion {
+		return nil
+	}
+	return filter
+}
+
+func stringSet(values []string, normalize func(string) string) map[string]struct{} {
+	var set map[string]struct{}
+	for _, v := range values {
+		if v = normalize(strings.TrimSpace(v)); v == "" {
+			continue
+		}
+		if set == nil {
+			set = make(map[string]struct{}, len(values))
+		}
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// normalizeIdentifier lowercases a block or item name and strips the default namespace.
+func normalizeIdentifier(name string) string {
+	return strings.TrimPrefix(strings.ToLower(name), "minecraft:")
+}
+
+func normalizeCommandName(name string) string {
+	return strings.ToLower(strings.TrimPrefix(name, "/"))
+}
+
+// matches reports whether the event described by attrs passes every criterion of the filter.
+func (f *eventFilter) matches(attrs *eventAttributes) bool {
+	if f.worlds != nil && !containsAny(f.worlds, attrs.worlds) {
+		return false
+	}
+	if f.players != nil && !containsAny(f.players, attrs.players) {
+		return false
+	}
+	if f.blocks != nil && !containsAny(f.blocks, attrs.blocks) {
+		return false
+	}
+	if f.items != nil && !containsAny(f.items, attrs.items) {
+		return false
+	}
+	if f.commands != nil && !containsAny(f.commands, attrs.commands) {
+		return false
+	}
+	if f.hasRegion {
+		if !attrs.hasPosition {
+			return false
+		}
+		for i := range 3 {
+			if attrs.position[i] < f.regionMin[i] || attrs.position[i] > f.regionMax[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func containsAny(set map[string]struct{}, values []string) bool {
+	for _, v := range values {
+		if _, ok := set[v]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// eventAttributes are the filterable values of an event. They are read from the payload by field
+// name, so every event type with e.g. a player_uuid, world or position field is covered without
+// per-event code.
+type eventAttributes struct {
+	worlds   []string
+	players  []string
+	blocks   []string
+	items    []string
+	commands []string
+
+	hasPosition bool
+	position    mgl64.Vec3
+	hasRotation bool
+	rotation    mgl64.Vec2 // yaw, pitch
+}
+
+func newEventAttributes(envelope *pb.EventEnvelope) *eventAttributes {
+	attrs := &eventAttributes{}
+	env := envelope.ProtoReflect()
+	field := env.WhichOneof(env.Descriptor().Oneofs().ByName("payload"))
+	if field == nil || field.Message() == nil {
+		return attrs
+	}
+	payload := env.Get(field).Message()
+	fields := payload.Descriptor().Fields()
+
+	if fd := fields.ByName("player_uuid"); fd != nil && fd.Kind() == protoreflect.StringKind {
+		attrs.players = appendNonEmpty(attrs.players, strings.ToLower(payload.Get(fd).String()))
+	}
+	if fd := fields.ByName("world"); fd != nil {
+		switch {
+		case fd.Kind() == protoreflect.StringKind:
+			attrs.worlds = appendNonEmpty(attrs.worlds, strings.ToLower(payload.Get(fd).String()))
+		case fd.Message() != nil && payload.Has(fd):
+			ref := payload.Get(fd).Message()
+			for _, name := range []protoreflect.Name{"name", "dimension", "id"} {
+				attrs.worlds = appendNonEmpty(attrs.worlds, strings.ToLower(messageString(ref, name)))
+			}
+		}
+	}
+	if fd := fields.ByName("block"); fd != nil && fd.Message() != nil && payload.Has(fd) {
+		attrs.blocks = appendNonEmpty(attrs.blocks, normalizeIdentifier(messageString(payload.Get(fd).Message(), "name")))
+	}
+	if fd := fields.ByName("item"); fd != nil && fd.Message() != nil && payload.Has(fd) {
+		attrs.items = appendNonEmpty(attrs.items, normalizeIdentifier(messageString(payload.Get(fd).Message(), "name")))
+	}
+	if fd := fields.ByName("command"); fd != nil && fd.Kind() == protoreflect.StringKind {
+		attrs.commands = appendNonEmpty(attrs.commands, normalizeCommandName(payload.Get(fd).String()))
+	}
+	if fd := fields.ByName("position"); fd != nil && fd.Message() != nil && payload.Has(fd) {
+		attrs.position, attrs.hasPosition = messagePosition(payload.Get(fd).Message())
+	}
+	if fd := fields.ByName("rotation"); fd != nil && fd.Message() != nil && payload.Has(fd) {
+		attrs.rotation, attrs.hasRotation = messageRotation(payload.Get(fd).Message())
+	}
+	return attrs
+}
+
+func appendNonEmpty(values []string, v string) []string {
+	if v == "" {
+		return values
+	}
+	return append(values, v)
+}
+
+// messageString returns the string field name of msg, or "" if it has none.
+func messageString(msg protoreflect.Message, name protoreflect.Name) string {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return msg.Get(fd).String()
+}
+
+// messagePosition reads the x, y and z fields of a Vec3 or BlockPos message.
+func messagePosition(msg protoreflect.Message) (mgl64.Vec3, bool) {
+	var pos mgl64.Vec3
+	for i, name := range []protoreflect.Name{"x", "y", "z"} {
+		fd := msg.Descriptor().Fields().ByName(name)
+		if fd == nil {
+			return pos, false
+		}
+		switch fd.Kind() {
+		case protoreflect.DoubleKind, protoreflect.FloatKind:
+			pos[i] = msg.Get(fd).Float()
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind:
+			pos[i] = float64(msg.Get(fd).Int())
+		default:
+			return pos, false
+		}
+	}
+	return pos, true
+}
+
+// messageRotation reads the yaw and pitch fields of a Rotation message.
+func messageRotation(msg protoreflect.Message) (mgl64.Vec2, bool) {
+	var rot mgl64.Vec2
+	for i, name := range []protoreflect.Name{"yaw", "pitch"} {
+		fd := msg.Descriptor().Fields().ByName(name)
+		if fd == nil || (fd.Kind() != protoreflect.FloatKind && fd.Kind() != protoreflect.DoubleKind) {
+			return rot, false
+		}
+		rot[i] = msg.Get(fd).Float()
+	}
+	return rot, true
+}
+
+// subscribers returns the plugins subscribed to the event whose filters and throttles accept it.
+// Attributes are only extracted when a subscriber has a filter or throttle.
+func (m *Manager) subscribers(envelope *pb.EventEnvelope) []*pluginProcess {
+	var attrs *eventAttributes
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	procs := make([]*pluginProcess, 0, len(m.plugins))
+	for _, proc := range m.plugins {
+		sub, ok := proc.subscription(envelope.Type)
+		if !ok {
+			continue
+		}
+		if sub.filter != nil || sub.throttle != nil {
+			if attrs == nil {
+				attrs = newEventAttributes(envelope)
+			}
+			if sub.filter != nil && !sub.filter.matches(attrs) {
+				continue
+			}
+			if sub.throttle != nil && !sub.throttle.allow(attrs, time.Now()) {
+				continue
+			}
+		}
+		procs = append(procs, proc)
+	}
+	return procs
+}
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"testing"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestEventFilterMatches(t *testing.T) {
+	const (
+		steve = "8d4b0c6e-1f0a-4c61-9d43-1c2b3a4d5e6f"
+		alex  = "0f6e5d4c-3b2a-4c1d-8e9f-a0b1c2d3e4f5"
+	)
+	blockBreak := func(world, player, block string, x, y, z int32) *pb.EventEnvelope {
+		return &pb.EventEnvelope{Type: pb.EventType_PLAYER_BLOCK_BREAK, Payload: &pb.EventEnvelope_BlockBreak{
+			BlockBreak: &pb.BlockBreakEvent{
+				PlayerUuid: player,
+				World:      world,
+				Position:   &pb.BlockPos{X: x, Y: y, Z: z},
+				Block:      &pb.BlockState{Name: block},
+			},
+		}}
+	}
+	itemUse := &pb.EventEnvelope{Type: pb.EventType_PLAYER_ITEM_USE, Payload: &pb.EventEnvelope_PlayerItemUse{
+		PlayerItemUse: &pb.PlayerItemUseEvent{PlayerUuid: steve, World: "overworld", Item: &pb.ItemStack{Name: "minecraft:bow"}},
+	}}
+	command := &pb.EventEnvelope{Type: pb.EventType_COMMAND, Payload: &pb.EventEnvelope_Command{
+		Command: &pb.CommandEvent{PlayerUuid: steve, Command: "Spawn"},
+	}}
+	chat := &pb.EventEnvelope{Type: pb.EventType_CHAT, Payload: &pb.EventEnvelope_Chat{
+		Chat: &pb.ChatEvent{PlayerUuid: steve, Message: "hi"},
+	}}
+	region := &pb.BBox{Min: &pb.Vec3{X: 10, Y: 0, Z: 10}, Max: &pb.Vec3{X: -10, Y: 64, Z: -10}}
+
+	tests := []struct {
+		name   string
+		filter *pb.EventFilter
+		event  *pb.EventEnvelope
+		want   bool
+	}{
+		{"world", &pb.EventFilter{Worlds: []string{"Nether"}}, blockBreak("nether", steve, "stone", 0, 0, 0), true},
+		{"other world", &pb.EventFilter{Worlds: []string{"nether"}}, blockBreak("overworld", steve, "stone", 0, 0, 0), false},
+		{"event without world", &pb.EventFilter{Worlds: []string{"overworld"}}, chat, false},
+		{"player in set", &pb.EventFilter{PlayerUuids: []string{alex, steve}}, blockBreak("overworld", steve, "stone", 0, 0, 0), true},
+		{"player uuid case", &pb.EventFilter{PlayerUuids: []string{"8D4B0C6E-1F0A-4C61-9D43-1C2B3A4D5E6F"}}, chat, true},
+		{"player not in set", &pb.EventFilter{PlayerUuids: []string{alex}}, chat, false},
+		{"block", &pb.EventFilter{BlockNames: []string{"minecraft:diamond_ore"}}, blockBreak("overworld", steve, "minecraft:diamond_ore", 0, 0, 0), true},
+		{"block without namespace", &pb.EventFilter{BlockNames: []string{"diamond_ore"}}, blockBreak("overworld", steve, "minecraft:diamond_ore", 0, 0, 0), true},
+		{"other block", &pb.EventFilter{BlockNames: []string{"diamond_ore"}}, blockBreak("overworld", steve, "minecraft:stone", 0, 0, 0), false},
+		{"item", &pb.EventFilter{ItemNames: []string{"BOW"}}, itemUse, true},
+		{"other item", &pb.EventFilter{ItemNames: []string{"crossbow"}}, itemUse, false},
+		{"event without item", &pb.EventFilter{ItemNames: []string{"bow"}}, chat, false},
+		{"command", &pb.EventFilter{Commands: []string{"/spawn"}}, command, true},
+		{"other command", &pb.EventFilter{Commands: []string{"home"}}, command, false},
+		{"inside region", &pb.EventFilter{Region: region}, blockBreak("overworld", steve, "stone", -10, 64, 3), true},
+		{"outside region", &pb.EventFilter{Region: region}, blockBreak("overworld", steve, "stone", 0, 65, 0), false},
+		{"event without position", &pb.EventFilter{Region: region}, chat, false},
+		{
+			name:   "every criterion",
+			filter: &pb.EventFilter{Worlds: []string{"overworld"}, PlayerUuids: []string{steve}, BlockNames: []string{"stone"}, Region: region},
+			event:  blockBreak("overworld", steve, "minecraft:stone", 1, 2, 3),
+			want:   true,
+		},
+		{
+			name:   "one criterion fails",
+			filter: &pb.EventFilter{Worlds: []string{"overworld"}, PlayerUuids: []string{alex}, BlockNames: []string{"stone"}},
+			event:  blockBreak("overworld", steve, "minecraft:stone", 1, 2, 3),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newEventFilter(tt.filter)
+			if f == nil {
+				t.Fatal("newEventFilter returned nil for a filter with criteria")
+			}
+			if got := f.matches(newEventAttributes(tt.event)); got != tt.want {
+				t.Errorf("matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewEventFilterEmpty(t *testing.T) {
+	for _, f := range []*pb.EventFilter{nil, {}, {Worlds: []string{" "}}, {Region: &pb.BBox{Min: &pb.Vec3{}}}} {
+		if got := newEventFilter(f); got != nil {
+			t.Errorf("newEventFilter(%v) = %+v, want nil", f, got)
+		}
+	}
+}
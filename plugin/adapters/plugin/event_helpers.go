@@ -2,12 +2,12 @@ package plugin
 
 import (
 	"fmt"
+	"math"
 	"net"
 	"strconv"
 	"strings"
 
 	"github.com/df-mc/dragonfly/server/block/cube"
-	"github.com/df-mc/dragonfly/server/item"
 	"github.com/df-mc/dragonfly/server/player"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/session"
@@ -124,44 +124,6 @@ func protoLiquidOrBlockState(b world.Block) *pb.LiquidState {
 	return &pb.LiquidState{Block: protoBlockState(b)}
 }
 
-func protoItemStack(it item.Stack) *pb.ItemStack {
-	if it.Empty() {
-		return nil
-	}
-	itm := it.Item()
-	if itm == nil {
-		return nil
-	}
-	name, meta := itm.EncodeItem()
-	return &pb.ItemStack{
-		Name:  name,
-		Meta:  int32(meta),
-		Count: int32(it.Count()),
-	}
-}
-
-func protoItemStackPtr(it *item.Stack) *pb.ItemStack {
-	if it == nil {
-		return nil
-	}
-	return protoItemStack(*it)
-}
-
-func convertProtoItemStackValue(stack *pb.ItemStack) (item.Stack, bool) {
-	if stack == nil || stack.Name == "" {
-		return item.Stack{}, false
-	}
-	material, ok := world.ItemByName(stack.Name, int16(stack.Meta))
-	if !ok {
-		return item.Stack{}, false
-	}
-	count := int(stack.Count)
-	if count <= 0 {
-		return item.Stack{}, false
-	}
-	return item.NewStack(material, count), true
-}
-
 func blockFromProto(state *pb.BlockState) (world.Block, bool) {
 	if state == nil || state.Name == "" {
 		return nil, false
@@ -210,6 +172,16 @@ func vec3FromProto(vec *pb.Vec3) (mgl64.Vec3, bool) {
 	return mgl64.Vec3{float64(vec.X), float64(vec.Y), float64(vec.Z)}, true
 }
 
+// finiteVec3 reports whether every component of v is a finite number.
+func finiteVec3(v mgl64.Vec3) bool {
+	for _, f := range v {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return false
+		}
+	}
+	return true
+}
+
 func parseProtoAddress(addr *pb.Address) *net.UDPAddr {
 	if addr == nil {
 		return nil
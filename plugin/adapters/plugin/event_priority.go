@@ -0,0 +1,205 @@
+package plugin
+
+import (
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// eventSubscription holds the options a plugin subscribed to an event with.
+type eventSubscription struct {
+	priority         pb.EventPriority
+	receiveCancelled bool
+	// filter is nil when the subscription accepts every event of its type.
+	filter *eventFilter
+	// throttle is nil when events are not rate or delta limited.
+	throttle *eventThrottle
+	// observeOnly subscribers are dispatched like MONITOR and never waited for.
+	observeOnly bool
+}
+
+func newEventSubscription(opt *pb.EventSubscription) *eventSubscription {
+	return &eventSubscription{
+		priority:         opt.GetPriority(),
+		receiveCancelled: opt.GetReceiveCancelled(),
+		filter:           newEventFilter(opt.GetFilter()),
+		throttle:         newEventThrottle(opt.GetThrottle()),
+		observeOnly:      opt.GetObserveOnly(),
+	}
+}
+
+// priorityRanks orders EventPriority values by dispatch order; the enum numbering keeps
+// NORMAL as the proto3 default and therefore does not reflect it.
+var priorityRanks = map[pb.EventPriority]int{
+	pb.EventPriority_EVENT_PRIORITY_LOWEST:  0,
+	pb.EventPriority_EVENT_PRIORITY_LOW:     1,
+	pb.EventPriority_EVENT_PRIORITY_NORMAL:  2,
+	pb.EventPriority_EVENT_PRIORITY_HIGH:    3,
+	pb.EventPriority_EVENT_PRIORITY_HIGHEST: 4,
+	pb.EventPriority_EVENT_PRIORITY_MONITOR: 5,
+}
+
+const (
+	monitorRank   = 5
+	priorityCount = monitorRank + 1
+)
+
+func priorityRank(p pb.EventPriority) int {
+	if rank, ok := priorityRanks[p]; ok {
+		return rank
+	}
+	return priorityRanks[pb.EventPriority_EVENT_PRIORITY_NORMAL]
+}
+
+// priorityGroups returns the plugins subscribed to the event, grouped by priority rank and sorted
+// by plugin ID within each group so dispatch order is deterministic. ok is false when no plugin
+// is subscribed or every subscriber filtered the event out.
+func (m *Manager) priorityGroups(envelope *pb.EventEnvelope) (groups [priorityCount][]*pluginProcess, ok bool) {
+	for _, proc := range m.subscribers(envelope) {
+		sub, subscribed := proc.subscription(envelope.Type)
+		if !subscribed {
+			continue
+		}
+		rank := priorityRank(sub.priority)
+		if sub.observeOnly {
+			rank = monitorRank
+		}
+		groups[rank] = append(groups[rank], proc)
+		ok = true
+	}
+	for _, group := range groups {
+		slices.SortFunc(group, func(a, b *pluginProcess) int { return strings.Compare(a.id, b.id) })
+	}
+	return groups, ok
+}
+
+// receivingCancelled returns the plugins in procs that subscribed to eventType with receive_cancelled.
+func receivingCancelled(procs []*pluginProcess, eventType pb.EventType) []*pluginProcess {
+	var out []*pluginProcess
+	for _, proc := range procs {
+		if sub, ok := proc.subscription(eventType); ok && sub.receiveCancelled {
+			out = append(out, proc)
+		}
+	}
+	return out
+}
+
+// sendEventOutcome notifies every receive_cancelled subscriber of how the event was resolved.
+func (m *Manager) sendEventOutcome(groups [priorityCount][]*pluginProcess, outcome *pb.EventOutcome) {
+	for _, procs := range groups {
+		for _, proc := range receivingCancelled(procs, outcome.Type) {
+			proc.queue(&pb.HostToPlugin{
+				PluginId: proc.id,
+				Payload:  &pb.HostToPlugin_EventOutcome{EventOutcome: outcome},
+			})
+		}
+	}
+}
+
+// mutatedEnvelope returns a copy of envelope with the mutations in results applied to its payload,
+// so that later priority groups observe earlier edits. The original envelope is left untouched
+// because it may still be queued for sending.
+func mutatedEnvelope(envelope *pb.EventEnvelope, results []*pb.EventResult) *pb.EventEnvelope {
+	out := proto.Clone(envelope).(*pb.EventEnvelope)
+	for _, res := range results {
+		applyEnvelopeMutation(out, res)
+	}
+	return out
+}
+
+// applyEnvelopeMutation applies a single EventResult mutation to the matching event payload.
+// Mutations of values that are not part of the event payload, such as block break drops, are
+// only applied to the server once dispatch completes.
+func applyEnvelopeMutation(envelope *pb.EventEnvelope, res *pb.EventResult) {
+	if res == nil {
+		return
+	}
+	switch mut := res.Update.(type) {
+	case *pb.EventResult_Chat:
+		if evt := envelope.GetChat(); evt != nil {
+			mutateField(&evt.Message, mut.Chat.Message)
+		}
+	case *pb.EventResult_PlayerFoodLoss:
+		if evt := envelope.GetPlayerFoodLoss(); evt != nil {
+			mutateField(&evt.To, mut.PlayerFoodLoss.To)
+		}
+	case *pb.EventResult_PlayerHeal:
+		if evt := envelope.GetPlayerHeal(); evt != nil {
+			mutateField(&evt.Amount, mut.PlayerHeal.Amount)
+		}
+	case *pb.EventResult_PlayerHurt:
+		if evt := envelope.GetPlayerHurt(); evt != nil {
+			mutateField(&evt.Damage, mut.PlayerHurt.Damage)
+			mutateField(&evt.AttackImmunityMs, mut.PlayerHurt.AttackImmunityMs)
+		}
+	case *pb.EventResult_PlayerDeath:
+		if evt := envelope.GetPlayerDeath(); evt != nil {
+			mutateField(&evt.KeepInventory, mut.PlayerDeath.KeepInventory)
+		}
+	case *pb.EventResult_PlayerRespawn:
+		if evt := envelope.GetPlayerRespawn(); evt != nil {
+			if mut.PlayerRespawn.Position != nil {
+				evt.Position = mut.PlayerRespawn.Position
+			}
+			evt.World = mut.PlayerRespawn.World
+		}
+	case *pb.EventResult_PlayerAttackEntity:
+		if evt := envelope.GetPlayerAttackEntity(); evt != nil {
+			mutateField(&evt.Force, mut.PlayerAttackEntity.Force)
+			mutateField(&evt.Height, mut.PlayerAttackEntity.Height)
+			mutateField(&evt.Critical, mut.PlayerAttackEntity.Critical)
+		}
+	case *pb.EventResult_PlayerExperienceGain:
+		if evt := envelope.GetPlayerExperienceGain(); evt != nil {
+			mutateField(&evt.Amount, mut.PlayerExperienceGain.Amount)
+		}
+	case *pb.EventResult_PlayerLecternPageTurn:
+		if evt := envelope.GetPlayerLecternPageTurn(); evt != nil {
+			mutateField(&evt.NewPage, mut.PlayerLecternPageTurn.NewPage)
+		}
+	case *pb.EventResult_PlayerItemPickup:
+		if evt := envelope.GetPlayerItemPickup(); evt != nil && mut.PlayerItemPickup.Item != nil {
+			evt.Item = mut.PlayerItemPickup.Item
+		}
+	case *pb.EventResult_PlayerTransfer:
+		if evt := envelope.GetPlayerTransfer(); evt != nil && mut.PlayerTransfer.Address != nil {
+			evt.Address = mut.PlayerTransfer.Address
+		}
+	case *pb.EventResult_WorldExplosion:
+		evt := envelope.GetWorldExplosion()
+		if evt == nil {
+			return
+		}
+		if mut.WorldExplosion.EntityUuids != nil {
+			evt.AffectedEntities = filterEntityRefsByUUIDs(evt.AffectedEntities, mut.WorldExplosion.EntityUuids.Values)
+		}
+		if mut.WorldExplosion.Blocks != nil {
+			evt.AffectedBlocks = mut.WorldExplosion.Blocks.Positions
+		}
+		mutateField(&evt.ItemDropChance, mut.WorldExplosion.ItemDropChance)
+		mutateField(&evt.SpawnFire, mut.WorldExplosion.SpawnFire)
+	}
+}
+
+// filterEntityRefsByUUIDs mirrors filterEntitiesByUUIDs for entity references in event payloads.
+func filterEntityRefsByUUIDs(refs []*pb.EntityRef, uuids []string) []*pb.EntityRef {
+	allowed := make(map[string]struct{}, len(uuids))
+	for _, id := range uuids {
+		if id != "" {
+			allowed[strings.ToLower(id)] = struct{}{}
+		}
+	}
+	if len(allowed) == 0 {
+		return refs
+	}
+	filtered := make([]*pb.EntityRef, 0, len(refs))
+	for _, ref := range refs {
+		if _, ok := allowed[strings.ToLower(ref.GetUuid())]; ok {
+			filtered = append(filtered, ref)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,229 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestPriorityRank(t *testing.T) {
+	order := []pb.EventPriority{
+		pb.EventPriority_EVENT_PRIORITY_LOWEST,
+		pb.EventPriority_EVENT_PRIORITY_LOW,
+		pb.EventPriority_EVENT_PRIORITY_NORMAL,
+		pb.EventPriority_EVENT_PRIORITY_HIGH,
+		pb.EventPriority_EVENT_PRIORITY_HIGHEST,
+		pb.EventPriority_EVENT_PRIORITY_MONITOR,
+	}
+	for i, p := range order {
+		if got := priorityRank(p); got != i {
+			t.Errorf("priorityRank(%v) = %d, want %d", p, got, i)
+		}
+	}
+	if got, want := priorityRank(pb.EventPriority(99)), priorityRank(pb.EventPriority_EVENT_PRIORITY_NORMAL); got != want {
+		t.Errorf("priorityRank of an unknown priority = %d, want NORMAL (%d)", got, want)
+	}
+}
+
+func TestPriorityGroups(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	for id, opt := range map[string]*pb.EventSubscription{
+		"c":        {Priority: pb.EventPriority_EVENT_PRIORITY_LOW},
+		"a":        {Priority: pb.EventPriority_EVENT_PRIORITY_LOW},
+		"b":        {},
+		"high":     {Priority: pb.EventPriority_EVENT_PRIORITY_HIGHEST},
+		"monitor":  {Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR},
+		"observer": {Priority: pb.EventPriority_EVENT_PRIORITY_LOWEST, ObserveOnly: true},
+	} {
+		subscribe(newTestPlugin(m, id), pb.EventType_CHAT, opt)
+	}
+	subscribe(newTestPlugin(m, "other"), pb.EventType_PLAYER_JUMP, &pb.EventSubscription{})
+
+	groups, ok := m.priorityGroups(&pb.EventEnvelope{Type: pb.EventType_CHAT})
+	if !ok {
+		t.Fatal("priorityGroups found no subscribers")
+	}
+	want := [priorityCount][]string{
+		1:           {"a", "c"},
+		2:           {"b"},
+		4:           {"high"},
+		monitorRank: {"monitor", "observer"},
+	}
+	for rank, procs := range groups {
+		var ids []string
+		for _, p := range procs {
+			ids = append(ids, p.id)
+		}
+		if !slices.Equal(ids, want[rank]) {
+			t.Errorf("group %d = %v, want %v", rank, ids, want[rank])
+		}
+	}
+
+	if _, ok := m.priorityGroups(&pb.EventEnvelope{Type: pb.EventType_PLAYER_QUIT}); ok {
+		t.Error("priorityGroups found subscribers of an event nobody subscribed to")
+	}
+}
+
+func TestMutatedEnvelope(t *testing.T) {
+	envelope := chatEnvelope("hello")
+	out := mutatedEnvelope(envelope, []*pb.EventResult{nil, chatResult("first"), {}, chatResult("second")})
+	if got := out.GetChat().Message; got != "second" {
+		t.Errorf("mutated message = %q, want the last mutation", got)
+	}
+	if got := envelope.GetChat().Message; got != "hello" {
+		t.Errorf("original message = %q, want it untouched", got)
+	}
+
+	// Mutations of other events leave the payload alone.
+	amount := 5.0
+	out = mutatedEnvelope(envelope, []*pb.EventResult{{Update: &pb.EventResult_PlayerHeal{PlayerHeal: &pb.PlayerHealMutation{Amount: &amount}}}})
+	if got := out.GetChat().Message; got != "hello" {
+		t.Errorf("mutated message = %q after a heal mutation, want it untouched", got)
+	}
+}
+
+// prioritySubscriber is a plugin subscribed to CHAT that answers each event it is sent.
+type prioritySubscriber struct {
+	id  string
+	opt *pb.EventSubscription
+	// set replaces the chat message if not empty.
+	set    string
+	cancel bool
+}
+
+func TestEmitCancellablePriorities(t *testing.T) {
+	var (
+		lowest  = pb.EventPriority_EVENT_PRIORITY_LOWEST
+		low     = pb.EventPriority_EVENT_PRIORITY_LOW
+		high    = pb.EventPriority_EVENT_PRIORITY_HIGH
+		highest = pb.EventPriority_EVENT_PRIORITY_HIGHEST
+	)
+	tests := []struct {
+		name        string
+		subscribers []prioritySubscriber
+		// seen holds the chat message each subscriber was sent.
+		seen map[string]string
+		// observed holds the message each receive_cancelled subscriber observed after the event
+		// was cancelled.
+		observed  map[string]string
+		final     string
+		cancelled bool
+	}{
+		{
+			name: "mutations reach later groups",
+			subscribers: []prioritySubscriber{
+				{id: "a", opt: &pb.EventSubscription{Priority: lowest}, set: "lowest"},
+				{id: "b", opt: &pb.EventSubscription{Priority: low}},
+				{id: "c", opt: &pb.EventSubscription{Priority: high}, set: "high"},
+			},
+			seen:  map[string]string{"a": "hello", "b": "lowest", "c": "lowest"},
+			final: "high",
+		},
+		{
+			name: "one group sees the same payload",
+			subscribers: []prioritySubscriber{
+				{id: "b", opt: &pb.EventSubscription{Priority: low}, set: "b"},
+				{id: "a", opt: &pb.EventSubscription{Priority: low}, set: "a"},
+				{id: "c", opt: &pb.EventSubscription{Priority: high}},
+			},
+			// Mutations within a group are applied in plugin ID order.
+			seen:  map[string]string{"a": "hello", "b": "hello", "c": "b"},
+			final: "b",
+		},
+		{
+			name: "cancelled",
+			subscribers: []prioritySubscriber{
+				{id: "a", opt: &pb.EventSubscription{Priority: lowest}, set: "lowest"},
+				{id: "b", opt: &pb.EventSubscription{Priority: low}, set: "low", cancel: true},
+				{id: "c", opt: &pb.EventSubscription{Priority: high}},
+				{id: "d", opt: &pb.EventSubscription{Priority: highest, ReceiveCancelled: true}},
+			},
+			seen:      map[string]string{"a": "hello", "b": "lowest"},
+			observed:  map[string]string{"d": "lowest"},
+			final:     "lowest",
+			cancelled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			monitor := newTestPlugin(m, "monitor")
+			subscribe(monitor, pb.EventType_CHAT, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+
+			var mu sync.Mutex
+			seen := map[string]string{}
+			observers := map[string]*pluginProcess{}
+			for _, s := range tt.subscribers {
+				p := newTestPlugin(m, s.id)
+				subscribe(p, pb.EventType_CHAT, s.opt)
+				if _, ok := tt.observed[s.id]; ok {
+					observers[s.id] = p
+					continue
+				}
+				respond(t, p, func(evt *pb.EventEnvelope) *pb.EventResult {
+					mu.Lock()
+					seen[s.id] = evt.GetChat().Message
+					mu.Unlock()
+					res := &pb.EventResult{Cancel: &s.cancel}
+					if s.set != "" {
+						res.Update = chatResult(s.set).Update
+					}
+					return res
+				})
+			}
+
+			ctx := &testCancelContext{}
+			envelope := chatEnvelope("hello")
+			results := m.emitCancellable(ctx, envelope)
+
+			mu.Lock()
+			if !maps.Equal(seen, tt.seen) {
+				t.Errorf("subscribers were sent %v, want %v", seen, tt.seen)
+			}
+			mu.Unlock()
+			for id, want := range tt.observed {
+				evts := events(observers[id])
+				if len(evts) != 1 || !evts[0].Cancelled || evts[0].ExpectsResponse || evts[0].GetChat().Message != want {
+					t.Errorf("%s observed %v, want the cancelled event with message %q", id, evts, want)
+				}
+			}
+			evts := events(monitor)
+			if len(evts) != 1 {
+				t.Fatalf("monitor received %d events, want 1", len(evts))
+			}
+			if got := evts[0].GetChat().Message; got != tt.final || evts[0].Cancelled != tt.cancelled || evts[0].ExpectsResponse {
+				t.Errorf("monitor received %q (cancelled %v, expects response %v), want the final message %q (cancelled %v)", got, evts[0].Cancelled, evts[0].ExpectsResponse, tt.final, tt.cancelled)
+			}
+			if ctx.cancelled != tt.cancelled {
+				t.Errorf("context cancelled = %v, want %v", ctx.cancelled, tt.cancelled)
+			}
+			if tt.cancelled && results != nil {
+				t.Errorf("emitCancellable returned %v for a cancelled event, want nil", results)
+			}
+			if got := envelope.GetChat().Message; got != "hello" {
+				t.Errorf("original message = %q, want it untouched", got)
+			}
+		})
+	}
+}
+
+// testCancelContext records whether the event was cancelled.
+type testCancelContext struct{ cancelled bool }
+
+func (c *testCancelContext) Cancel() { c.cancelled = true }
+
+func chatEnvelope(message string) *pb.EventEnvelope {
+	return &pb.EventEnvelope{
+		Type:    pb.EventType_CHAT,
+		Payload: &pb.EventEnvelope_Chat{Chat: &pb.ChatEvent{Message: message}},
+	}
+}
+
+func chatResult(message string) *pb.EventResult {
+	return &pb.EventResult{Update: &pb.EventResult_Chat{Chat: &pb.ChatMutation{Message: &message}}}
+}
@@ -0,0 +1,230 @@
+package plugin
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/go-gl/mathgl/mgl64"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// moveBatchInterval is the interval at which observe-only PLAYER_MOVE batches are sent, one
+// server tick.
+const moveBatchInterval = time.Second / 20
+
+// eventThrottle drops events of a subscription that change too little or arrive too often.
+type eventThrottle struct {
+	minDistance float64
+	minAngle    float64
+	interval    time.Duration
+
+	mu   sync.Mutex
+	last map[string]throttleState
+}
+
+// throttleState is the last event delivered for a throttle key.
+type throttleState struct {
+	at          time.Time
+	hasPosition bool
+	position    mgl64.Vec3
+	hasRotation bool
+	rotation    mgl64.Vec2
+}
+
+// newEventThrottle compiles t, returning nil if it sets no limits.
+func newEventThrottle(t *pb.EventThrottle) *eventThrottle {
+	if t == nil {
+		return nil
+	}
+	throttle := &eventThrottle{
+		minDistance: max(t.MinDistance, 0),
+		minAngle:    max(t.MinAngle, 0),
+		last:        make(map[string]throttleState),
+	}
+	if t.MaxPerSecond > 0 {
+		throttle.interval = time.Duration(float64(time.Second) / t.MaxPerSecond)
+	}
+	if throttle.minDistance == 0 && throttle.minAngle == 0 && throttle.interval == 0 {
+		return nil
+	}
+	return throttle
+}
+
+// allow reports whether the event described by attrs should be delivered, and records it as the
+// last delivered event if so. The first event of every player or world is always delivered.
+func (t *eventThrottle) allow(attrs *eventAttributes, now time.Time) bool {
+	key := throttleKey(attrs)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	last, seen := t.last[key]
+	if seen && !t.changedEnough(last, attrs) {
+		return false
+	}
+	if seen && t.interval > 0 && now.Sub(last.at) < t.interval {
+		return false
+	}
+	t.last[key] = throttleState{
+		at:          now,
+		hasPosition: attrs.hasPosition,
+		position:    attrs.position,
+		hasRotation: attrs.hasRotation,
+		rotation:    attrs.rotation,
+	}
+	return true
+}
+
+// changedEnough applies the distance and angle deltas. Deltas the event cannot be measured by,
+// such as a distance on an event without a position, are ignored.
+func (t *eventThrottle) changedEnough(last throttleState, attrs *eventAttributes) bool {
+	checkDistance := t.minDistance > 0 && attrs.hasPosition && last.hasPosition
+	checkAngle := t.minAngle > 0 && attrs.hasRotation && last.hasRotation
+	if !checkDistance && !checkAngle {
+		return true
+	}
+	if checkDistance && attrs.position.Sub(last.position).Len() >= t.minDistance {
+		return true
+	}
+	if checkAngle && (angleDelta(attrs.rotation[0], last.rotation[0]) >= t.minAngle ||
+		angleDelta(attrs.rotation[1], last.rotation[1]) >= t.minAngle) {
+		return true
+	}
+	return false
+}
+
+// forget drops the state of a player or world.
+func (t *eventThrottle) forget(key string) {
+	t.mu.Lock()
+	delete(t.last, key)
+	t.mu.Unlock()
+}
+
+// throttleKey returns the player the event is about, or its world if it has no player.
+func throttleKey(attrs *eventAttributes) string {
+	if len(attrs.players) > 0 {
+		return attrs.players[0]
+	}
+	if len(attrs.worlds) > 0 {
+		return attrs.worlds[0]
+	}
+	return ""
+}
+
+// angleDelta returns the smallest difference between two angles in degrees.
+func angleDelta(a, b float64) float64 {
+	d := math.Mod(math.Abs(a-b), 360)
+	if d > 180 {
+		d = 360 - d
+	}
+	return d
+}
+
+// moveBatch collects the moves for an observe-only PLAYER_MOVE subscriber until the next tick.
+type moveBatch struct {
+	mu    sync.Mutex
+	moves []*pb.PlayerMoveEvent
+	index map[string]int
+}
+
+// add queues a move, replacing an earlier move of the same player in this tick.
+func (b *moveBatch) add(move *pb.PlayerMoveEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if i, ok := b.index[move.PlayerUuid]; ok {
+		b.moves[i] = move
+		return
+	}
+	if b.index == nil {
+		b.index = make(map[string]int)
+	}
+	b.index[move.PlayerUuid] = len(b.moves)
+	b.moves = append(b.moves, move)
+}
+
+// batchMoves adds the final move of a PLAYER_MOVE event to the batches of the observe-only
+// subscribers in procs and returns the other plugins, which receive the event itself.
+func batchMoves(procs []*pluginProcess, final *pb.EventEnvelope) []*pluginProcess {
+	move := final.GetPlayerMove()
+	if move == nil {
+		return procs
+	}
+	others := procs[:0:0]
+	for _, proc := range procs {
+		if sub, ok := proc.subscription(final.Type); ok && sub.observeOnly {
+			observed := proto.Clone(move).(*pb.PlayerMoveEvent)
+			observed.Cancelled = final.Cancelled
+			proc.moves.add(observed)
+			continue
+		}
+		others = append(others, proc)
+	}
+	return others
+}
+
+// take returns the queued moves and empties the batch.
+func (b *moveBatch) take() []*pb.PlayerMoveEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	moves := b.moves
+	b.moves = nil
+	clear(b.index)
+	return moves
+}
+
+// sendMoveBatches sends the moves collected for observe-only subscribers once per tick until the
+// manager is closed.
+func (m *Manager) sendMoveBatches() {
+	ticker := time.NewTicker(moveBatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		m.mu.RLock()
+		procs := make([]*pluginProcess, 0, len(m.plugins))
+		for _, proc := range m.plugins {
+			procs = append(procs, proc)
+		}
+		m.mu.RUnlock()
+
+		for _, proc := range procs {
+			moves := proc.moves.take()
+			if len(moves) == 0 {
+				continue
+			}
+			proc.queue(&pb.HostToPlugin{
+				PluginId: proc.id,
+				Payload: &pb.HostToPlugin_Event{
+					Event: &pb.EventEnvelope{
+						EventId: m.generateEventID(),
+						Type:    pb.EventType_PLAYER_MOVE,
+						Payload: &pb.EventEnvelope_PlayerMoveBatch{
+							PlayerMoveBatch: &pb.PlayerMoveBatch{Moves: moves},
+						},
+					},
+				},
+			})
+		}
+	}
+}
+
+// forgetThrottles drops the throttle state of a player that left.
+func (m *Manager) forgetThrottles(playerUUID string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, proc := range m.plugins {
+		subs := proc.subscriptions.Load()
+		if subs == nil {
+			continue
+		}
+		for _, sub := range *subs {
+			if sub.throttle != nil {
+				sub.throttle.forget(playerUUID)
+			}
+		}
+	}
+}
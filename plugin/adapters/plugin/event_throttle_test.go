@@ -0,0 +1,233 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/go-gl/mathgl/mgl64"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestEventThrottleAllow(t *testing.T) {
+	const player = "8d4b0c6e-1f0a-4c61-9d43-1c2b3a4d5e6f"
+	start := time.Unix(0, 0)
+	type event struct {
+		after    time.Duration
+		position mgl64.Vec3
+		rotation mgl64.Vec2
+		player   string
+	}
+
+	tests := []struct {
+		name     string
+		throttle *pb.EventThrottle
+		events   []event
+		want     []bool
+	}{
+		{
+			name:     "distance",
+			throttle: &pb.EventThrottle{MinDistance: 1},
+			events: []event{
+				{position: mgl64.Vec3{0, 64, 0}},
+				{position: mgl64.Vec3{0.5, 64, 0}},
+				{position: mgl64.Vec3{0.9, 64, 0.3}},
+				{position: mgl64.Vec3{1, 64, 0}},
+				{position: mgl64.Vec3{1.5, 64, 0}},
+			},
+			// Distances are measured from the last delivered event.
+			want: []bool{true, false, false, true, false},
+		},
+		{
+			name:     "angle",
+			throttle: &pb.EventThrottle{MinAngle: 10},
+			events: []event{
+				{rotation: mgl64.Vec2{175, 0}},
+				{rotation: mgl64.Vec2{-178, 0}},
+				{rotation: mgl64.Vec2{-170, 0}},
+				{rotation: mgl64.Vec2{-170, 9}},
+				{rotation: mgl64.Vec2{-170, -10}},
+			},
+			want: []bool{true, false, true, false, true},
+		},
+		{
+			name:     "distance or angle",
+			throttle: &pb.EventThrottle{MinDistance: 2, MinAngle: 45},
+			events: []event{
+				{},
+				{position: mgl64.Vec3{1, 0, 0}, rotation: mgl64.Vec2{30, 0}},
+				{position: mgl64.Vec3{1, 0, 0}, rotation: mgl64.Vec2{90, 0}},
+				{position: mgl64.Vec3{3, 0, 0}, rotation: mgl64.Vec2{90, 0}},
+			},
+			want: []bool{true, false, true, true},
+		},
+		{
+			name:     "rate",
+			throttle: &pb.EventThrottle{MaxPerSecond: 4},
+			events: []event{
+				{},
+				{after: 100 * time.Millisecond},
+				{after: 250 * time.Millisecond},
+				{after: 400 * time.Millisecond},
+				{after: 500 * time.Millisecond},
+			},
+			want: []bool{true, false, true, false, true},
+		},
+		{
+			name:     "rate and distance",
+			throttle: &pb.EventThrottle{MinDistance: 1, MaxPerSecond: 10},
+			events: []event{
+				{},
+				{after: 50 * time.Millisecond, position: mgl64.Vec3{5, 0, 0}},
+				{after: 150 * time.Millisecond},
+				{after: 200 * time.Millisecond, position: mgl64.Vec3{5, 0, 0}},
+			},
+			want: []bool{true, false, false, true},
+		},
+		{
+			name:     "per player",
+			throttle: &pb.EventThrottle{MaxPerSecond: 1},
+			events: []event{
+				{},
+				{player: "0f6e5d4c-3b2a-4c1d-8e9f-a0b1c2d3e4f5"},
+				{after: time.Millisecond},
+			},
+			want: []bool{true, true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttle := newEventThrottle(tt.throttle)
+			if throttle == nil {
+				t.Fatal("newEventThrottle returned nil for a throttle with limits")
+			}
+			for i, e := range tt.events {
+				id := e.player
+				if id == "" {
+					id = player
+				}
+				attrs := &eventAttributes{
+					players:     []string{id},
+					hasPosition: true,
+					position:    e.position,
+					hasRotation: true,
+					rotation:    e.rotation,
+				}
+				if got := throttle.allow(attrs, start.Add(e.after)); got != tt.want[i] {
+					t.Errorf("event %d: allow = %v, want %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestNewEventThrottleEmpty(t *testing.T) {
+	for _, throttle := range []*pb.EventThrottle{nil, {}, {MinDistance: -1, MaxPerSecond: -5}} {
+		if got := newEventThrottle(throttle); got != nil {
+			t.Errorf("newEventThrottle(%v) = %+v, want nil", throttle, got)
+		}
+	}
+}
+
+func TestAngleDelta(t *testing.T) {
+	tests := []struct{ a, b, want float64 }{
+		{10, 20, 10},
+		{170, -170, 20},
+		{-90, 270, 0},
+		{0, 180, 180},
+		{720, 1, 1},
+	}
+	for _, tt := range tests {
+		if got := angleDelta(tt.a, tt.b); got != tt.want {
+			t.Errorf("angleDelta(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMoveBatch(t *testing.T) {
+	var b moveBatch
+	b.add(&pb.PlayerMoveEvent{PlayerUuid: "a", Position: &pb.Vec3{X: 1}})
+	b.add(&pb.PlayerMoveEvent{PlayerUuid: "b", Position: &pb.Vec3{X: 2}})
+	b.add(&pb.PlayerMoveEvent{PlayerUuid: "a", Position: &pb.Vec3{X: 3}, Cancelled: true})
+
+	moves := b.take()
+	if len(moves) != 2 {
+		t.Fatalf("took %d moves, want 2", len(moves))
+	}
+	if moves[0].PlayerUuid != "a" || moves[0].Position.X != 3 || !moves[0].Cancelled {
+		t.Errorf("first move = %v, want the latest, cancelled move of a", moves[0])
+	}
+	if moves[1].PlayerUuid != "b" {
+		t.Errorf("second move = %v, want the move of b", moves[1])
+	}
+	if moves := b.take(); len(moves) != 0 {
+		t.Errorf("took %d moves from an emptied batch", len(moves))
+	}
+}
+
+func TestBatchMoves(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	observer := newTestPlugin(m, "observer")
+	subscribe(observer, pb.EventType_PLAYER_MOVE, &pb.EventSubscription{ObserveOnly: true})
+	monitor := newTestPlugin(m, "monitor")
+	subscribe(monitor, pb.EventType_PLAYER_MOVE, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+
+	tests := []struct {
+		name      string
+		cancelled bool
+	}{
+		{"resolved", false},
+		{"cancelled", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			final := &pb.EventEnvelope{
+				Type:      pb.EventType_PLAYER_MOVE,
+				Cancelled: tt.cancelled,
+				Payload:   &pb.EventEnvelope_PlayerMove{PlayerMove: &pb.PlayerMoveEvent{PlayerUuid: "a"}},
+			}
+			others := batchMoves([]*pluginProcess{monitor, observer}, final)
+			if len(others) != 1 || others[0] != monitor {
+				t.Fatalf("batchMoves returned %v, want only the MONITOR subscriber", others)
+			}
+			moves := observer.moves.take()
+			if len(moves) != 1 || moves[0].Cancelled != tt.cancelled {
+				t.Fatalf("batched %v, want one move with cancelled %v", moves, tt.cancelled)
+			}
+			if final.GetPlayerMove().Cancelled {
+				t.Error("batchMoves changed the final event")
+			}
+		})
+	}
+
+	// Observe-only subscribers of other events receive the event itself.
+	chat := &pb.EventEnvelope{Type: pb.EventType_CHAT, Payload: &pb.EventEnvelope_Chat{Chat: &pb.ChatEvent{}}}
+	if others := batchMoves([]*pluginProcess{observer}, chat); len(others) != 1 {
+		t.Errorf("batchMoves returned %v for a chat event, want the observer", others)
+	}
+}
+
+func TestEmitMoveBatchesAfterOutcome(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	observer := newTestPlugin(m, "observer")
+	subscribe(observer, pb.EventType_PLAYER_MOVE, &pb.EventSubscription{ObserveOnly: true})
+
+	m.emitCancellable(nil, &pb.EventEnvelope{
+		Type:    pb.EventType_PLAYER_MOVE,
+		Payload: &pb.EventEnvelope_PlayerMove{PlayerMove: &pb.PlayerMoveEvent{PlayerUuid: "a"}},
+	})
+	if msgs := received(observer); len(msgs) != 0 {
+		t.Fatalf("observer received %d messages, want the move batched", len(msgs))
+	}
+	if moves := observer.moves.take(); len(moves) != 1 || moves[0].Cancelled {
+		t.Fatalf("batched %v, want one move that was not cancelled", moves)
+	}
+}
+
+// subscribe sets the subscription of p to eventType.
+func subscribe(p *pluginProcess, eventType pb.EventType, opt *pb.EventSubscription) {
+	subs := map[pb.EventType]*eventSubscription{eventType: newEventSubscription(opt)}
+	p.subscriptions.Store(&subs)
+}
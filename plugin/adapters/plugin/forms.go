@@ -0,0 +1,382 @@
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/player/dialogue"
+	"github.com/df-mc/dragonfly/server/player/form"
+	"github.com/df-mc/dragonfly/server/world"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// menuFormResponder is a menu form whose buttons carry plugin-defined IDs. It implements form.Form directly
+// so the pressed button is identified by its submitted index, even when several buttons look the same.
+type menuFormResponder struct {
+	mgr      *Manager
+	pluginID string
+	formID   string
+	title    string
+	body     string
+	buttons  []form.Button
+	ids      []string
+}
+
+// newMenuFormResponder returns the menu form of act, which reports to the plugin with the ID passed.
+func newMenuFormResponder(m *Manager, pluginID, formID string, act *pb.PlayerSendMenuFormAction) menuFormResponder {
+	buttons := act.FormButtons
+	if len(buttons) == 0 {
+		// Plugins built against older SDKs still send plain text buttons.
+		for _, text := range act.Buttons {
+			buttons = append(buttons, &pb.FormButton{Text: text})
+		}
+	}
+	f := menuFormResponder{
+		mgr:      m,
+		pluginID: pluginID,
+		formID:   formID,
+		title:    act.Title,
+		body:     act.GetBody(),
+		buttons:  make([]form.Button, len(buttons)),
+		ids:      make([]string, len(buttons)),
+	}
+	for i, btn := range buttons {
+		f.buttons[i] = form.NewButton(btn.GetText(), btn.GetImage())
+		f.ids[i] = btn.GetId()
+	}
+	return f
+}
+
+// MarshalJSON ...
+func (f menuFormResponder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":    "form",
+		"title":   f.title,
+		"content": f.body,
+		"buttons": f.buttons,
+	})
+}
+
+// SubmitJSON resolves the pressed button index and forwards it to the plugin.
+func (f menuFormResponder) SubmitJSON(b []byte, sub form.Submitter, _ *world.Tx) error {
+	if b == nil {
+		f.mgr.emitFormResponse(f.pluginID, f.formID, sub, -1, "")
+		return nil
+	}
+	var index uint
+	if err := json.Unmarshal(b, &index); err != nil {
+		return fmt.Errorf("cannot parse button index as int: %w", err)
+	}
+	if index >= uint(len(f.buttons)) {
+		return fmt.Errorf("button index points to inexistent button: %v (only %v buttons present)", index, len(f.buttons))
+	}
+	evt := newFormResponse(f.pluginID, f.formID, sub, false)
+	if evt == nil {
+		return nil
+	}
+	idx := int32(index)
+	evt.ButtonIndex = &idx
+	evt.ButtonText = &f.buttons[index].Text
+	if id := f.ids[index]; id != "" {
+		evt.ButtonId = &id
+	}
+	f.mgr.sendFormResponse(evt)
+	return nil
+}
+
+// formModalResponder is a modal form that reports which of its two buttons was pressed. Yes maps to button
+// index 0, No to 1. Like menuFormResponder it implements form.Form directly, so the index is read from the
+// submitted bool and stays correct when both buttons have the same text.
+type formModalResponder struct {
+	mgr      *Manager
+	pluginID string
+	formID   string
+	title    string
+	body     string
+	yes      string
+	no       string
+}
+
+// MarshalJSON ...
+func (f formModalResponder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":    "modal",
+		"title":   f.title,
+		"content": f.body,
+		"button1": f.yes,
+		"button2": f.no,
+	})
+}
+
+// SubmitJSON resolves the pressed button from the submitted bool and forwards it to the plugin.
+func (f formModalResponder) SubmitJSON(b []byte, sub form.Submitter, _ *world.Tx) error {
+	if b == nil {
+		f.mgr.emitFormResponse(f.pluginID, f.formID, sub, -1, "")
+		return nil
+	}
+	var yes bool
+	if err := json.Unmarshal(b, &yes); err != nil {
+		return fmt.Errorf("error parsing JSON as bool: %w", err)
+	}
+	if yes {
+		f.mgr.emitFormResponse(f.pluginID, f.formID, sub, 0, f.yes)
+		return nil
+	}
+	f.mgr.emitFormResponse(f.pluginID, f.formID, sub, 1, f.no)
+	return nil
+}
+
+// dialogueResponder reports dialogue button presses back to the plugin that sent the dialogue.
+type dialogueResponder struct {
+	mgr      *Manager
+	pluginID string
+	formID   string
+	buttons  []dialogue.Button
+	// texts holds the button texts the plugin sent, which buttons may differ from.
+	texts []string
+}
+
+// newDialogueResponder returns the responder of a dialogue with buttons labelled texts. Dialogue buttons
+// hold nothing but their text and the pressed button is submitted by value, so buttons that repeat the
+// text of an earlier one get invisible reset codes appended until every button is unique.
+func newDialogueResponder(m *Manager, pluginID, formID string, texts []string) dialogueResponder {
+	buttons := make([]dialogue.Button, len(texts))
+	for i, text := range texts {
+		btn := dialogue.Button{Text: text}
+		for slices.Contains(buttons[:i], btn) {
+			btn.Text += "§r"
+		}
+		buttons[i] = btn
+	}
+	return dialogueResponder{mgr: m, pluginID: pluginID, formID: formID, buttons: buttons, texts: texts}
+}
+
+func (r dialogueResponder) Submit(sub dialogue.Submitter, pressed dialogue.Button, _ *world.Tx) {
+	index := slices.Index(r.buttons, pressed)
+	if index < 0 {
+		return
+	}
+	r.mgr.emitDialogueResponse(r.pluginID, r.formID, sub, index, r.texts[index])
+}
+
+func (r dialogueResponder) Close(sub dialogue.Submitter, _ *world.Tx) {
+	r.mgr.emitDialogueResponse(r.pluginID, r.formID, sub, -1, "")
+}
+
+// formResponseID returns the plugin-supplied form ID, falling back to the action correlation ID.
+func formResponseID(formID *string, correlationID string) string {
+	if formID != nil && *formID != "" {
+		return *formID
+	}
+	return correlationID
+}
+
+func (m *Manager) emitFormResponse(pluginID, formID string, sub form.Submitter, index int, text string) {
+	evt := newFormResponse(pluginID, formID, sub, index < 0)
+	if evt == nil {
+		return
+	}
+	if index >= 0 {
+		idx := int32(index)
+		evt.ButtonIndex = &idx
+		evt.ButtonText = &text
+	}
+	m.sendFormResponse(evt)
+}
+
+func newFormResponse(pluginID, formID string, sub form.Submitter, closed bool) *pb.PlayerFormResponseEvent {
+	pl, ok := sub.(*player.Player)
+	if !ok {
+		return nil
+	}
+	return &pb.PlayerFormResponseEvent{
+		PlayerUuid: pl.UUID().String(),
+		Name:       pl.Name(),
+		PluginId:   pluginID,
+		FormId:     formID,
+		Closed:     closed,
+	}
+}
+
+func (m *Manager) sendFormResponse(evt *pb.PlayerFormResponseEvent) {
+	m.sendEventTo(evt.PluginId, &pb.EventEnvelope{
+		Type:    pb.EventType_PLAYER_FORM_RESPONSE,
+		Payload: &pb.EventEnvelope_PlayerFormResponse{PlayerFormResponse: evt},
+	})
+}
+
+func (m *Manager) emitDialogueResponse(pluginID, formID string, sub dialogue.Submitter, index int, text string) {
+	pl, ok := sub.(*player.Player)
+	if !ok {
+		return
+	}
+	evt := &pb.PlayerDialogueResponseEvent{
+		PlayerUuid: pl.UUID().String(),
+		Name:       pl.Name(),
+		PluginId:   pluginID,
+		FormId:     formID,
+		Closed:     index < 0,
+	}
+	if index >= 0 {
+		idx := int32(index)
+		evt.ButtonIndex = &idx
+		evt.ButtonText = &text
+	}
+	m.sendEventTo(pluginID, &pb.EventEnvelope{
+		Type:    pb.EventType_PLAYER_DIALOGUE_RESPONSE,
+		Payload: &pb.EventEnvelope_PlayerDialogueResponse{PlayerDialogueResponse: evt},
+	})
+}
+
+// customFormResponder is a custom form built from a plugin-supplied element list. Dragonfly's form.Custom
+// derives its elements from struct fields, so the form is implemented directly against form.Form and the
+// submitted values are reported back as a PlayerFormResponseEvent.
+type customFormResponder struct {
+	mgr      *Manager
+	pluginID string
+	formID   string
+	title    string
+	elements []form.Element
+}
+
+// MarshalJSON ...
+func (f customFormResponder) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":    "custom_form",
+		"title":   f.title,
+		"content": f.elements,
+	})
+}
+
+// SubmitJSON validates the submitted values against the form elements and forwards them to the plugin.
+func (f customFormResponder) SubmitJSON(b []byte, sub form.Submitter, _ *world.Tx) error {
+	if b == nil {
+		if evt := newFormResponse(f.pluginID, f.formID, sub, true); evt != nil {
+			f.mgr.sendFormResponse(evt)
+		}
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewBuffer(b))
+	dec.UseNumber()
+	var data []any
+	if err := dec.Decode(&data); err != nil {
+		return fmt.Errorf("error decoding JSON data to slice: %w", err)
+	}
+	if len(data) < len(f.elements) {
+		return fmt.Errorf("form JSON data array does not have enough values")
+	}
+
+	values := make([]*pb.FormValue, 0, len(f.elements))
+	for i, elem := range f.elements {
+		val, err := parseCustomFormValue(elem, data[i])
+		if err != nil {
+			return fmt.Errorf("error parsing form response value: %w", err)
+		}
+		if val == nil {
+			continue
+		}
+		val.Index = int32(i)
+		values = append(values, val)
+	}
+
+	evt := newFormResponse(f.pluginID, f.formID, sub, false)
+	if evt == nil {
+		return nil
+	}
+	evt.Values = values
+	f.mgr.sendFormResponse(evt)
+	return nil
+}
+
+// parseCustomFormValue converts a raw submitted value for elem. Labels carry no value and yield nil.
+func parseCustomFormValue(elem form.Element, raw any) (*pb.FormValue, error) {
+	switch e := elem.(type) {
+	case form.Label:
+		return nil, nil
+	case form.Input:
+		s, ok := raw.(string)
+		if !ok || !utf8.ValidString(s) {
+			return nil, fmt.Errorf("value %v is not allowed for input element", raw)
+		}
+		return &pb.FormValue{Value: &pb.FormValue_Input{Input: s}}, nil
+	case form.Toggle:
+		b, ok := raw.(bool)
+		if !ok {
+			return nil, fmt.Errorf("value %v is not allowed for toggle element", raw)
+		}
+		return &pb.FormValue{Value: &pb.FormValue_Toggle{Toggle: b}}, nil
+	case form.Slider:
+		n, ok := raw.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("value %v is not allowed for slider element", raw)
+		}
+		v, err := n.Float64()
+		if err != nil || v < e.Min || v > e.Max {
+			return nil, fmt.Errorf("slider value %v is out of range %v-%v", raw, e.Min, e.Max)
+		}
+		return &pb.FormValue{Value: &pb.FormValue_Slider{Slider: v}}, nil
+	case form.Dropdown:
+		idx, err := parseOptionIndex(raw, len(e.Options))
+		if err != nil {
+			return nil, err
+		}
+		return &pb.FormValue{Value: &pb.FormValue_Dropdown{Dropdown: int32(idx)}, Option: &e.Options[idx]}, nil
+	case form.StepSlider:
+		idx, err := parseOptionIndex(raw, len(e.Options))
+		if err != nil {
+			return nil, err
+		}
+		return &pb.FormValue{Value: &pb.FormValue_StepSlider{StepSlider: int32(idx)}, Option: &e.Options[idx]}, nil
+	default:
+		return nil, fmt.Errorf("unsupported form element %T", elem)
+	}
+}
+
+func parseOptionIndex(raw any, options int) (int, error) {
+	n, ok := raw.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("value %v is not a valid option index", raw)
+	}
+	idx, err := n.Int64()
+	if err != nil || idx < 0 || int(idx) >= options {
+		return 0, fmt.Errorf("option index %v is out of range 0-%v", raw, options-1)
+	}
+	return int(idx), nil
+}
+
+// convertFormElement maps a proto form element to its Dragonfly counterpart.
+func convertFormElement(elem *pb.FormElement) (form.Element, bool) {
+	switch e := elem.GetElement().(type) {
+	case *pb.FormElement_Label:
+		return form.NewLabel(e.Label.GetText()), true
+	case *pb.FormElement_Input:
+		return form.NewInput(e.Input.GetText(), e.Input.GetDefaultValue(), e.Input.GetPlaceholder()), true
+	case *pb.FormElement_Toggle:
+		return form.NewToggle(e.Toggle.GetText(), e.Toggle.GetDefaultValue()), true
+	case *pb.FormElement_Slider:
+		s := e.Slider
+		if s.GetMin() > s.GetMax() {
+			return nil, false
+		}
+		return form.NewSlider(s.GetText(), s.GetMin(), s.GetMax(), s.GetStepSize(), s.GetDefaultValue()), true
+	case *pb.FormElement_Dropdown:
+		d := e.Dropdown
+		if len(d.GetOptions()) == 0 || d.GetDefaultIndex() < 0 || int(d.GetDefaultIndex()) >= len(d.GetOptions()) {
+			return nil, false
+		}
+		return form.NewDropdown(d.GetText(), d.GetOptions(), int(d.GetDefaultIndex())), true
+	case *pb.FormElement_StepSlider:
+		d := e.StepSlider
+		if len(d.GetOptions()) == 0 || d.GetDefaultIndex() < 0 || int(d.GetDefaultIndex()) >= len(d.GetOptions()) {
+			return nil, false
+		}
+		return form.NewStepSlider(d.GetText(), d.GetOptions(), int(d.GetDefaultIndex())), true
+	default:
+		return nil, false
+	}
+}
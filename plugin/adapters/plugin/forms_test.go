@@ -0,0 +1,448 @@
+package plugin
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/player/dialogue"
+	"github.com/df-mc/dragonfly/server/player/form"
+	"github.com/df-mc/dragonfly/server/world"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestFormModalResponderSubmit(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		// index is -1 if the form was closed.
+		index   int32
+		text    string
+		wantErr bool
+	}{
+		{name: "yes", data: []byte("true"), index: 0, text: "Confirm"},
+		{name: "no", data: []byte("false"), index: 1, text: "Confirm"},
+		{name: "closed", index: -1},
+		{name: "malformed", data: []byte("1"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "forms")
+			// Both buttons have the same text, so only the submitted bool tells them apart.
+			f := formModalResponder{mgr: m, pluginID: "forms", formID: "confirm", yes: "Confirm", no: "Confirm"}
+
+			w, h := newTestWorld(t)
+			var err error
+			inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+				err = f.SubmitJSON(tt.data, pl, tx)
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SubmitJSON = %v, want error %v", err, tt.wantErr)
+			}
+			evts := events(p)
+			if tt.wantErr {
+				if len(evts) != 0 {
+					t.Fatalf("plugin received %v for a malformed submission", evts)
+				}
+				return
+			}
+			if len(evts) != 1 {
+				t.Fatalf("plugin received %d events, want 1", len(evts))
+			}
+			res := evts[0].GetPlayerFormResponse()
+			if res.FormId != "confirm" || res.PlayerUuid != h.UUID().String() {
+				t.Errorf("response = %v, want form confirm of the player", res)
+			}
+			if tt.index < 0 {
+				if !res.Closed || res.ButtonIndex != nil {
+					t.Errorf("response = %v, want the form closed", res)
+				}
+				return
+			}
+			if res.Closed || res.GetButtonIndex() != tt.index || res.GetButtonText() != tt.text {
+				t.Errorf("response = %v, want button %d (%q)", res, tt.index, tt.text)
+			}
+		})
+	}
+}
+
+func TestFormModalResponderMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(formModalResponder{title: "Shop", body: "Buy?", yes: "Yes", no: "No"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]string
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{"type": "modal", "title": "Shop", "content": "Buy?", "button1": "Yes", "button2": "No"}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestDialogueResponderSubmit(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	p := newTestPlugin(m, "forms")
+	texts := []string{"Buy", "Buy", "Sell", "Buy"}
+	sub := newDialogueResponder(m, "forms", "shop", texts)
+	d := dialogue.New(sub, "Shop").WithButtons(sub.buttons...)
+
+	w, h := newTestWorld(t)
+	for i := range texts {
+		inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+			if err := d.Submit(uint(i), pl, tx); err != nil {
+				t.Fatalf("Submit(%d): %v", i, err)
+			}
+		})
+		evts := events(p)
+		if len(evts) != 1 {
+			t.Fatalf("plugin received %d events for button %d, want 1", len(evts), i)
+		}
+		res := evts[0].GetPlayerDialogueResponse()
+		if res.FormId != "shop" || res.Closed || res.GetButtonIndex() != int32(i) || res.GetButtonText() != texts[i] {
+			t.Errorf("response = %v, want button %d (%q)", res, i, texts[i])
+		}
+	}
+
+	inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+		d.Close(pl, tx)
+	})
+	if evts := events(p); len(evts) != 1 || !evts[0].GetPlayerDialogueResponse().Closed || evts[0].GetPlayerDialogueResponse().ButtonIndex != nil {
+		t.Errorf("plugin received %v, want the dialogue closed", evts)
+	}
+}
+
+func TestConvertFormElement(t *testing.T) {
+	options := []string{"a", "b", "c"}
+	tests := []struct {
+		name string
+		elem *pb.FormElement
+		want form.Element
+	}{
+		{
+			name: "label",
+			elem: &pb.FormElement{Element: &pb.FormElement_Label{Label: &pb.FormLabel{Text: "Hello"}}},
+			want: form.NewLabel("Hello"),
+		},
+		{
+			name: "input",
+			elem: &pb.FormElement{Element: &pb.FormElement_Input{Input: &pb.FormInput{Text: "Name", DefaultValue: "Steve", Placeholder: "name"}}},
+			want: form.NewInput("Name", "Steve", "name"),
+		},
+		{
+			name: "toggle",
+			elem: &pb.FormElement{Element: &pb.FormElement_Toggle{Toggle: &pb.FormToggle{Text: "PvP", DefaultValue: true}}},
+			want: form.NewToggle("PvP", true),
+		},
+		{
+			name: "slider",
+			elem: &pb.FormElement{Element: &pb.FormElement_Slider{Slider: &pb.FormSlider{Text: "Amount", Min: 1, Max: 10, StepSize: 1, DefaultValue: 5}}},
+			want: form.NewSlider("Amount", 1, 10, 1, 5),
+		},
+		{
+			name: "slider min above max",
+			elem: &pb.FormElement{Element: &pb.FormElement_Slider{Slider: &pb.FormSlider{Text: "Amount", Min: 10, Max: 1}}},
+		},
+		{
+			name: "dropdown",
+			elem: &pb.FormElement{Element: &pb.FormElement_Dropdown{Dropdown: &pb.FormDropdown{Text: "Kit", Options: options, DefaultIndex: 2}}},
+			want: form.NewDropdown("Kit", options, 2),
+		},
+		{
+			name: "dropdown without options",
+			elem: &pb.FormElement{Element: &pb.FormElement_Dropdown{Dropdown: &pb.FormDropdown{Text: "Kit"}}},
+		},
+		{
+			name: "dropdown default out of range",
+			elem: &pb.FormElement{Element: &pb.FormElement_Dropdown{Dropdown: &pb.FormDropdown{Text: "Kit", Options: options, DefaultIndex: 3}}},
+		},
+		{
+			name: "step slider",
+			elem: &pb.FormElement{Element: &pb.FormElement_StepSlider{StepSlider: &pb.FormStepSlider{Text: "Speed", Options: options, DefaultIndex: 1}}},
+			want: form.NewStepSlider("Speed", options, 1),
+		},
+		{
+			name: "step slider negative default",
+			elem: &pb.FormElement{Element: &pb.FormElement_StepSlider{StepSlider: &pb.FormStepSlider{Text: "Speed", Options: options, DefaultIndex: -1}}},
+		},
+		{name: "empty", elem: &pb.FormElement{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := convertFormElement(tt.elem)
+			if ok != (tt.want != nil) || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("convertFormElement = %#v, %v, want %#v", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCustomFormValue(t *testing.T) {
+	options := []string{"a", "b", "c"}
+	option := func(s string) *string { return &s }
+	tests := []struct {
+		name string
+		elem form.Element
+		raw  any
+		// want is nil if the value is skipped or, with wantErr, rejected.
+		want    *pb.FormValue
+		wantErr bool
+	}{
+		{name: "label", elem: form.NewLabel("Hello"), raw: nil},
+		{name: "input", elem: form.NewInput("Name", "", ""), raw: "Steve", want: &pb.FormValue{Value: &pb.FormValue_Input{Input: "Steve"}}},
+		{name: "input not a string", elem: form.NewInput("Name", "", ""), raw: json.Number("1"), wantErr: true},
+		{name: "input invalid UTF-8", elem: form.NewInput("Name", "", ""), raw: "\xff", wantErr: true},
+		{name: "toggle", elem: form.NewToggle("PvP", false), raw: true, want: &pb.FormValue{Value: &pb.FormValue_Toggle{Toggle: true}}},
+		{name: "toggle not a bool", elem: form.NewToggle("PvP", false), raw: "true", wantErr: true},
+		{name: "slider", elem: form.NewSlider("Amount", 1, 10, 1, 5), raw: json.Number("2.5"), want: &pb.FormValue{Value: &pb.FormValue_Slider{Slider: 2.5}}},
+		{name: "slider at max", elem: form.NewSlider("Amount", 1, 10, 1, 5), raw: json.Number("10"), want: &pb.FormValue{Value: &pb.FormValue_Slider{Slider: 10}}},
+		{name: "slider below min", elem: form.NewSlider("Amount", 1, 10, 1, 5), raw: json.Number("0"), wantErr: true},
+		{name: "slider above max", elem: form.NewSlider("Amount", 1, 10, 1, 5), raw: json.Number("10.5"), wantErr: true},
+		{name: "slider not a number", elem: form.NewSlider("Amount", 1, 10, 1, 5), raw: "5", wantErr: true},
+		{name: "dropdown", elem: form.NewDropdown("Kit", options, 0), raw: json.Number("1"), want: &pb.FormValue{Value: &pb.FormValue_Dropdown{Dropdown: 1}, Option: option("b")}},
+		{name: "dropdown negative", elem: form.NewDropdown("Kit", options, 0), raw: json.Number("-1"), wantErr: true},
+		{name: "dropdown past the options", elem: form.NewDropdown("Kit", options, 0), raw: json.Number("3"), wantErr: true},
+		{name: "dropdown fraction", elem: form.NewDropdown("Kit", options, 0), raw: json.Number("1.5"), wantErr: true},
+		{name: "step slider", elem: form.NewStepSlider("Speed", options, 0), raw: json.Number("2"), want: &pb.FormValue{Value: &pb.FormValue_StepSlider{StepSlider: 2}, Option: option("c")}},
+		{name: "step slider past the options", elem: form.NewStepSlider("Speed", options, 0), raw: json.Number("3"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCustomFormValue(tt.elem, tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCustomFormValue error = %v, want error %v", err, tt.wantErr)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("parseCustomFormValue = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCustomFormResponderSubmitJSON(t *testing.T) {
+	elements := []form.Element{
+		form.NewLabel("Pick a kit"),
+		form.NewInput("Name", "", ""),
+		form.NewDropdown("Kit", []string{"archer", "knight"}, 0),
+		form.NewSlider("Amount", 1, 10, 1, 1),
+	}
+	option := "knight"
+	tests := []struct {
+		name string
+		data []byte
+		// want holds the values reported, or is nil with closed or wantErr.
+		want    []*pb.FormValue
+		closed  bool
+		wantErr bool
+	}{
+		{
+			name: "submitted",
+			data: []byte(`[null, "Steve", 1, 4]`),
+			// The label has no value, so the values keep the index of their element.
+			want: []*pb.FormValue{
+				{Index: 1, Value: &pb.FormValue_Input{Input: "Steve"}},
+				{Index: 2, Value: &pb.FormValue_Dropdown{Dropdown: 1}, Option: &option},
+				{Index: 3, Value: &pb.FormValue_Slider{Slider: 4}},
+			},
+		},
+		{name: "closed", closed: true},
+		{name: "malformed", data: []byte(`{"name": "Steve"}`), wantErr: true},
+		{name: "truncated", data: []byte(`[null, "Steve"`), wantErr: true},
+		{name: "too few values", data: []byte(`[null, "Steve", 1]`), wantErr: true},
+		{name: "dropdown out of range", data: []byte(`[null, "Steve", 2, 4]`), wantErr: true},
+		{name: "slider out of range", data: []byte(`[null, "Steve", 1, 11]`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "forms")
+			f := customFormResponder{mgr: m, pluginID: "forms", formID: "kit", title: "Kits", elements: elements}
+
+			w, h := newTestWorld(t)
+			var err error
+			inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+				err = f.SubmitJSON(tt.data, pl, tx)
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SubmitJSON = %v, want error %v", err, tt.wantErr)
+			}
+			evts := events(p)
+			if tt.wantErr {
+				if len(evts) != 0 {
+					t.Fatalf("plugin received %v for a rejected submission", evts)
+				}
+				return
+			}
+			if len(evts) != 1 {
+				t.Fatalf("plugin received %d events, want 1", len(evts))
+			}
+			res := evts[0].GetPlayerFormResponse()
+			if res.FormId != "kit" || res.Closed != tt.closed || len(res.Values) != len(tt.want) {
+				t.Fatalf("response = %v, want form kit (closed %v) with %d values", res, tt.closed, len(tt.want))
+			}
+			for i, v := range res.Values {
+				if !proto.Equal(v, tt.want[i]) {
+					t.Errorf("value %d = %v, want %v", i, v, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCustomFormResponderMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(customFormResponder{title: "Kits", elements: []form.Element{form.NewLabel("Pick a kit"), form.NewToggle("PvP", true)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Type    string           `json:"type"`
+		Title   string           `json:"title"`
+		Content []map[string]any `json:"content"`
+	}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != "custom_form" || got.Title != "Kits" || len(got.Content) != 2 {
+		t.Fatalf("form = %s, want a custom form titled Kits with 2 elements", b)
+	}
+	if got.Content[0]["type"] != "label" || got.Content[1]["type"] != "toggle" || got.Content[1]["default"] != true {
+		t.Errorf("elements = %v, want the label and the toggle", got.Content)
+	}
+}
+
+func TestNewMenuFormResponder(t *testing.T) {
+	id := "shop"
+	tests := []struct {
+		name string
+		act  *pb.PlayerSendMenuFormAction
+		// want holds the JSON of each button.
+		want []map[string]any
+		ids  []string
+	}{
+		{
+			name: "form buttons",
+			act: &pb.PlayerSendMenuFormAction{
+				FormButtons: []*pb.FormButton{
+					{Text: "Shop", Image: proto.String("textures/items/apple"), Id: &id},
+					{Text: "Website", Image: proto.String("https://example.com/icon.png")},
+					{Text: "Close"},
+				},
+				// Plain text buttons are ignored once form buttons are set.
+				Buttons: []string{"Ignored"},
+			},
+			want: []map[string]any{
+				{"text": "Shop", "image": map[string]any{"type": "path", "data": "textures/items/apple"}},
+				{"text": "Website", "image": map[string]any{"type": "url", "data": "https://example.com/icon.png"}},
+				{"text": "Close"},
+			},
+			ids: []string{"shop", "", ""},
+		},
+		{
+			name: "plain text buttons",
+			act:  &pb.PlayerSendMenuFormAction{Buttons: []string{"Yes", "No"}},
+			want: []map[string]any{{"text": "Yes"}, {"text": "No"}},
+			ids:  []string{"", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.act.Title, tt.act.Body = "Menu", proto.String("Pick one")
+			f := newMenuFormResponder(nil, "forms", "menu", tt.act)
+			if !reflect.DeepEqual(f.ids, tt.ids) {
+				t.Errorf("ids = %q, want %q", f.ids, tt.ids)
+			}
+			b, err := json.Marshal(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got struct {
+				Type    string           `json:"type"`
+				Title   string           `json:"title"`
+				Content string           `json:"content"`
+				Buttons []map[string]any `json:"buttons"`
+			}
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Type != "form" || got.Title != "Menu" || got.Content != "Pick one" {
+				t.Errorf("form = %s, want a menu titled Menu", b)
+			}
+			if !reflect.DeepEqual(got.Buttons, tt.want) {
+				t.Errorf("buttons = %v, want %v", got.Buttons, tt.want)
+			}
+		})
+	}
+}
+
+func TestMenuFormResponderSubmit(t *testing.T) {
+	act := &pb.PlayerSendMenuFormAction{FormButtons: []*pb.FormButton{
+		{Text: "Buy", Id: proto.String("buy-1")},
+		{Text: "Buy", Image: proto.String("textures/items/apple"), Id: proto.String("buy-2")},
+		{Text: "Close"},
+	}}
+	tests := []struct {
+		name string
+		data []byte
+		// index is -1 if the form was closed.
+		index   int32
+		text    string
+		id      *string
+		wantErr bool
+	}{
+		{name: "first", data: []byte("0"), index: 0, text: "Buy", id: proto.String("buy-1")},
+		// Buttons with the same text are told apart by their index.
+		{name: "same text", data: []byte("1"), index: 1, text: "Buy", id: proto.String("buy-2")},
+		{name: "without ID", data: []byte("2"), index: 2, text: "Close"},
+		{name: "closed", index: -1},
+		{name: "out of range", data: []byte("3"), wantErr: true},
+		{name: "malformed", data: []byte(`"0"`), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "forms")
+			f := newMenuFormResponder(m, "forms", "menu", act)
+
+			w, h := newTestWorld(t)
+			var err error
+			inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+				err = f.SubmitJSON(tt.data, pl, tx)
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SubmitJSON = %v, want error %v", err, tt.wantErr)
+			}
+			evts := events(p)
+			if tt.wantErr {
+				if len(evts) != 0 {
+					t.Fatalf("plugin received %v for a rejected submission", evts)
+				}
+				return
+			}
+			if len(evts) != 1 {
+				t.Fatalf("plugin received %d events, want 1", len(evts))
+			}
+			res := evts[0].GetPlayerFormResponse()
+			if tt.index < 0 {
+				if !res.Closed || res.ButtonIndex != nil || res.ButtonId != nil {
+					t.Errorf("response = %v, want the form closed", res)
+				}
+				return
+			}
+			if res.Closed || res.GetButtonIndex() != tt.index || res.GetButtonText() != tt.text {
+				t.Errorf("response = %v, want button %d (%q)", res, tt.index, tt.text)
+			}
+			if (res.ButtonId == nil) != (tt.id == nil) || (tt.id != nil && res.GetButtonId() != *tt.id) {
+				t.Errorf("button ID = %v, want %v", res.ButtonId, tt.id)
+			}
+		})
+	}
+}
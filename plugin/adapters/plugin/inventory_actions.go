@@ -0,0 +1,222 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+var errNoContainer = errors.New("no container at position")
+
+// slotInventory is an inventory targeted by an inventory action. *inventory.Inventory implements it
+// for every inventory except the offhand.
+type slotInventory interface {
+	Size() int
+	Item(slot int) (item.Stack, error)
+	SetItem(slot int, it item.Stack) error
+}
+
+// offhandInventory exposes the offhand of a player as an inventory with a single slot.
+type offhandInventory struct {
+	pl *player.Player
+}
+
+func (offhandInventory) Size() int {
+	return 1
+}
+
+func (o offhandInventory) Item(slot int) (item.Stack, error) {
+	if slot != 0 {
+		return item.Stack{}, errInvalidSlot(slot, 1)
+	}
+	_, offHand := o.pl.HeldItems()
+	return offHand, nil
+}
+
+func (o offhandInventory) SetItem(slot int, it item.Stack) error {
+	if slot != 0 {
+		return errInvalidSlot(slot, 1)
+	}
+	mainHand, _ := o.pl.HeldItems()
+	o.pl.SetHeldItems(mainHand, it)
+	return nil
+}
+
+func errInvalidSlot(slot, size int) error {
+	return fmt.Errorf("invalid slot %d: inventory has %d slots", slot, size)
+}
+
+func checkSlot(inv slotInventory, slot int32) error {
+	if slot < 0 || int(slot) >= inv.Size() {
+		return errInvalidSlot(int(slot), inv.Size())
+	}
+	return nil
+}
+
+// execInventory runs check, which may be nil, and then fn with the inventory selected by target and
+// reports the result. Player inventories are resolved on the player's world, containers in a
+// transaction of their world.
+func (m *Manager) execInventory(p *pluginProcess, correlationID string, target *pb.InventoryTarget, check func(inv slotInventory) error, fn func(inv slotInventory) (*pb.ActionResult, error)) {
+	if target == nil {
+		m.sendActionError(p, correlationID, "missing target")
+		return
+	}
+	switch target.Type {
+	case pb.InventoryType_INVENTORY_TYPE_PLAYER, pb.InventoryType_INVENTORY_TYPE_ARMOUR,
+		pb.InventoryType_INVENTORY_TYPE_OFFHAND, pb.InventoryType_INVENTORY_TYPE_ENDER_CHEST:
+		var checkPlayer func(pl *player.Player) error
+		if check != nil {
+			checkPlayer = func(pl *player.Player) error { return check(playerInventory(pl, target.Type)) }
+		}
+		m.execPlayerChecked(p, correlationID, target.PlayerUuid, checkPlayer, func(pl *player.Player) (*pb.ActionResult, error) {
+			return fn(playerInventory(pl, target.Type))
+		})
+	case pb.InventoryType_INVENTORY_TYPE_CONTAINER:
+		w := m.worldFromRef(target.GetWorld())
+		if w == nil {
+			m.sendActionError(p, correlationID, "world not found")
+			return
+		}
+		if target.Position == nil {
+			m.sendActionError(p, correlationID, "missing position")
+			return
+		}
+		pos := cube.Pos{int(target.Position.X), int(target.Position.Y), int(target.Position.Z)}
+		checkContainer := func(tx *world.Tx) error {
+			container, ok := tx.Block(pos).(block.Container)
+			if !ok {
+				return errNoContainer
+			}
+			if check != nil {
+				return check(container.Inventory(tx, pos))
+			}
+			return nil
+		}
+		m.execWorldChecked(p, correlationID, w, checkContainer, func(tx *world.Tx) {
+			result, err := fn(tx.Block(pos).(block.Container).Inventory(tx, pos))
+			m.reportActionResult(p, correlationID, result, err)
+		})
+	default:
+		m.sendActionError(p, correlationID, "unknown inventory type")
+	}
+}
+
+func playerInventory(pl *player.Player, t pb.InventoryType) slotInventory {
+	switch t {
+	case pb.InventoryType_INVENTORY_TYPE_ARMOUR:
+		return pl.Armour().Inventory()
+	case pb.InventoryType_INVENTORY_TYPE_OFFHAND:
+		return offhandInventory{pl: pl}
+	case pb.InventoryType_INVENTORY_TYPE_ENDER_CHEST:
+		return pl.EnderChestInventory()
+	default:
+		return pl.Inventory()
+	}
+}
+
+func (m *Manager) handleInventoryQuery(p *pluginProcess, correlationID string, act *pb.InventoryQueryAction) {
+	m.execInventory(p, correlationID, act.Target, nil, func(inv slotInventory) (*pb.ActionResult, error) {
+		slots := make([]*pb.InventorySlot, 0, inv.Size())
+		for i := 0; i < inv.Size(); i++ {
+			it, _ := inv.Item(i)
+			slots = append(slots, &pb.InventorySlot{Slot: int32(i), Item: protoItemStack(it)})
+		}
+		return &pb.ActionResult{Result: &pb.ActionResult_Inventory{
+			Inventory: &pb.InventoryResult{Target: act.Target, Slots: slots},
+		}}, nil
+	})
+}
+
+func (m *Manager) handleInventorySetSlot(p *pluginProcess, correlationID string, act *pb.InventorySetSlotAction) {
+	var stack item.Stack
+	if act.Item != nil {
+		var ok bool
+		if stack, ok = convertProtoItemStackValue(act.Item); !ok {
+			m.sendActionError(p, correlationID, "invalid item")
+			return
+		}
+	}
+	check := func(inv slotInventory) error {
+		return checkSlot(inv, act.Slot)
+	}
+	m.execInventory(p, correlationID, act.Target, check, func(inv slotInventory) (*pb.ActionResult, error) {
+		return nil, inv.SetItem(int(act.Slot), stack)
+	})
+}
+
+func (m *Manager) handleInventoryRemoveItem(p *pluginProcess, correlationID string, act *pb.InventoryRemoveItemAction) {
+	stack, ok := convertProtoItemStackValue(act.Item)
+	if !ok {
+		m.sendActionError(p, correlationID, "invalid item")
+		return
+	}
+	m.execInventory(p, correlationID, act.Target, nil, func(inv slotInventory) (*pb.ActionResult, error) {
+		remaining := stack.Count()
+		for i := 0; i < inv.Size() && remaining > 0; i++ {
+			it, _ := inv.Item(i)
+			if it.Empty() || !it.Comparable(stack) {
+				continue
+			}
+			n := min(it.Count(), remaining)
+			if err := inv.SetItem(i, it.Grow(-n)); err != nil {
+				return nil, err
+			}
+			remaining -= n
+		}
+		return &pb.ActionResult{Result: &pb.ActionResult_InventoryRemove{
+			InventoryRemove: &pb.InventoryRemoveResult{Removed: int32(stack.Count() - remaining)},
+		}}, nil
+	})
+}
+
+func (m *Manager) handleInventorySwapSlots(p *pluginProcess, correlationID string, act *pb.InventorySwapSlotsAction) {
+	check := func(inv slotInventory) error {
+		if err := checkSlot(inv, act.SlotA); err != nil {
+			return err
+		}
+		return checkSlot(inv, act.SlotB)
+	}
+	m.execInventory(p, correlationID, act.Target, check, func(inv slotInventory) (*pb.ActionResult, error) {
+		a, _ := inv.Item(int(act.SlotA))
+		b, _ := inv.Item(int(act.SlotB))
+		if err := inv.SetItem(int(act.SlotA), b); err != nil {
+			return nil, err
+		}
+		return nil, inv.SetItem(int(act.SlotB), a)
+	})
+}
+
+func (m *Manager) handleInventoryClearRange(p *pluginProcess, correlationID string, act *pb.InventoryClearRangeAction) {
+	if act.FromSlot > act.ToSlot {
+		m.sendActionError(p, correlationID, "from_slot is after to_slot")
+		return
+	}
+	check := func(inv slotInventory) error {
+		if err := checkSlot(inv, act.FromSlot); err != nil {
+			return err
+		}
+		return checkSlot(inv, act.ToSlot)
+	}
+	m.execInventory(p, correlationID, act.Target, check, func(inv slotInventory) (*pb.ActionResult, error) {
+		removed := 0
+		for i := int(act.FromSlot); i <= int(act.ToSlot); i++ {
+			it, _ := inv.Item(i)
+			if it.Empty() {
+				continue
+			}
+			removed += it.Count()
+			if err := inv.SetItem(i, item.Stack{}); err != nil {
+				return nil, err
+			}
+		}
+		return &pb.ActionResult{Result: &pb.ActionResult_ClearInventory{
+			ClearInventory: &pb.ClearInventoryResult{Removed: int32(removed)},
+		}}, nil
+	})
+}
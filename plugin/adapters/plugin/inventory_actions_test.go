@@ -0,0 +1,386 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// newTestChest places a chest holding items in a test world that the manager knows and returns the
+// world and the target of the chest.
+func newTestChest(t *testing.T, m *Manager, items map[int]item.Stack) (*world.World, *pb.InventoryTarget) {
+	w, _, ref := newRegisteredWorld(t, m)
+	pos := cube.Pos{2, 64, 2}
+	<-w.Exec(func(tx *world.Tx) {
+		tx.SetBlock(pos, block.NewChest(), nil)
+		inv := tx.Block(pos).(block.Container).Inventory(tx, pos)
+		for slot, it := range items {
+			_ = inv.SetItem(slot, it)
+		}
+	})
+	return w, &pb.InventoryTarget{
+		Type:     pb.InventoryType_INVENTORY_TYPE_CONTAINER,
+		World:    ref,
+		Position: &pb.BlockPos{X: 2, Y: 64, Z: 2},
+	}
+}
+
+// chestItems returns the contents of the chest of target.
+func chestItems(w *world.World, target *pb.InventoryTarget) []item.Stack {
+	pos := cube.Pos{int(target.Position.X), int(target.Position.Y), int(target.Position.Z)}
+	var items []item.Stack
+	<-w.Exec(func(tx *world.Tx) {
+		items = tx.Block(pos).(block.Container).Inventory(tx, pos).Slots()
+	})
+	return items
+}
+
+// checkChest reports the slots of the chest of target that do not hold the items of want.
+func checkChest(t *testing.T, w *world.World, target *pb.InventoryTarget, want map[int]item.Stack) {
+	t.Helper()
+	for slot, it := range chestItems(w, target) {
+		if got, want := protoItemStack(it), protoItemStack(want[slot]); !proto.Equal(got, want) {
+			t.Errorf("slot %d holds %v, want %v", slot, got, want)
+		}
+	}
+}
+
+func TestInventoryActions(t *testing.T) {
+	diamond := func(n int) item.Stack { return item.NewStack(item.Diamond{}, n) }
+	apple := func(n int) item.Stack { return item.NewStack(item.Apple{}, n) }
+	tests := []struct {
+		name   string
+		items  map[int]item.Stack
+		action func(target *pb.InventoryTarget) *pb.Action
+		want   map[int]item.Stack
+		// result is the result sent, without its correlation ID and status.
+		result *pb.ActionResult
+	}{
+		{
+			name: "set slot",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySetSlot{InventorySetSlot: &pb.InventorySetSlotAction{
+					Target: target, Slot: 26, Item: &pb.ItemStack{Name: "minecraft:diamond", Count: 2},
+				}}}
+			},
+			want:   map[int]item.Stack{26: diamond(2)},
+			result: &pb.ActionResult{},
+		},
+		{
+			name:  "clear slot",
+			items: map[int]item.Stack{4: diamond(2), 5: apple(1)},
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySetSlot{InventorySetSlot: &pb.InventorySetSlotAction{Target: target, Slot: 4}}}
+			},
+			want:   map[int]item.Stack{5: apple(1)},
+			result: &pb.ActionResult{},
+		},
+		{
+			name:  "remove across slots",
+			items: map[int]item.Stack{0: diamond(10), 3: apple(1), 7: diamond(5)},
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryRemoveItem{InventoryRemoveItem: &pb.InventoryRemoveItemAction{
+					Target: target, Item: &pb.ItemStack{Name: "minecraft:diamond", Count: 12},
+				}}}
+			},
+			want:   map[int]item.Stack{3: apple(1), 7: diamond(3)},
+			result: &pb.ActionResult{Result: &pb.ActionResult_InventoryRemove{InventoryRemove: &pb.InventoryRemoveResult{Removed: 12}}},
+		},
+		{
+			name:  "remove more than held",
+			items: map[int]item.Stack{1: diamond(2), 2: apple(4)},
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryRemoveItem{InventoryRemoveItem: &pb.InventoryRemoveItemAction{
+					Target: target, Item: &pb.ItemStack{Name: "minecraft:diamond", Count: 5},
+				}}}
+			},
+			want:   map[int]item.Stack{2: apple(4)},
+			result: &pb.ActionResult{Result: &pb.ActionResult_InventoryRemove{InventoryRemove: &pb.InventoryRemoveResult{Removed: 2}}},
+		},
+		{
+			name:  "swap slots",
+			items: map[int]item.Stack{0: diamond(3), 1: apple(1)},
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySwapSlots{InventorySwapSlots: &pb.InventorySwapSlotsAction{Target: target, SlotA: 0, SlotB: 1}}}
+			},
+			want:   map[int]item.Stack{0: apple(1), 1: diamond(3)},
+			result: &pb.ActionResult{},
+		},
+		{
+			name:  "swap with empty slot",
+			items: map[int]item.Stack{0: diamond(3)},
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySwapSlots{InventorySwapSlots: &pb.InventorySwapSlotsAction{Target: target, SlotA: 0, SlotB: 26}}}
+			},
+			want:   map[int]item.Stack{26: diamond(3)},
+			result: &pb.ActionResult{},
+		},
+		{
+			name:  "clear range",
+			items: map[int]item.Stack{0: diamond(2), 2: apple(3), 5: diamond(1)},
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryClearRange{InventoryClearRange: &pb.InventoryClearRangeAction{Target: target, FromSlot: 0, ToSlot: 2}}}
+			},
+			want:   map[int]item.Stack{5: diamond(1)},
+			result: &pb.ActionResult{Result: &pb.ActionResult_ClearInventory{ClearInventory: &pb.ClearInventoryResult{Removed: 5}}},
+		},
+		{
+			name:  "clear single slot",
+			items: map[int]item.Stack{26: apple(7)},
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryClearRange{InventoryClearRange: &pb.InventoryClearRangeAction{Target: target, FromSlot: 26, ToSlot: 26}}}
+			},
+			want:   map[int]item.Stack{},
+			result: &pb.ActionResult{Result: &pb.ActionResult_ClearInventory{ClearInventory: &pb.ClearInventoryResult{Removed: 7}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "test")
+			w, target := newTestChest(t, m, tt.items)
+			act := tt.action(target)
+			correlationID := "action"
+			act.CorrelationId = &correlationID
+
+			m.applyActions(p, &pb.ActionBatch{Actions: []*pb.Action{act}})
+			results := actionResults(p)
+			if len(results) != 1 {
+				t.Fatalf("received %d results, want 1", len(results))
+			}
+			want := proto.Clone(tt.result).(*pb.ActionResult)
+			want.CorrelationId, want.Status = correlationID, &pb.ActionStatus{Ok: true}
+			if !proto.Equal(results[0], want) {
+				t.Errorf("result = %v, want %v", results[0], want)
+			}
+			checkChest(t, w, target, tt.want)
+		})
+	}
+}
+
+func TestInventoryQuery(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	p := newTestPlugin(m, "test")
+	sword := item.NewStack(item.Sword{Tier: item.ToolTierIron}, 1).WithCustomName("Blade")
+	_, target := newTestChest(t, m, map[int]item.Stack{0: item.NewStack(item.Diamond{}, 3), 26: sword})
+
+	m.handleInventoryQuery(p, "query", &pb.InventoryQueryAction{Target: target})
+	results := actionResults(p)
+	if len(results) != 1 || !results[0].GetStatus().GetOk() {
+		t.Fatalf("received %v, want the inventory", results)
+	}
+	inv := results[0].GetInventory()
+	if !proto.Equal(inv.GetTarget(), target) {
+		t.Errorf("target = %v, want %v", inv.GetTarget(), target)
+	}
+	if len(inv.GetSlots()) != 27 {
+		t.Fatalf("received %d slots, want every slot of the chest", len(inv.GetSlots()))
+	}
+	for i, slot := range inv.GetSlots() {
+		if slot.Slot != int32(i) {
+			t.Errorf("slot %d reported as %d", i, slot.Slot)
+		}
+	}
+	if got := inv.Slots[0].GetItem(); got.GetName() != "minecraft:diamond" || got.GetCount() != 3 {
+		t.Errorf("slot 0 = %v, want 3 diamonds", got)
+	}
+	if got := inv.Slots[26].GetItem(); !proto.Equal(got, protoItemStack(sword)) {
+		t.Errorf("slot 26 = %v, want the named sword", got)
+	}
+	if inv.Slots[1].Item != nil {
+		t.Errorf("empty slot 1 = %v, want no item", inv.Slots[1].Item)
+	}
+}
+
+func TestInventoryActionErrors(t *testing.T) {
+	diamond := &pb.ItemStack{Name: "minecraft:diamond", Count: 1}
+	tests := []struct {
+		name string
+		// action returns the action on the chest of target.
+		action func(target *pb.InventoryTarget) *pb.Action
+		want   string
+	}{
+		{
+			name: "negative slot",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySetSlot{InventorySetSlot: &pb.InventorySetSlotAction{Target: target, Slot: -1, Item: diamond}}}
+			},
+			want: "invalid slot -1: inventory has 27 slots",
+		},
+		{
+			name: "slot past the end",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySetSlot{InventorySetSlot: &pb.InventorySetSlotAction{Target: target, Slot: 27, Item: diamond}}}
+			},
+			want: "invalid slot 27: inventory has 27 slots",
+		},
+		{
+			name: "set invalid item",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySetSlot{InventorySetSlot: &pb.InventorySetSlotAction{
+					Target: target, Item: &pb.ItemStack{Name: "minecraft:not_an_item", Count: 1},
+				}}}
+			},
+			want: "invalid item",
+		},
+		{
+			name: "remove invalid item",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryRemoveItem{InventoryRemoveItem: &pb.InventoryRemoveItemAction{Target: target}}}
+			},
+			want: "invalid item",
+		},
+		{
+			// The first slot is valid, so the swap must not half apply.
+			name: "swap with invalid slot",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventorySwapSlots{InventorySwapSlots: &pb.InventorySwapSlotsAction{Target: target, SlotA: 0, SlotB: 30}}}
+			},
+			want: "invalid slot 30: inventory has 27 slots",
+		},
+		{
+			name: "clear reversed range",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryClearRange{InventoryClearRange: &pb.InventoryClearRangeAction{Target: target, FromSlot: 3, ToSlot: 1}}}
+			},
+			want: "from_slot is after to_slot",
+		},
+		{
+			name: "clear range past the end",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryClearRange{InventoryClearRange: &pb.InventoryClearRangeAction{Target: target, FromSlot: 0, ToSlot: 27}}}
+			},
+			want: "invalid slot 27: inventory has 27 slots",
+		},
+		{
+			name: "missing target",
+			action: func(*pb.InventoryTarget) *pb.Action {
+				return &pb.Action{Kind: &pb.Action_InventoryQuery{InventoryQuery: &pb.InventoryQueryAction{}}}
+			},
+			want: "missing target",
+		},
+		{
+			name: "unknown world",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				target.World = &pb.WorldRef{Id: "0x0"}
+				return &pb.Action{Kind: &pb.Action_InventoryQuery{InventoryQuery: &pb.InventoryQueryAction{Target: target}}}
+			},
+			want: "world not found",
+		},
+		{
+			name: "missing position",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				target.Position = nil
+				return &pb.Action{Kind: &pb.Action_InventoryQuery{InventoryQuery: &pb.InventoryQueryAction{Target: target}}}
+			},
+			want: "missing position",
+		},
+		{
+			name: "no container",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				target.Position = &pb.BlockPos{X: 5, Y: 64, Z: 5}
+				return &pb.Action{Kind: &pb.Action_InventoryQuery{InventoryQuery: &pb.InventoryQueryAction{Target: target}}}
+			},
+			want: "no container at position",
+		},
+		{
+			name: "unknown inventory type",
+			action: func(target *pb.InventoryTarget) *pb.Action {
+				target.Type = 99
+				return &pb.Action{Kind: &pb.Action_InventoryQuery{InventoryQuery: &pb.InventoryQueryAction{Target: target}}}
+			},
+			want: "unknown inventory type",
+		},
+		{
+			name: "offline player",
+			action: func(*pb.InventoryTarget) *pb.Action {
+				target := &pb.InventoryTarget{Type: pb.InventoryType_INVENTORY_TYPE_OFFHAND, PlayerUuid: uuid.NewString()}
+				return &pb.Action{Kind: &pb.Action_InventorySetSlot{InventorySetSlot: &pb.InventorySetSlotAction{Target: target, Item: diamond}}}
+			},
+			want: "player not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			p := newTestPlugin(m, "test")
+			items := map[int]item.Stack{0: item.NewStack(item.Apple{}, 4)}
+			w, target := newTestChest(t, m, items)
+			act := tt.action(proto.Clone(target).(*pb.InventoryTarget))
+			correlationID := "action"
+			act.CorrelationId = &correlationID
+
+			m.applyActions(p, &pb.ActionBatch{Actions: []*pb.Action{act}})
+			results := actionResults(p)
+			if len(results) != 1 {
+				t.Fatalf("received %d results, want 1", len(results))
+			}
+			if res := results[0]; res.CorrelationId != correlationID || res.GetStatus().GetOk() || res.GetStatus().GetError() != tt.want {
+				t.Errorf("result = %v, want error %q", res, tt.want)
+			}
+			checkChest(t, w, target, items)
+		})
+	}
+}
+
+func TestOffhandInventory(t *testing.T) {
+	w, h := newTestWorld(t)
+	sword := item.NewStack(item.Sword{Tier: item.ToolTierIron}, 1)
+	totem := item.NewStack(item.Totem{}, 1)
+	inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+		pl.SetHeldItems(sword, item.Stack{})
+		inv := playerInventory(pl, pb.InventoryType_INVENTORY_TYPE_OFFHAND)
+		if inv.Size() != 1 {
+			t.Fatalf("offhand has %d slots, want 1", inv.Size())
+		}
+		if err := inv.SetItem(0, totem); err != nil {
+			t.Fatalf("SetItem: %v", err)
+		}
+		main, off := pl.HeldItems()
+		if !off.Equal(totem) || !main.Equal(sword) {
+			t.Errorf("held items = %v, %v, want the sword kept and the totem in the offhand", main, off)
+		}
+		if it, err := inv.Item(0); err != nil || !it.Equal(totem) {
+			t.Errorf("Item(0) = %v, %v, want the totem", it, err)
+		}
+		if _, err := inv.Item(1); err == nil {
+			t.Error("Item(1) of the offhand succeeded")
+		}
+		if err := inv.SetItem(-1, sword); err == nil {
+			t.Error("SetItem(-1) of the offhand succeeded")
+		}
+		if _, off := pl.HeldItems(); !off.Equal(totem) {
+			t.Errorf("offhand = %v after an invalid SetItem, want the totem", off)
+		}
+	})
+}
+
+func TestPlayerInventory(t *testing.T) {
+	tests := []struct {
+		typ  pb.InventoryType
+		size int
+	}{
+		{pb.InventoryType_INVENTORY_TYPE_PLAYER, 36},
+		{pb.InventoryType_INVENTORY_TYPE_ARMOUR, 4},
+		{pb.InventoryType_INVENTORY_TYPE_OFFHAND, 1},
+		{pb.InventoryType_INVENTORY_TYPE_ENDER_CHEST, 27},
+	}
+	w, h := newTestWorld(t)
+	inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+		for _, tt := range tests {
+			if got := playerInventory(pl, tt.typ).Size(); got != tt.size {
+				t.Errorf("%v has %d slots, want %d", tt.typ, got, tt.size)
+			}
+		}
+	})
+}
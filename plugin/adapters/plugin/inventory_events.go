@@ -0,0 +1,137 @@
+package plugin
+
+import (
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/google/uuid"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// handleInventories installs the inventory handler on the main inventory, armour and ender chest
+// of a player. The offhand has no inventory of its own exposed by Dragonfly.
+func (m *Manager) handleInventories(p *player.Player) {
+	if m.inventoryHandlerFactory == nil {
+		return
+	}
+	for kind, inv := range map[pb.InventoryType]*inventory.Inventory{
+		pb.InventoryType_INVENTORY_TYPE_PLAYER:      p.Inventory(),
+		pb.InventoryType_INVENTORY_TYPE_ARMOUR:      p.Armour().Inventory(),
+		pb.InventoryType_INVENTORY_TYPE_ENDER_CHEST: p.EnderChestInventory(),
+	} {
+		inv.Handle(m.inventoryHandlerFactory(m, inv, kind, nil))
+	}
+}
+
+// handleContainerInventory installs the inventory handler on the container block at pos, which a
+// player is about to open. The handler stays in place for every later viewer of the container.
+func (m *Manager) handleContainerInventory(tx *world.Tx, pos cube.Pos, b world.Block) {
+	container, ok := b.(block.Container)
+	if !ok || m.inventoryHandlerFactory == nil {
+		return
+	}
+	inv := container.Inventory(tx, pos)
+	inv.Handle(m.inventoryHandlerFactory(m, inv, pb.InventoryType_INVENTORY_TYPE_CONTAINER, &pos))
+}
+
+func (m *Manager) EmitPlayerInventorySlotChange(ctx *inventory.Context, p *player.Player, kind pb.InventoryType, container *cube.Pos, slot int, action pb.InventorySlotAction, it, before, after item.Stack) {
+	if p == nil {
+		return
+	}
+	evt := &pb.PlayerInventorySlotChangeEvent{
+		PlayerUuid: p.UUID().String(),
+		Name:       p.Name(),
+		World:      playerWorldDimension(p),
+		Inventory:  kind,
+		Slot:       int32(slot),
+		Action:     action,
+		Item:       protoItemStack(it),
+		Before:     protoItemStack(before),
+		After:      protoItemStack(after),
+	}
+	if container != nil {
+		evt.Position = protoBlockPos(*container)
+		if tx := p.Tx(); tx != nil {
+			evt.Block = protoBlockState(tx.Block(*container))
+		}
+	}
+	m.emitCancellable(ctx, &pb.EventEnvelope{
+		Type:    pb.EventType_PLAYER_INVENTORY_SLOT_CHANGE,
+		Payload: &pb.EventEnvelope_PlayerInventorySlotChange{PlayerInventorySlotChange: evt},
+	})
+}
+
+func (m *Manager) EmitContainerOpen(ctx *player.Context, p *player.Player, pos cube.Pos, b world.Block) {
+	if p == nil {
+		return
+	}
+	m.emitCancellable(ctx, &pb.EventEnvelope{
+		Type: pb.EventType_CONTAINER_OPEN,
+		Payload: &pb.EventEnvelope_ContainerOpen{
+			ContainerOpen: &pb.ContainerOpenEvent{
+				PlayerUuid: p.UUID().String(),
+				Name:       p.Name(),
+				World:      playerWorldDimension(p),
+				Position:   protoBlockPos(pos),
+				Block:      protoBlockState(b),
+			},
+		},
+	})
+	if tx := p.Tx(); tx != nil && !ctx.Cancelled() {
+		m.handleContainerInventory(tx, pos, b)
+	}
+}
+
+func (m *Manager) EmitContainerClose(ctx *player.Context, p *player.Player, pos cube.Pos, b world.Block, serverSide bool) {
+	if p == nil {
+		return
+	}
+	m.emitCancellable(ctx, &pb.EventEnvelope{
+		Type: pb.EventType_CONTAINER_CLOSE,
+		Payload: &pb.EventEnvelope_ContainerClose{
+			ContainerClose: &pb.ContainerCloseEvent{
+				PlayerUuid: p.UUID().String(),
+				Name:       p.Name(),
+				World:      playerWorldDimension(p),
+				Position:   protoBlockPos(pos),
+				Block:      protoBlockState(b),
+				ServerSide: serverSide,
+			},
+		},
+	})
+}
+
+// containerClosed emits CONTAINER_CLOSE for the container at pos that was closed for the player with
+// the UUID passed. Dragonfly has no handler for closing containers, so playerConn reports closes from
+// the ContainerClose packets of the connection. It must not be called from a transaction. If the
+// player closed the container and a plugin cancels the event, the container is opened again.
+func (m *Manager) containerClosed(id uuid.UUID, pos cube.Pos, serverSide bool) {
+	m.mu.RLock()
+	p, ok := m.players[id]
+	m.mu.RUnlock()
+	if !ok {
+		return
+	}
+	p.H().ExecWorld(func(tx *world.Tx, e world.Entity) {
+		pl, ok := e.(*player.Player)
+		if !ok {
+			return
+		}
+		b := tx.Block(pos)
+		switch b.(type) {
+		case block.Container, block.EnderChest:
+		default:
+			// Windows such as crafting tables hold no inventory of their own.
+			return
+		}
+		ctx := event.C(pl)
+		m.EmitContainerClose(ctx, pl, pos, b, serverSide)
+		if ctx.Cancelled() && !serverSide {
+			pl.OpenBlockContainer(pos, tx)
+		}
+	})
+}
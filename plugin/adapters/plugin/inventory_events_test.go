@@ -0,0 +1,249 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/df-mc/dragonfly/server"
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/event"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+
+	"github.com/secmc/plugin/plugin/ports"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// events returns the events queued for p.
+func events(p *pluginProcess) []*pb.EventEnvelope {
+	var evts []*pb.EventEnvelope
+	for {
+		select {
+		case msg := <-p.sendCh:
+			if evt := msg.GetEvent(); evt != nil {
+				evts = append(evts, evt)
+			}
+		default:
+			return evts
+		}
+	}
+}
+
+// respond answers every event p is sent that expects a response with the result of fn, until the
+// test ends.
+func respond(t *testing.T, p *pluginProcess, fn func(*pb.EventEnvelope) *pb.EventResult) {
+	stop := make(chan struct{})
+	t.Cleanup(func() { close(stop) })
+	go func() {
+		for {
+			select {
+			case msg := <-p.sendCh:
+				if evt := msg.GetEvent(); evt != nil && evt.ExpectsResponse {
+					res := fn(evt)
+					res.EventId = evt.EventId
+					p.deliverEventResult(res)
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// cancel is a response that cancels the event.
+func cancel(*pb.EventEnvelope) *pb.EventResult {
+	cancelled := true
+	return &pb.EventResult{Cancel: &cancelled}
+}
+
+// finaliseBlocks finalises the block registry, which Dragonfly only does when a server is created.
+var finaliseBlocks = sync.OnceFunc(func() {
+	server.Config{Log: slog.New(slog.NewTextHandler(io.Discard, nil)), DisableResourceBuilding: true}.New()
+})
+
+// newTestWorld returns a world without storage or terrain that is closed when the test ends, with
+// a player spawned in it.
+func newTestWorld(t *testing.T) (*world.World, *world.EntityHandle) {
+	finaliseBlocks()
+	w := world.Config{}.New()
+	t.Cleanup(func() { _ = w.Close() })
+	h := world.EntitySpawnOpts{Position: mgl64.Vec3{0, 64, 0}}.New(player.Type, player.Config{Name: "Steve", UUID: uuid.New()})
+	<-w.Exec(func(tx *world.Tx) {
+		tx.AddEntity(h)
+	})
+	return w, h
+}
+
+// inTx runs fn with the player of h in a transaction of w.
+func inTx(w *world.World, h *world.EntityHandle, fn func(tx *world.Tx, p *player.Player)) {
+	<-w.Exec(func(tx *world.Tx) {
+		e, _ := h.Entity(tx)
+		fn(tx, e.(*player.Player))
+	})
+}
+
+func TestEmitContainerOpen(t *testing.T) {
+	pos := cube.Pos{1, 64, 1}
+	tests := []struct {
+		name   string
+		cancel bool
+	}{
+		{"opened", false},
+		{"cancelled", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled []*cube.Pos
+			factory := func(manager ports.EventManager, inv *inventory.Inventory, kind pb.InventoryType, container *cube.Pos) inventory.Handler {
+				handled = append(handled, container)
+				return inventory.NopHandler{}
+			}
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, factory)
+			monitor := newTestPlugin(m, "monitor")
+			subscribe(monitor, pb.EventType_EVENT_TYPE_ALL, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+			if tt.cancel {
+				guard := newTestPlugin(m, "guard")
+				subscribe(guard, pb.EventType_CONTAINER_OPEN, &pb.EventSubscription{})
+				respond(t, guard, cancel)
+			}
+
+			w, h := newTestWorld(t)
+			var ctx *player.Context
+			inTx(w, h, func(tx *world.Tx, p *player.Player) {
+				tx.SetBlock(pos, block.NewChest(), nil)
+				ctx = event.C(p)
+				m.EmitContainerOpen(ctx, p, pos, tx.Block(pos))
+			})
+
+			if ctx.Cancelled() != tt.cancel {
+				t.Errorf("context cancelled = %v, want %v", ctx.Cancelled(), tt.cancel)
+			}
+			evts := events(monitor)
+			if len(evts) != 1 || evts[0].Type != pb.EventType_CONTAINER_OPEN {
+				t.Fatalf("monitor received %v, want a CONTAINER_OPEN event", evts)
+			}
+			open := evts[0].GetContainerOpen()
+			if open.PlayerUuid != h.UUID().String() || open.Name != "Steve" || open.Block.GetName() != "minecraft:chest" || open.Position.GetX() != 1 || open.Position.GetY() != 64 || evts[0].Cancelled != tt.cancel {
+				t.Errorf("monitor received %v (cancelled %v), want the chest at %v opened by Steve", open, evts[0].Cancelled, pos)
+			}
+			// The inventory of the chest is only handled once it is actually opened.
+			if tt.cancel {
+				if len(handled) != 0 {
+					t.Errorf("handled the container inventory of a cancelled open")
+				}
+			} else if len(handled) != 1 || *handled[0] != pos {
+				t.Errorf("handled container inventories at %v, want %v", handled, pos)
+			}
+		})
+	}
+}
+
+func TestContainerClosed(t *testing.T) {
+	pos := cube.Pos{2, 64, 2}
+	tests := []struct {
+		name       string
+		block      world.Block
+		serverSide bool
+		want       bool
+	}{
+		{"chest", block.NewChest(), false, true},
+		{"closed by the server", block.NewChest(), true, true},
+		{"ender chest", block.NewEnderChest(), false, true},
+		{"crafting table", block.CraftingTable{}, false, false},
+		{"air", block.Air{}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			monitor := newTestPlugin(m, "monitor")
+			subscribe(monitor, pb.EventType_EVENT_TYPE_ALL, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+
+			w, h := newTestWorld(t)
+			inTx(w, h, func(tx *world.Tx, p *player.Player) {
+				tx.SetBlock(pos, tt.block, nil)
+				m.players[p.UUID()] = p
+			})
+			m.containerClosed(h.UUID(), pos, tt.serverSide)
+
+			evts := events(monitor)
+			if !tt.want {
+				if len(evts) != 0 {
+					t.Fatalf("monitor received %v, want nothing", evts)
+				}
+				return
+			}
+			if len(evts) != 1 || evts[0].Type != pb.EventType_CONTAINER_CLOSE {
+				t.Fatalf("monitor received %v, want a CONTAINER_CLOSE event", evts)
+			}
+			closed := evts[0].GetContainerClose()
+			if closed.PlayerUuid != h.UUID().String() || closed.Position.GetX() != 2 || closed.ServerSide != tt.serverSide {
+				t.Errorf("monitor received %v, want a close at %v with server side %v", closed, pos, tt.serverSide)
+			}
+		})
+	}
+
+	t.Run("unknown player", func(t *testing.T) {
+		m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+		monitor := newTestPlugin(m, "monitor")
+		subscribe(monitor, pb.EventType_EVENT_TYPE_ALL, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+		m.containerClosed(uuid.New(), pos, false)
+		if evts := events(monitor); len(evts) != 0 {
+			t.Fatalf("monitor received %v, want nothing", evts)
+		}
+	})
+}
+
+func TestEmitPlayerInventorySlotChange(t *testing.T) {
+	pos := cube.Pos{3, 64, 3}
+	tests := []struct {
+		name      string
+		kind      pb.InventoryType
+		container *cube.Pos
+	}{
+		{"player inventory", pb.InventoryType_INVENTORY_TYPE_PLAYER, nil},
+		{"container", pb.InventoryType_INVENTORY_TYPE_CONTAINER, &pos},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			monitor := newTestPlugin(m, "monitor")
+			subscribe(monitor, pb.EventType_EVENT_TYPE_ALL, &pb.EventSubscription{Priority: pb.EventPriority_EVENT_PRIORITY_MONITOR})
+
+			w, h := newTestWorld(t)
+			inTx(w, h, func(tx *world.Tx, p *player.Player) {
+				tx.SetBlock(pos, block.NewChest(), nil)
+				m.EmitPlayerInventorySlotChange(event.C[inventory.Holder](p), p, tt.kind, tt.container, 4,
+					pb.InventorySlotAction_INVENTORY_SLOT_ACTION_TAKE,
+					item.NewStack(item.Diamond{}, 2), item.NewStack(item.Diamond{}, 5), item.NewStack(item.Diamond{}, 3))
+			})
+
+			evts := events(monitor)
+			if len(evts) != 1 || evts[0].Type != pb.EventType_PLAYER_INVENTORY_SLOT_CHANGE {
+				t.Fatalf("monitor received %v, want a PLAYER_INVENTORY_SLOT_CHANGE event", evts)
+			}
+			change := evts[0].GetPlayerInventorySlotChange()
+			if change.PlayerUuid != h.UUID().String() || change.Inventory != tt.kind || change.Slot != 4 || change.Action != pb.InventorySlotAction_INVENTORY_SLOT_ACTION_TAKE {
+				t.Errorf("monitor received %v, want a take from slot 4 of %v", change, tt.kind)
+			}
+			if change.Item.GetCount() != 2 || change.Before.GetCount() != 5 || change.After.GetCount() != 3 {
+				t.Errorf("monitor received item %v, before %v and after %v, want 2, 5 and 3 diamonds", change.Item, change.Before, change.After)
+			}
+			if tt.container == nil {
+				if change.Position != nil || change.Block != nil {
+					t.Errorf("monitor received position %v and block %v for a player inventory", change.Position, change.Block)
+				}
+				return
+			}
+			if change.Position.GetX() != 3 || change.Block.GetName() != "minecraft:chest" {
+				t.Errorf("monitor received position %v and block %v, want the chest at %v", change.Position, change.Block, pos)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package plugin
+
+import (
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// protoItemStack converts an item stack with all of its state: custom name, lore, damage,
+// enchantments, item NBT such as armour trims and book pages, and values set with WithValue.
+func protoItemStack(it item.Stack) *pb.ItemStack {
+	if it.Empty() {
+		return nil
+	}
+	itm := it.Item()
+	if itm == nil {
+		return nil
+	}
+	name, meta := itm.EncodeItem()
+	stack := &pb.ItemStack{
+		Name:        name,
+		Meta:        int32(meta),
+		Count:       int32(it.Count()),
+		Lore:        it.Lore(),
+		Unbreakable: it.Unbreakable(),
+		AnvilCost:   int32(it.AnvilCost()),
+	}
+	if customName := it.CustomName(); customName != "" {
+		stack.CustomName = &customName
+	}
+	if maxDurability := it.MaxDurability(); maxDurability >= 0 {
+		stack.Damage = int32(maxDurability - it.Durability())
+	}
+	for _, e := range it.Enchantments() {
+		if id, ok := item.EnchantmentID(e.Type()); ok {
+			stack.Enchantments = append(stack.Enchantments, &pb.ItemEnchantment{Id: int32(id), Level: int32(e.Level())})
+		}
+	}
+	if nbter, ok := itm.(world.NBTer); ok {
+		if data := nbter.EncodeNBT(); len(data) > 0 {
+			if b, err := nbt.MarshalEncoding(data, nbt.LittleEndian); err == nil {
+				stack.Nbt = b
+			}
+			stack.Trim = protoArmourTrim(data)
+		}
+	}
+	for k, v := range it.Values() {
+		if value, ok := protoItemValue(v); ok {
+			if stack.Values == nil {
+				stack.Values = make(map[string]*pb.ItemValue)
+			}
+			stack.Values[k] = value
+		}
+	}
+	return stack
+}
+
+func protoItemStackPtr(it *item.Stack) *pb.ItemStack {
+	if it == nil {
+		return nil
+	}
+	return protoItemStack(*it)
+}
+
+// convertProtoItemStackValue converts a protobuf item stack with all of its state. It fails for
+// unknown items and enchantments, non-positive counts, malformed NBT and damage that would break
+// the item.
+func convertProtoItemStackValue(stack *pb.ItemStack) (item.Stack, bool) {
+	if stack == nil || stack.Name == "" {
+		return item.Stack{}, false
+	}
+	material, ok := world.ItemByName(stack.Name, int16(stack.Meta))
+	if !ok {
+		return item.Stack{}, false
+	}
+	count := int(stack.Count)
+	if count <= 0 {
+		return item.Stack{}, false
+	}
+	if nbter, ok := material.(world.NBTer); ok && (len(stack.Nbt) > 0 || stack.Trim != nil) {
+		data := map[string]any{}
+		if len(stack.Nbt) > 0 {
+			if err := nbt.UnmarshalEncoding(stack.Nbt, &data, nbt.LittleEndian); err != nil {
+				return item.Stack{}, false
+			}
+		}
+		if stack.Trim != nil {
+			data["Trim"] = map[string]any{"Pattern": stack.Trim.Pattern, "Material": stack.Trim.Material}
+		}
+		if material, ok = nbter.DecodeNBT(data).(world.Item); !ok {
+			return item.Stack{}, false
+		}
+	}
+
+	s := item.NewStack(material, count)
+	if stack.CustomName != nil {
+		s = s.WithCustomName(*stack.CustomName)
+	}
+	if len(stack.Lore) > 0 {
+		s = s.WithLore(stack.Lore...)
+	}
+	if stack.Damage > 0 {
+		// Damaging a stack by its full durability would silently turn it into its broken item.
+		if maxDurability := s.MaxDurability(); maxDurability >= 0 && int(stack.Damage) >= maxDurability {
+			return item.Stack{}, false
+		}
+		s = s.Damage(int(stack.Damage))
+	}
+	if stack.Unbreakable {
+		s = s.AsUnbreakable()
+	}
+	if stack.AnvilCost != 0 {
+		s = s.WithAnvilCost(int(stack.AnvilCost))
+	}
+	if len(stack.Enchantments) > 0 {
+		enchantments := make([]item.Enchantment, 0, len(stack.Enchantments))
+		for _, e := range stack.Enchantments {
+			t, ok := item.EnchantmentByID(int(e.GetId()))
+			if !ok || e.GetLevel() < 1 {
+				return item.Stack{}, false
+			}
+			enchantments = append(enchantments, item.NewEnchantment(t, int(e.GetLevel())))
+		}
+		s = s.WithEnchantments(enchantments...)
+	}
+	for k, v := range stack.Values {
+		if value, ok := itemValueFromProto(v); ok {
+			s = s.WithValue(k, value)
+		}
+	}
+	return s, true
+}
+
+// protoArmourTrim reads the trim written by the EncodeNBT method of armour items.
+func protoArmourTrim(data map[string]any) *pb.ArmourTrim {
+	trim, ok := data["Trim"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	pattern, _ := trim["Pattern"].(string)
+	material, _ := trim["Material"].(string)
+	return &pb.ArmourTrim{Pattern: pattern, Material: material}
+}
+
+func protoItemValue(v any) (*pb.ItemValue, bool) {
+	switch v := v.(type) {
+	case string:
+		return &pb.ItemValue{Value: &pb.ItemValue_StringValue{StringValue: v}}, true
+	case int:
+		return &pb.ItemValue{Value: &pb.ItemValue_IntValue{IntValue: int64(v)}}, true
+	case int32:
+		return &pb.ItemValue{Value: &pb.ItemValue_Int32Value{Int32Value: v}}, true
+	case int64:
+		return &pb.ItemValue{Value: &pb.ItemValue_Int64Value{Int64Value: v}}, true
+	case float32:
+		return &pb.ItemValue{Value: &pb.ItemValue_FloatValue{FloatValue: v}}, true
+	case float64:
+		return &pb.ItemValue{Value: &pb.ItemValue_DoubleValue{DoubleValue: v}}, true
+	case bool:
+		return &pb.ItemValue{Value: &pb.ItemValue_BoolValue{BoolValue: v}}, true
+	case []byte:
+		return &pb.ItemValue{Value: &pb.ItemValue_BytesValue{BytesValue: v}}, true
+	}
+	return nil, false
+}
+
+func itemValueFromProto(v *pb.ItemValue) (any, bool) {
+	switch v := v.GetValue().(type) {
+	case *pb.ItemValue_StringValue:
+		return v.StringValue, true
+	case *pb.ItemValue_IntValue:
+		return int(v.IntValue), true
+	case *pb.ItemValue_Int32Value:
+		return v.Int32Value, true
+	case *pb.ItemValue_Int64Value:
+		return v.Int64Value, true
+	case *pb.ItemValue_FloatValue:
+		return v.FloatValue, true
+	case *pb.ItemValue_DoubleValue:
+		return v.DoubleValue, true
+	case *pb.ItemValue_BoolValue:
+		return v.BoolValue, true
+	case *pb.ItemValue_BytesValue:
+		return v.BytesValue, true
+	}
+	return nil, false
+}
@@ -0,0 +1,193 @@
+package plugin
+
+import (
+	"image/color"
+	"reflect"
+	"testing"
+
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/enchantment"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+func TestItemStackRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		stack item.Stack
+	}{
+		{
+			name:  "plain",
+			stack: item.NewStack(item.Diamond{}, 64),
+		},
+		{
+			name: "enchanted sword",
+			stack: item.NewStack(item.Sword{Tier: item.ToolTierDiamond}, 1).
+				WithCustomName("§6Excalibur").
+				WithLore("Forged in the lake", "§7Legendary").
+				WithEnchantments(item.NewEnchantment(enchantment.Sharpness, 5), item.NewEnchantment(enchantment.Unbreaking, 3)).
+				WithAnvilCost(7).
+				Damage(120),
+		},
+		{
+			name:  "unbreakable pickaxe",
+			stack: item.NewStack(item.Pickaxe{Tier: item.ToolTierNetherite}, 1).AsUnbreakable(),
+		},
+		{
+			name:  "enchanted book",
+			stack: item.NewStack(item.Book{}, 1).WithEnchantments(item.NewEnchantment(enchantment.Mending, 1)),
+		},
+		{
+			name: "trimmed helmet",
+			stack: item.NewStack(item.Helmet{
+				Tier: item.ArmourTierDiamond{},
+				Trim: item.ArmourTrim{Template: item.TemplateSentry(), Material: item.Emerald{}},
+			}, 1),
+		},
+		{
+			name:  "dyed boots",
+			stack: item.NewStack(item.Boots{Tier: item.ArmourTierLeather{Colour: color.RGBA{R: 200, G: 30, B: 90, A: 255}}}, 1),
+		},
+		{
+			name: "written book",
+			stack: item.NewStack(item.WrittenBook{
+				Title:      "Rules",
+				Author:     "Server",
+				Generation: item.CopyGeneration(),
+				Pages:      []string{"No griefing.", "Be nice."},
+			}, 1),
+		},
+		{
+			name: "values",
+			stack: item.NewStack(item.GoldIngot{}, 3).
+				WithValue("owner", "steve").
+				WithValue("level", 12).
+				WithValue("seed", int64(1)<<40).
+				WithValue("slot", int32(4)).
+				WithValue("chance", 0.25).
+				WithValue("weight", float32(1.5)).
+				WithValue("soulbound", true).
+				WithValue("blob", []byte{1, 2, 3}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			converted := protoItemStack(tt.stack)
+			if converted == nil {
+				t.Fatalf("protoItemStack returned nil")
+			}
+			back, ok := convertProtoItemStackValue(converted)
+			if !ok {
+				t.Fatalf("convertProtoItemStackValue failed for %v", converted)
+			}
+			assertStacksEqual(t, tt.stack, back)
+
+			// The protobuf form must be stable as well.
+			assertProtoStacksEqual(t, converted, protoItemStack(back))
+		})
+	}
+}
+
+func TestItemStackFromProtoRoundTrip(t *testing.T) {
+	name := "Crown"
+	in := &pb.ItemStack{
+		Name:         "minecraft:golden_helmet",
+		Count:        1,
+		CustomName:   &name,
+		Lore:         []string{"Worn by kings"},
+		Damage:       10,
+		AnvilCost:    3,
+		Enchantments: []*pb.ItemEnchantment{{Id: 0, Level: 4}},
+		Trim:         &pb.ArmourTrim{Pattern: "wild", Material: "amethyst"},
+		Values: map[string]*pb.ItemValue{
+			"quest": {Value: &pb.ItemValue_StringValue{StringValue: "coronation"}},
+			"tier":  {Value: &pb.ItemValue_IntValue{IntValue: 2}},
+		},
+	}
+	stack, ok := convertProtoItemStackValue(in)
+	if !ok {
+		t.Fatalf("convertProtoItemStackValue failed")
+	}
+	helmet, ok := stack.Item().(item.Helmet)
+	if !ok || helmet.Trim.Template != item.TemplateWild() || helmet.Trim.Material != (item.AmethystShard{}) {
+		t.Fatalf("trim not applied: %#v", stack.Item())
+	}
+	out := protoItemStack(stack)
+	// The trim is also carried in the item NBT on the way out.
+	if len(out.Nbt) == 0 {
+		t.Fatalf("expected trim NBT on converted stack")
+	}
+	out.Nbt = nil
+	if !proto.Equal(in, out) {
+		t.Fatalf("protobuf stack changed:\nbefore: %v\nafter:  %v", in, out)
+	}
+}
+
+func TestItemStackFromProtoInvalid(t *testing.T) {
+	tests := map[string]*pb.ItemStack{
+		"unknown item":        {Name: "minecraft:not_an_item", Count: 1},
+		"zero count":          {Name: "minecraft:diamond"},
+		"unknown enchantment": {Name: "minecraft:diamond_sword", Count: 1, Enchantments: []*pb.ItemEnchantment{{Id: 9999, Level: 1}}},
+		"zero level":          {Name: "minecraft:diamond_sword", Count: 1, Enchantments: []*pb.ItemEnchantment{{Id: 9, Level: 0}}},
+		"malformed nbt":       {Name: "minecraft:written_book", Count: 1, Nbt: []byte{0xff, 0x00}},
+		"broken by damage":    {Name: "minecraft:diamond_sword", Count: 1, Damage: 1561},
+		"beyond durability":   {Name: "minecraft:diamond_sword", Count: 1, Damage: 5000},
+	}
+	for name, in := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, ok := convertProtoItemStackValue(in); ok {
+				t.Fatalf("expected conversion of %v to fail", in)
+			}
+		})
+	}
+}
+
+func TestItemStackFromProtoDamage(t *testing.T) {
+	stack, ok := convertProtoItemStackValue(&pb.ItemStack{Name: "minecraft:diamond_sword", Count: 1, Damage: 1560})
+	if !ok {
+		t.Fatalf("convertProtoItemStackValue failed for a sword with durability left")
+	}
+	if stack.Durability() != 1 {
+		t.Fatalf("durability = %d, want 1", stack.Durability())
+	}
+}
+
+// assertProtoStacksEqual compares two protobuf stacks with their NBT decoded, as the order of NBT
+// compound keys is not stable.
+func assertProtoStacksEqual(t *testing.T, want, got *pb.ItemStack) {
+	t.Helper()
+	decode := func(b []byte) map[string]any {
+		data := map[string]any{}
+		if len(b) > 0 {
+			if err := nbt.UnmarshalEncoding(b, &data, nbt.LittleEndian); err != nil {
+				t.Fatalf("decode nbt: %v", err)
+			}
+		}
+		return data
+	}
+	if wantNBT, gotNBT := decode(want.Nbt), decode(got.Nbt); !reflect.DeepEqual(wantNBT, gotNBT) {
+		t.Fatalf("nbt differs:\nwant: %v\ngot:  %v", wantNBT, gotNBT)
+	}
+	want, got = proto.CloneOf(want), proto.CloneOf(got)
+	want.Nbt, got.Nbt = nil, nil
+	if !proto.Equal(want, got) {
+		t.Fatalf("protobuf stacks differ:\nwant: %v\ngot:  %v", want, got)
+	}
+}
+
+func assertStacksEqual(t *testing.T, want, got item.Stack) {
+	t.Helper()
+	// Equal covers the item and its NBT, count, damage, custom name, lore, enchantments, anvil cost
+	// and values.
+	if !want.Equal(got) {
+		t.Fatalf("stacks differ:\nwant: %v\ngot:  %v", want, got)
+	}
+	if want.Unbreakable() != got.Unbreakable() {
+		t.Fatalf("unbreakable: want %v, got %v", want.Unbreakable(), got.Unbreakable())
+	}
+	if !reflect.DeepEqual(want.Values(), got.Values()) {
+		t.Fatalf("values: want %v, got %v", want.Values(), got.Values())
+	}
+}
@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/secmc/plugin/plugin/config"
+)
+
+// LoadPlugin starts a plugin while the server is running. The top-level defaults of the startup
+// configuration apply to it. It fails if a plugin with the same ID is already loaded.
+func (m *Manager) LoadPlugin(pc config.PluginConfig) error {
+	if m.grpcServer == nil {
+		return fmt.Errorf("plugin server not started")
+	}
+	if pc.ID == "" {
+		pc.ID = pc.Name
+	}
+	if pc.ID == "" {
+		pc.ID = fmt.Sprintf("plugin-%s", strings.ToLower(uuid.NewString()[:8]))
+	}
+	if pc.EventTimeoutMs <= 0 {
+		pc.EventTimeoutMs = m.cfg.EventTimeoutMs
+	}
+	if pc.ShutdownGraceMs <= 0 {
+		pc.ShutdownGraceMs = m.cfg.ShutdownGraceMs
+	}
+	if err := m.checkAuthentication(pc); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	if _, ok := m.plugins[pc.ID]; ok {
+		m.mu.Unlock()
+		return fmt.Errorf("plugin %q already loaded", pc.ID)
+	}
+	proc := newPluginProcess(m, pc)
+	m.plugins[pc.ID] = proc
+	m.mu.Unlock()
+
+	if proc.token == "" && m.cfg.TLS.ClientCAFile == "" {
+		proc.log.Warn("insecure external plugin has no token configured, its connections are not authenticated")
+	}
+	proc.auditPermissions()
+
+	// Stop waits for start, so that the goroutines it adds to the wait group are waited for as well.
+	proc.wg.Add(1)
+	go func() {
+		defer proc.wg.Done()
+		proc.start(m.ctx, m.grpcServer.Address())
+	}()
+	return nil
+}
+
+// UnloadPlugin shuts a plugin down and forgets it. Its commands are unbound, queued actions are
+// dropped, and waiters for its event results and plugin message replies are released. The plugin
+// still receives HostShutdown and may exit within its grace period.
+func (m *Manager) UnloadPlugin(id string) error {
+	m.mu.Lock()
+	proc, ok := m.plugins[id]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("plugin %q not loaded", id)
+	}
+	delete(m.plugins, id)
+	for name, binding := range m.commands {
+		if binding.pluginID == id {
+			delete(m.commands, name)
+		}
+	}
+	m.mu.Unlock()
+
+	if n := proc.discardActions(); n > 0 {
+		proc.log.Info("dropped queued actions", "batches", n)
+	}
+	proc.shutdown("plugin unloaded")
+	m.dropPluginRequests(id)
+	proc.log.Info("plugin unloaded")
+	return nil
+}
+
+// ReloadPlugin unloads a plugin and loads it again with its entry re-read from the plugin
+// configuration file. The entry is read once the old process is gone, as preparing it may replace the
+// working directory the old process runs in, such as the clone of a non-persistent git plugin. If the
+// entry cannot be read, the previous configuration is reused. The new process starts with a fresh
+// restart history, so a plugin that gave up after crash looping runs again.
+func (m *Manager) ReloadPlugin(id string) error {
+	m.mu.RLock()
+	proc, ok := m.plugins[id]
+	m.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("plugin %q not loaded", id)
+	}
+	if err := m.UnloadPlugin(id); err != nil {
+		return err
+	}
+	pc, err := config.LoadPluginConfig(m.configPath, id)
+	if err != nil {
+		proc.log.Warn("reusing previous plugin config", "error", err)
+		pc = proc.cfg
+	}
+	return m.LoadPlugin(pc)
+}
+
+// LoadPluginByID loads the plugin with the given ID from the plugin configuration file.
+func (m *Manager) LoadPluginByID(id string) error {
+	pc, err := config.LoadPluginConfig(m.configPath, id)
+	if err != nil {
+		return err
+	}
+	return m.LoadPlugin(pc)
+}
+
+// PluginIDs returns the IDs of all loaded plugins, sorted.
+func (m *Manager) PluginIDs() []string {
+	m.mu.RLock()
+	ids := make([]string, 0, len(m.plugins))
+	for id := range m.plugins {
+		ids = append(ids, id)
+	}
+	m.mu.RUnlock()
+	sort.Strings(ids)
+	return ids
+}
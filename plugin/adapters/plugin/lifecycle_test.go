@@ -0,0 +1,157 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// startTestServer starts the plugin server of m on a free port, without plugins.
+func startTestServer(t *testing.T, m *Manager) {
+	t.Helper()
+	if err := m.StartWithConfig(config.Config{ServerAddr: "127.0.0.1:0", EventTimeoutMs: 300, ShutdownGraceMs: 50}); err != nil {
+		t.Fatalf("start manager: %v", err)
+	}
+	t.Cleanup(m.Close)
+}
+
+// newLoadedTestPlugin registers a disconnected plugin that has a command bound, an event result
+// awaited and an action batch queued.
+func newLoadedTestPlugin(m *Manager, id string) (*pluginProcess, chan *pb.EventResult) {
+	p := newTestPlugin(m, id)
+	p.connected.Store(false)
+	p.actionsNotify = make(chan struct{}, 1)
+	p.actionsFreed = make(chan struct{}, 1)
+	p.limits = queueLimits{eventSize: 16, actionSize: 4, overflow: config.OverflowDropNewest}
+	p.enqueueActions(testBatch("1"))
+	m.commands[id+"-cmd"] = commandBinding{pluginID: id, command: id + "-cmd"}
+	return p, p.expectEventResult("event")
+}
+
+func TestLoadPlugin(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	if err := m.LoadPlugin(config.PluginConfig{ID: "shop", Token: "secret"}); err == nil {
+		t.Fatal("LoadPlugin succeeded before the plugin server started")
+	}
+	startTestServer(t, m)
+
+	if err := m.LoadPlugin(config.PluginConfig{Name: "shop", Token: "secret"}); err != nil {
+		t.Fatalf("LoadPlugin: %v", err)
+	}
+	m.mu.RLock()
+	p := m.plugins["shop"]
+	m.mu.RUnlock()
+	if p == nil {
+		t.Fatalf("loaded plugins %v, want shop named after its name", m.PluginIDs())
+	}
+	if p.cfg.EventTimeoutMs != 300 || p.cfg.ShutdownGraceMs != 50 {
+		t.Errorf("plugin has event timeout %d and grace %d, want the defaults of the server", p.cfg.EventTimeoutMs, p.cfg.ShutdownGraceMs)
+	}
+	if err := m.LoadPlugin(config.PluginConfig{ID: "shop", Token: "secret"}); err == nil {
+		t.Error("LoadPlugin loaded a plugin with the ID of a loaded one")
+	}
+	if err := m.LoadPlugin(config.PluginConfig{ID: "external"}); err == nil {
+		t.Error("LoadPlugin loaded an external plugin without a token")
+	}
+	if ids := m.PluginIDs(); !slices.Equal(ids, []string{"shop"}) {
+		t.Errorf("loaded plugins %v, want only shop", ids)
+	}
+}
+
+func TestUnloadPlugin(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	p, waiter := newLoadedTestPlugin(m, "shop")
+	newLoadedTestPlugin(m, "other")
+	m.pendingMessages[pluginRequestKey("shop", "msg")] = &pendingPluginRequest{sourceID: "shop", messageID: "msg", timer: time.NewTimer(time.Hour)}
+
+	if err := m.UnloadPlugin("shop"); err != nil {
+		t.Fatalf("UnloadPlugin: %v", err)
+	}
+	if ids := m.PluginIDs(); !slices.Equal(ids, []string{"other"}) {
+		t.Errorf("loaded plugins %v, want only other", ids)
+	}
+	if _, ok := m.commands["shop-cmd"]; ok {
+		t.Error("command of the unloaded plugin still bound")
+	}
+	if _, ok := m.commands["other-cmd"]; !ok {
+		t.Error("command of another plugin unbound")
+	}
+	select {
+	case _, ok := <-waiter:
+		if ok {
+			t.Error("waiter for an event result received a result")
+		}
+	default:
+		t.Error("waiter for an event result not released")
+	}
+	if len(p.actionsQueue) != 0 || p.actionsPending.Load() != 0 {
+		t.Errorf("%d action batches still queued", len(p.actionsQueue))
+	}
+	if len(m.pendingMessages) != 0 {
+		t.Errorf("plugin message requests %v still pending", m.pendingMessages)
+	}
+	if !p.closed.Load() {
+		t.Error("plugin not stopped")
+	}
+	if err := m.UnloadPlugin("shop"); err == nil {
+		t.Error("UnloadPlugin unloaded a plugin twice")
+	}
+}
+
+func TestReloadPlugin(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	startTestServer(t, m)
+	m.configPath = filepath.Join(t.TempDir(), "plugins.yaml")
+	writeConfig := func(data string) {
+		if err := os.WriteFile(m.configPath, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeConfig("server_addr: 127.0.0.1:0\nplugins:\n  - id: shop\n    token: secret\n    event_timeout_ms: 700\n")
+	old, waiter := newLoadedTestPlugin(m, "shop")
+
+	if err := m.ReloadPlugin("shop"); err != nil {
+		t.Fatalf("ReloadPlugin: %v", err)
+	}
+	m.mu.RLock()
+	p := m.plugins["shop"]
+	m.mu.RUnlock()
+	if p == nil || p == old {
+		t.Fatal("plugin not replaced by a new process")
+	}
+	if p.cfg.EventTimeoutMs != 700 || p.token != "secret" {
+		t.Errorf("plugin has event timeout %d and token %q, want the entry of the config file", p.cfg.EventTimeoutMs, p.token)
+	}
+	if !old.closed.Load() || len(old.actionsQueue) != 0 {
+		t.Error("old process not stopped with its actions dropped")
+	}
+	if _, ok := m.commands["shop-cmd"]; ok {
+		t.Error("command of the old process still bound")
+	}
+	if _, ok := <-waiter; ok {
+		t.Error("waiter for an event result of the old process received a result")
+	}
+
+	// The previous configuration is reused if the entry is gone.
+	writeConfig("server_addr: 127.0.0.1:0\n")
+	if err := m.ReloadPlugin("shop"); err != nil {
+		t.Fatalf("ReloadPlugin: %v", err)
+	}
+	m.mu.RLock()
+	reloaded := m.plugins["shop"]
+	m.mu.RUnlock()
+	if reloaded == nil || reloaded == p || reloaded.cfg.EventTimeoutMs != 700 {
+		t.Error("plugin not reloaded with its previous config")
+	}
+
+	if err := m.ReloadPlugin("missing"); err == nil {
+		t.Error("ReloadPlugin reloaded a plugin that is not loaded")
+	}
+}
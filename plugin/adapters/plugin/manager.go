@@ -2,16 +2,20 @@ package plugin
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/df-mc/dragonfly/server"
@@ -33,10 +37,15 @@ type Manager struct {
 	cancel context.CancelFunc
 
 	grpcServer *grpc.GrpcServer
+	// cfg is the configuration the manager was started with. Its top-level defaults also apply to
+	// plugins loaded at runtime, whose entries are re-read from configPath.
+	cfg        config.Config
+	configPath string
 
 	mu       sync.RWMutex
 	plugins  map[string]*pluginProcess
 	players  map[uuid.UUID]*player.Player
+	conns    map[uuid.UUID]*playerConn
 	commands map[string]commandBinding
 
 	worldMu sync.RWMutex
@@ -48,8 +57,12 @@ type Manager struct {
 
 	eventCounter atomic.Uint64
 
-	playerHandlerFactory ports.PlayerHandlerFactory
-	worldHandlerFactory  ports.WorldHandlerFactory
+	messagesMu      sync.Mutex
+	pendingMessages map[string]*pendingPluginRequest
+
+	playerHandlerFactory    ports.PlayerHandlerFactory
+	worldHandlerFactory     ports.WorldHandlerFactory
+	inventoryHandlerFactory ports.InventoryHandlerFactory
 
 	bootID string
 }
@@ -67,31 +80,40 @@ type commandBinding struct {
 	descriptor *pb.CommandSpec
 }
 
+// eventResponseTimeout is the default time to wait for a plugin's event result when neither
+// plugins.yaml nor the plugin's own config sets event_timeout_ms.
 const eventResponseTimeout = 250 * time.Millisecond
 
-func NewManager(srv *server.Server, log *slog.Logger, playerHandlerFactory ports.PlayerHandlerFactory, worldHandlerFactory ports.WorldHandlerFactory) *Manager {
+func NewManager(srv *server.Server, log *slog.Logger, playerHandlerFactory ports.PlayerHandlerFactory, worldHandlerFactory ports.WorldHandlerFactory, inventoryHandlerFactory ports.InventoryHandlerFactory) *Manager {
 	if log == nil {
 		log = slog.Default()
 	}
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Manager{
-		srv:                  srv,
-		log:                  log.With("component", "plugin-manager"),
-		ctx:                  ctx,
-		cancel:               cancel,
-		plugins:              make(map[string]*pluginProcess),
-		players:              make(map[uuid.UUID]*player.Player),
-		commands:             make(map[string]commandBinding),
-		worlds:               make(map[string]*world.World),
-		worldsByDim:          make(map[string]*world.World),
-		worldsByID:           make(map[string]*world.World),
-		playerHandlerFactory: playerHandlerFactory,
-		worldHandlerFactory:  worldHandlerFactory,
-		bootID:               uuid.NewString(),
+		srv:                     srv,
+		log:                     log.With("component", "plugin-manager"),
+		ctx:                     ctx,
+		cancel:                  cancel,
+		plugins:                 make(map[string]*pluginProcess),
+		players:                 make(map[uuid.UUID]*player.Player),
+		conns:                   make(map[uuid.UUID]*playerConn),
+		commands:                make(map[string]commandBinding),
+		worlds:                  make(map[string]*world.World),
+		worldsByDim:             make(map[string]*world.World),
+		worldsByID:              make(map[string]*world.World),
+		pendingMessages:         make(map[string]*pendingPluginRequest),
+		playerHandlerFactory:    playerHandlerFactory,
+		worldHandlerFactory:     worldHandlerFactory,
+		inventoryHandlerFactory: inventoryHandlerFactory,
+		bootID:                  uuid.NewString(),
+		configPath:              config.ConfigFile,
 	}
 }
 
 func (m *Manager) Start(configPath string) error {
+	if configPath != "" {
+		m.configPath = configPath
+	}
 	cfg, err := config.LoadConfig(configPath)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
@@ -107,12 +129,20 @@ func (m *Manager) Start(configPath string) error {
 func (m *Manager) StartWithConfig(cfg config.Config) error {
 	// Start gRPC server to accept plugin connections
 	address := cfg.ServerAddr
-	grpcServer, err := grpc.NewServer(address, m.handlePluginConnection)
+	var tlsConfig *tls.Config
+	if cfg.TLS.Enabled() {
+		var err error
+		tlsConfig, err = grpc.LoadTLSConfig(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("load plugin server tls: %w", err)
+		}
+	}
+	grpcServer, err := grpc.NewServer(address, tlsConfig, m.handlePluginConnection)
 	if err != nil {
 		return fmt.Errorf("start plugin server: %w", err)
 	}
 	m.grpcServer = grpcServer
-	m.log.Info("plugin server listening", "address", grpcServer.Address())
+	m.log.Info("plugin server listening", "address", grpcServer.Address(), "tls", tlsConfig != nil, "mtls", cfg.TLS.ClientCAFile != "")
 
 	// Start accepting connections in background
 	go func() {
@@ -121,19 +151,15 @@ func (m *Manager) StartWithConfig(cfg config.Config) error {
 		}
 	}()
 
+	m.cfg = cfg
+	m.registerPluginsCommand()
+	go m.sendMoveBatches()
+
 	// Launch plugin processes
 	for _, pc := range cfg.Plugins {
-		if pc.ID == "" {
-			pc.ID = pc.Name
+		if err := m.LoadPlugin(pc); err != nil {
+			m.log.Error("load plugin", "plugin", pc.ID, "error", err)
 		}
-		if pc.ID == "" {
-			pc.ID = fmt.Sprintf("plugin-%s", strings.ToLower(uuid.NewString()[:8]))
-		}
-		proc := newPluginProcess(m, pc)
-		m.mu.Lock()
-		m.plugins[pc.ID] = proc
-		m.mu.Unlock()
-		go proc.start(m.ctx, grpcServer.Address())
 	}
 	return nil
 }
@@ -152,7 +178,12 @@ func (m *Manager) handlePluginConnection(stream *grpc.GrpcStream) error {
 		return fmt.Errorf("decode first message: %w", err)
 	}
 
+	// With mutual TLS, the client certificate names the plugin.
+	identities := stream.PeerIdentities()
 	pluginID := msg.PluginId
+	if pluginID == "" {
+		pluginID = m.pluginForIdentities(identities)
+	}
 	if pluginID == "" {
 		return errors.New("first message missing plugin_id")
 	}
@@ -163,7 +194,28 @@ func (m *Manager) handlePluginConnection(stream *grpc.GrpcStream) error {
 	m.mu.RUnlock()
 
 	if !ok {
-		return fmt.Errorf("unknown plugin ID: %s", pluginID)
+		m.log.Warn("rejected plugin connection", "plugin", pluginID, "peer", stream.PeerAddress(), "reason", "unknown plugin ID")
+		return status.Errorf(codes.Unauthenticated, "unknown plugin ID: %s", pluginID)
+	}
+	if len(identities) > 0 {
+		// A verified certificate authenticates the plugin it names, in place of the token.
+		if !slices.Contains(identities, pluginID) {
+			proc.log.Warn("rejected plugin connection", "peer", stream.PeerAddress(), "reason", "certificate does not match plugin ID", "identities", identities)
+			return status.Error(codes.Unauthenticated, "client certificate does not match plugin ID")
+		}
+	} else {
+		token := msg.AuthToken
+		if token == "" {
+			token = stream.Metadata(tokenMetadataKey)
+		}
+		if !proc.authenticate(token) {
+			proc.log.Warn("rejected plugin connection", "peer", stream.PeerAddress(), "reason", "invalid token")
+			return status.Error(codes.Unauthenticated, "invalid plugin token")
+		}
+	}
+	if proc.connected.Load() {
+		proc.log.Warn("rejected plugin connection", "peer", stream.PeerAddress(), "reason", "already connected")
+		return status.Error(codes.AlreadyExists, errAlreadyConnected.Error())
 	}
 
 	// Handle the first message (likely PluginHello)
@@ -171,6 +223,10 @@ func (m *Manager) handlePluginConnection(stream *grpc.GrpcStream) error {
 
 	// Attach the stream to the process
 	if err := proc.attachStream(stream); err != nil {
+		if errors.Is(err, errAlreadyConnected) {
+			proc.log.Warn("rejected plugin connection", "peer", stream.PeerAddress(), "reason", "already connected")
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
 		return fmt.Errorf("attach stream: %w", err)
 	}
 
@@ -180,30 +236,40 @@ func (m *Manager) handlePluginConnection(stream *grpc.GrpcStream) error {
 		return m.ctx.Err()
 	case <-proc.done:
 		return nil
+	case <-stream.Done():
+		return status.Error(codes.Unavailable, "plugin stream closed by host")
 	}
 }
 
+// Close shuts all plugins down gracefully, in parallel, before stopping the gRPC server.
+// Plugins keep their streams during the grace period so that HostShutdown is delivered and
+// the actions they send while shutting down are still applied.
 func (m *Manager) Close() {
-	m.cancel()
+	m.mu.RLock()
+	procs := make([]*pluginProcess, 0, len(m.plugins))
+	for _, proc := range m.plugins {
+		procs = append(procs, proc)
+	}
+	m.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, proc := range procs {
+		wg.Go(func() {
+			proc.shutdown("server shutting down")
+			m.dropPluginRequests(proc.id)
+		})
+	}
+	wg.Wait()
 
+	m.cancel()
 	// Stop gRPC server
 	if m.grpcServer != nil {
 		m.grpcServer.Stop()
 	}
 
 	m.mu.Lock()
-	defer m.mu.Unlock()
-	for id, proc := range m.plugins {
-		shutdown := &pb.HostToPlugin{
-			PluginId: id,
-			Payload: &pb.HostToPlugin_Shutdown{
-				Shutdown: &pb.HostShutdown{Reason: "server shutting down"},
-			},
-		}
-		proc.queue(shutdown)
-		proc.Stop()
-	}
 	m.plugins = make(map[string]*pluginProcess)
+	m.mu.Unlock()
 }
 
 func (m *Manager) AttachWorld(w *world.World) {
@@ -225,6 +291,7 @@ func (m *Manager) AttachPlayer(p *player.Player) {
 		handler := m.playerHandlerFactory(m)
 		p.Handle(handler)
 	}
+	m.handleInventories(p)
 	m.mu.Lock()
 	m.players[p.UUID()] = p
 	m.mu.Unlock()
@@ -235,6 +302,7 @@ func (m *Manager) detachPlayer(p *player.Player) {
 	m.mu.Lock()
 	delete(m.players, p.UUID())
 	m.mu.Unlock()
+	m.forgetThrottles(p.UUID().String())
 }
 
 // broadcastEvent sends an event which does not expect a response.
@@ -244,51 +312,86 @@ func (m *Manager) broadcastEvent(envelope *pb.EventEnvelope) {
 	_ = m.dispatchEvent(envelope, false)
 }
 
-func (m *Manager) dispatchEvent(envelope *pb.EventEnvelope, expectResult bool) []*pb.EventResult {
-	if envelope == nil {
-		return nil
+// sendEventTo delivers an event to a single plugin regardless of its subscriptions.
+// It is used for replies to plugin-initiated interactions such as form responses.
+func (m *Manager) sendEventTo(pluginID string, envelope *pb.EventEnvelope) {
+	m.mu.RLock()
+	proc, ok := m.plugins[pluginID]
+	m.mu.RUnlock()
+	if !ok {
+		return
 	}
 	if envelope.EventId == "" {
 		envelope.EventId = m.generateEventID()
 	}
+	envelope.ExpectsResponse = false
+	proc.log.Debug("sending event", "event_id", envelope.EventId, "type", envelope.Type.String())
+	proc.queue(&pb.HostToPlugin{
+		PluginId: proc.id,
+		Payload: &pb.HostToPlugin_Event{
+			Event: envelope,
+		},
+	})
+}
 
-	eventType := envelope.Type
+// awaitEventFrom delivers an event to a single plugin regardless of its subscriptions and waits for its
+// result. It returns nil if the plugin is not loaded, is not waited for or did not answer in time.
+func (m *Manager) awaitEventFrom(pluginID string, envelope *pb.EventEnvelope) *pb.EventResult {
 	m.mu.RLock()
-	procs := make([]*pluginProcess, 0, len(m.plugins))
-	for _, proc := range m.plugins {
-		if !proc.HasSubscription(eventType) {
-			continue
-		}
-		procs = append(procs, proc)
-	}
+	proc, ok := m.plugins[pluginID]
 	m.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	if envelope.EventId == "" {
+		envelope.EventId = m.generateEventID()
+	}
+	envelope.ExpectsResponse = true
+	return m.dispatchToParallel([]*pluginProcess{proc}, envelope, true)[0]
+}
+
+func (m *Manager) dispatchEvent(envelope *pb.EventEnvelope, expectResult bool) []*pb.EventResult {
+	if envelope == nil {
+		return nil
+	}
+	if envelope.EventId == "" {
+		envelope.EventId = m.generateEventID()
+	}
 
+	procs := m.subscribers(envelope)
 	if len(procs) == 0 {
 		return nil
 	}
 
 	results := make([]*pb.EventResult, 0, len(procs))
 	for _, proc := range procs {
+		// Skip waiting on disconnected plugins, such as one that is being restarted, and on
+		// plugins whose circuit breaker is open.
+		wait := expectResult && proc.connected.Load() && proc.breaker.allow()
+		event := envelope
+		if expectResult && !wait {
+			event = fireAndForget(envelope)
+		}
 		var waitCh chan *pb.EventResult
-		if expectResult {
+		if wait {
 			waitCh = proc.expectEventResult(envelope.EventId)
 		}
 
 		msg := &pb.HostToPlugin{
 			PluginId: proc.id,
 			Payload: &pb.HostToPlugin_Event{
-				Event: envelope,
+				Event: event,
 			},
 		}
 		proc.log.Debug("sending event", "event_id", envelope.EventId, "type", envelope.Type.String())
 		proc.queue(msg)
 
-		if !expectResult {
+		if !wait {
 			continue
 		}
 
 		waitStart := time.Now()
-		res, err := proc.waitEventResult(waitCh, eventResponseTimeout)
+		res, err := proc.waitEventResult(waitCh, proc.eventTimeout(envelope.Type))
 		pluginResponseTime := time.Since(waitStart)
 
 		if err != nil {
@@ -297,10 +400,12 @@ func (m *Manager) dispatchEvent(envelope *pb.EventEnvelope, expectResult bool) [
 					"event_id", envelope.EventId,
 					"type", envelope.Type.String(),
 					"wait_ms", pluginResponseTime.Milliseconds())
+				m.recordEventTimeout(proc)
 			}
 			proc.discardEventResult(envelope.EventId)
 			continue
 		}
+		m.recordEventSuccess(proc)
 		if res != nil {
 			results = append(results, res)
 
@@ -332,48 +437,51 @@ func (m *Manager) dispatchEventParallel(envelope *pb.EventEnvelope, expectResult
 		envelope.EventId = m.generateEventID()
 	}
 
-	eventType := envelope.Type
-	m.mu.RLock()
-	procs := make([]*pluginProcess, 0, len(m.plugins))
-	for _, proc := range m.plugins {
-		if !proc.HasSubscription(eventType) {
-			continue
-		}
-		procs = append(procs, proc)
-	}
-	m.mu.RUnlock()
-
+	procs := m.subscribers(envelope)
 	if len(procs) == 0 {
 		return nil
 	}
+	return m.dispatchToParallel(procs, envelope, expectResult)
+}
 
+// dispatchToParallel sends an event to the given plugins concurrently. Results are returned in
+// the order of procs, with nil entries for plugins that did not respond.
+func (m *Manager) dispatchToParallel(procs []*pluginProcess, envelope *pb.EventEnvelope, expectResult bool) []*pb.EventResult {
 	results := make([]*pb.EventResult, len(procs))
 	var wg sync.WaitGroup
 	for idx, proc := range procs {
 		wg.Go(func() {
+			// Skip waiting on disconnected plugins and on plugins whose circuit breaker is open.
+			wait := expectResult && proc.connected.Load() && proc.breaker.allow()
+			event := envelope
+			if expectResult && !wait {
+				event = fireAndForget(envelope)
+			}
 			var waitCh chan *pb.EventResult
-			if expectResult {
+			if wait {
 				waitCh = proc.expectEventResult(envelope.EventId)
 			}
 			proc.log.Debug("sending event", "event_id", envelope.EventId, "type", envelope.Type.String())
 			proc.queue(&pb.HostToPlugin{
 				PluginId: proc.id,
 				Payload: &pb.HostToPlugin_Event{
-					Event: envelope,
+					Event: event,
 				},
 			})
-			if !expectResult {
+			if !wait {
 				return
 			}
 			waitStart := time.Now()
-			res, err := proc.waitEventResult(waitCh, eventResponseTimeout)
+			res, err := proc.waitEventResult(waitCh, proc.eventTimeout(envelope.Type))
 			if err != nil {
 				if errors.Is(err, context.DeadlineExceeded) {
 					proc.log.Warn("plugin did not respond to event", "event_id", envelope.EventId, "type", envelope.Type.String())
+					m.recordEventTimeout(proc)
 				}
 				proc.discardEventResult(envelope.EventId)
 				return
 			}
+			m.recordEventSuccess(proc)
 			pluginResponseTime := time.Since(waitStart)
 			proc.log.Debug("plugin event response received",
 				"event_id", envelope.EventId,
@@ -387,25 +495,94 @@ func (m *Manager) dispatchEventParallel(envelope *pb.EventEnvelope, expectResult
 	return results
 }
 
+// emitCancellable dispatches a cancellable event one priority group at a time, from
+// EVENT_PRIORITY_LOWEST to EVENT_PRIORITY_HIGHEST. Each group receives the payload with the
+// mutations of earlier groups applied, and dispatch stops once a plugin cancels the event;
+// later subscribers with receive_cancelled still observe it read-only. MONITOR subscribers are
+// notified last with the final payload and cannot influence it. The returned results are
+// ordered by priority, so later mutations take precedence.
 func (m *Manager) emitCancellable(ctx cancelContext, envelope *pb.EventEnvelope) []*pb.EventResult {
 	envelope.ExpectsResponse = true
-	// Fire all at once and wait for all responses.
-	results := m.dispatchEventParallel(envelope, true)
-	cancelled := false
-	for _, res := range results {
-		if res != nil && res.Cancel != nil && *res.Cancel {
-			cancelled = true
+	if envelope.EventId == "" {
+		envelope.EventId = m.generateEventID()
+	}
+	groups, ok := m.priorityGroups(envelope)
+	if !ok {
+		return nil
+	}
+
+	var (
+		results     []*pb.EventResult
+		applied     []*pb.AppliedMutation
+		pending     []*pb.EventResult
+		cancelledBy string
+		cancelled   *pb.EventEnvelope
+		current     = envelope
+	)
+	for rank, procs := range groups {
+		if len(procs) == 0 || rank == monitorRank {
+			continue
+		}
+		if cancelled != nil {
+			if observers := receivingCancelled(procs, envelope.Type); len(observers) > 0 {
+				m.dispatchToParallel(observers, cancelled, false)
+			}
+			continue
+		}
+		if len(pending) > 0 {
+			current = mutatedEnvelope(current, pending)
+			pending = nil
+		}
+		for idx, res := range m.dispatchToParallel(procs, current, true) {
+			if res == nil {
+				continue
+			}
+			if res.Cancel != nil && *res.Cancel && cancelledBy == "" {
+				cancelledBy = procs[idx].id
+			}
+			if res.Update != nil {
+				pending = append(pending, res)
+				applied = append(applied, &pb.AppliedMutation{PluginId: procs[idx].id, Result: res})
+			}
+			results = append(results, res)
+		}
+		if cancelledBy != "" {
+			cancelled = proto.Clone(current).(*pb.EventEnvelope)
+			cancelled.ExpectsResponse = false
+			cancelled.Cancelled = true
+		}
+	}
+
+	if monitors := groups[monitorRank]; len(monitors) > 0 {
+		final := cancelled
+		if final == nil {
+			final = mutatedEnvelope(current, pending)
+			final.ExpectsResponse = false
+		}
+		if monitors = batchMoves(monitors, final); len(monitors) > 0 {
+			m.dispatchToParallel(monitors, final, false)
 		}
 	}
-	if cancelled && ctx != nil {
+
+	if cancelled != nil {
+		applied = nil
+	}
+	m.sendEventOutcome(groups, &pb.EventOutcome{
+		EventId:     envelope.EventId,
+		Type:        envelope.Type,
+		Cancelled:   cancelled != nil,
+		CancelledBy: cancelledBy,
+		Mutations:   applied,
+	})
+
+	if cancelled != nil && ctx != nil {
 		ctx.Cancel()
 	}
 	// If any plugin cancelled, do not apply any mutations.
-	if cancelled {
-		m.log.Debug("event cancelled by plugin", "event_id", envelope.EventId, "type", envelope.Type.String())
+	if cancelled != nil {
+		m.log.Debug("event cancelled by plugin", "event_id", envelope.EventId, "type", envelope.Type.String(), "plugin", cancelledBy)
 		return nil
 	}
-	// m.log.Debug("event completed", "event_id", envelope.EventId, "type", envelope.Type.String(), "responses", len(results))
 	return results
 }
 
@@ -457,18 +634,9 @@ func convertProtoDrops(drops []*pb.ItemStack) []item.Stack {
 	}
 	converted := make([]item.Stack, 0, len(drops))
 	for _, drop := range drops {
-		if drop == nil || drop.Name == "" {
-			continue
+		if stack, ok := convertProtoItemStackValue(drop); ok {
+			converted = append(converted, stack)
 		}
-		material, ok := world.ItemByName(drop.Name, int16(drop.Meta))
-		if !ok {
-			continue
-		}
-		count := int(drop.Count)
-		if count <= 0 {
-			continue
-		}
-		converted = append(converted, item.NewStack(material, count))
 	}
 	return converted
 }
@@ -577,14 +745,22 @@ func (m *Manager) handlePluginMessage(p *pluginProcess, msg *pb.PluginToHost) {
 		eventNames := mapSlice(subscribe.Events, func(evt pb.EventType) string {
 			return evt.String()
 		})
+		for _, opt := range subscribe.Subscriptions {
+			eventNames = append(eventNames, fmt.Sprintf("%s@%s", opt.GetEvent(), opt.GetPriority()))
+		}
 		pluginName := p.id
 		if hello := p.helloInfo(); hello != nil && hello.Name != "" {
 			pluginName = hello.Name
 		}
 		m.log.Info(fmt.Sprintf("  %s subscribed to %d events", pluginName, len(eventNames)), "events", eventNames)
-		p.updateSubscriptions(subscribe.Events)
+		p.updateSubscriptions(subscribe.Events, subscribe.Subscriptions)
+		p.updateChannels(subscribe.Channels)
 	case *pb.PluginToHost_Actions:
 		p.enqueueActions(payload.Actions)
+	case *pb.PluginToHost_ShutdownAck:
+		p.ackShutdown()
+	case *pb.PluginToHost_PluginMessage:
+		m.routePluginMessage(p, payload.PluginMessage)
 	case *pb.PluginToHost_Log:
 		logMsg := payload.Log
 		level := strings.ToLower(logMsg.Level)
@@ -599,9 +775,11 @@ func (m *Manager) handlePluginMessage(p *pluginProcess, msg *pb.PluginToHost) {
 	case *pb.PluginToHost_ServerInfo:
 		var pluginNames []string
 
+		m.mu.RLock()
 		for _, pl := range m.plugins {
 			pluginNames = append(pluginNames, pl.cfg.Name)
 		}
+		m.mu.RUnlock()
 		p.sendServerInfo(pluginNames)
 	default:
 		p.log.Info(fmt.Sprintf("unhandled event: %#v", payload))
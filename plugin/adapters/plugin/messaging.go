@@ -0,0 +1,177 @@
+package plugin
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+const (
+	pluginMessageTimeout    = 5 * time.Second
+	pluginMessageMaxTimeout = time.Minute
+)
+
+// pendingPluginRequest tracks a request/reply plugin message awaiting its first reply.
+type pendingPluginRequest struct {
+	sourceID  string
+	messageID string
+	// targets holds the IDs of the plugins the request was delivered to, the only plugins that may
+	// reply to it.
+	targets map[string]struct{}
+	timer   *time.Timer
+}
+
+// routePluginMessage forwards a plugin message to its target plugin or channel subscribers.
+// Replies are matched to the pending request they answer; routing failures are reported back
+// to the sender as a PluginMessage carrying an error.
+func (m *Manager) routePluginMessage(p *pluginProcess, msg *pb.PluginMessage) {
+	if msg == nil {
+		return
+	}
+	msg.SourcePluginId = p.id
+	msg.Error = nil
+
+	if msg.ReplyTo != "" {
+		m.routePluginReply(p, msg)
+		return
+	}
+
+	var targets []*pluginProcess
+	switch {
+	case msg.TargetPluginId != "":
+		m.mu.RLock()
+		target, ok := m.plugins[msg.TargetPluginId]
+		m.mu.RUnlock()
+		if !ok || !target.connected.Load() {
+			m.sendPluginMessageError(p, msg.MessageId, fmt.Sprintf("unknown target plugin: %s", msg.TargetPluginId))
+			return
+		}
+		targets = append(targets, target)
+	case msg.Channel != "":
+		m.mu.RLock()
+		for _, proc := range m.plugins {
+			if proc != p && proc.HasChannel(msg.Channel) {
+				targets = append(targets, proc)
+			}
+		}
+		m.mu.RUnlock()
+		if len(targets) == 0 {
+			if msg.ExpectsReply {
+				m.sendPluginMessageError(p, msg.MessageId, fmt.Sprintf("no subscribers for channel: %s", msg.Channel))
+			}
+			return
+		}
+	default:
+		m.sendPluginMessageError(p, msg.MessageId, "message requires target_plugin_id or channel")
+		return
+	}
+
+	if msg.ExpectsReply {
+		if msg.MessageId == "" {
+			m.sendPluginMessageError(p, "", "request requires message_id")
+			return
+		}
+		if !m.expectPluginReply(p, msg, targets) {
+			m.sendPluginMessageError(p, msg.MessageId, "duplicate message_id for pending request")
+			return
+		}
+	}
+	for _, target := range targets {
+		target.queue(&pb.HostToPlugin{
+			PluginId: target.id,
+			Payload:  &pb.HostToPlugin_PluginMessage{PluginMessage: msg},
+		})
+	}
+}
+
+// routePluginReply forwards a reply to the plugin that sent the request it answers. Only a plugin the
+// request was delivered to may reply, and only the first reply is forwarded.
+func (m *Manager) routePluginReply(p *pluginProcess, msg *pb.PluginMessage) {
+	key := pluginRequestKey(msg.TargetPluginId, msg.ReplyTo)
+	m.messagesMu.Lock()
+	req, ok := m.pendingMessages[key]
+	if ok {
+		if _, ok = req.targets[p.id]; ok {
+			delete(m.pendingMessages, key)
+			req.timer.Stop()
+		}
+	}
+	m.messagesMu.Unlock()
+	if !ok {
+		m.sendPluginMessageError(p, msg.MessageId, fmt.Sprintf("no pending request %q for plugin %s", msg.ReplyTo, msg.TargetPluginId))
+		return
+	}
+
+	m.mu.RLock()
+	source, ok := m.plugins[req.sourceID]
+	m.mu.RUnlock()
+	if !ok {
+		return
+	}
+	source.queue(&pb.HostToPlugin{
+		PluginId: source.id,
+		Payload:  &pb.HostToPlugin_PluginMessage{PluginMessage: msg},
+	})
+}
+
+// expectPluginReply registers a pending request delivered to targets and arms its timeout. It returns
+// false if the sender already has a pending request with the same message ID.
+func (m *Manager) expectPluginReply(p *pluginProcess, msg *pb.PluginMessage, targets []*pluginProcess) bool {
+	timeout := pluginMessageTimeout
+	if msg.TimeoutMs > 0 {
+		timeout = min(time.Duration(msg.TimeoutMs)*time.Millisecond, pluginMessageMaxTimeout)
+	}
+	key := pluginRequestKey(p.id, msg.MessageId)
+
+	m.messagesMu.Lock()
+	defer m.messagesMu.Unlock()
+	if _, exists := m.pendingMessages[key]; exists {
+		return false
+	}
+	req := &pendingPluginRequest{sourceID: p.id, messageID: msg.MessageId, targets: make(map[string]struct{}, len(targets))}
+	for _, target := range targets {
+		req.targets[target.id] = struct{}{}
+	}
+	req.timer = time.AfterFunc(timeout, func() {
+		m.messagesMu.Lock()
+		current, ok := m.pendingMessages[key]
+		if ok && current == req {
+			delete(m.pendingMessages, key)
+		}
+		m.messagesMu.Unlock()
+		if ok && current == req {
+			m.sendPluginMessageError(p, req.messageID, "request timed out")
+		}
+	})
+	m.pendingMessages[key] = req
+	return true
+}
+
+// dropPluginRequests discards pending requests sent by the plugin with the given ID.
+func (m *Manager) dropPluginRequests(pluginID string) {
+	m.messagesMu.Lock()
+	defer m.messagesMu.Unlock()
+	for key, req := range m.pendingMessages {
+		if req.sourceID == pluginID {
+			req.timer.Stop()
+			delete(m.pendingMessages, key)
+		}
+	}
+}
+
+func (m *Manager) sendPluginMessageError(p *pluginProcess, messageID, errMsg string) {
+	p.log.Debug("plugin message rejected", "message_id", messageID, "error", errMsg)
+	p.queue(&pb.HostToPlugin{
+		PluginId: p.id,
+		Payload: &pb.HostToPlugin_PluginMessage{PluginMessage: &pb.PluginMessage{
+			ReplyTo:        messageID,
+			TargetPluginId: p.id,
+			Error:          &errMsg,
+		}},
+	})
+}
+
+func pluginRequestKey(sourceID, messageID string) string {
+	return sourceID + "\x00" + messageID
+}
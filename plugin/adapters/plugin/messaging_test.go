@@ -0,0 +1,231 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// newTestPlugin registers a connected plugin with the ID passed, subscribed to channels, whose
+// messages are queued on its send channel.
+func newTestPlugin(m *Manager, id string, channels ...string) *pluginProcess {
+	p := &pluginProcess{
+		id:      id,
+		manager: m,
+		log:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		sendCh:  make(chan *pb.HostToPlugin, 16),
+		done:    make(chan struct{}),
+		pending: make(map[string]chan *pb.EventResult),
+		breaker: newCircuitBreaker(config.CircuitBreakerConfig{}),
+	}
+	set := make(map[string]struct{}, len(channels))
+	for _, channel := range channels {
+		set[channel] = struct{}{}
+	}
+	p.channels.Store(&set)
+	p.connected.Store(true)
+	p.ready.Store(true)
+	m.plugins[id] = p
+	return p
+}
+
+// received returns the plugin messages queued for p.
+func received(p *pluginProcess) []*pb.PluginMessage {
+	var msgs []*pb.PluginMessage
+	for {
+		select {
+		case msg := <-p.sendCh:
+			msgs = append(msgs, msg.GetPluginMessage())
+		default:
+			return msgs
+		}
+	}
+}
+
+func TestRoutePluginMessage(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	shop := newTestPlugin(m, "shop")
+	economy := newTestPlugin(m, "economy", "balances")
+	bank := newTestPlugin(m, "bank", "balances")
+
+	tests := []struct {
+		name string
+		msg  *pb.PluginMessage
+		// delivered lists the plugins the message reaches.
+		delivered []*pluginProcess
+		err       string
+	}{
+		{
+			name:      "target",
+			msg:       &pb.PluginMessage{TargetPluginId: "economy", MessageId: "1"},
+			delivered: []*pluginProcess{economy},
+		},
+		{
+			name:      "channel",
+			msg:       &pb.PluginMessage{Channel: "balances", MessageId: "2"},
+			delivered: []*pluginProcess{economy, bank},
+		},
+		{
+			name: "unknown target",
+			msg:  &pb.PluginMessage{TargetPluginId: "auction", MessageId: "3"},
+			err:  "unknown target plugin: auction",
+		},
+		{
+			name: "channel without subscribers",
+			msg:  &pb.PluginMessage{Channel: "auctions", MessageId: "4", ExpectsReply: true},
+			err:  "no subscribers for channel: auctions",
+		},
+		{
+			name: "no target",
+			msg:  &pb.PluginMessage{MessageId: "5"},
+			err:  "message requires target_plugin_id or channel",
+		},
+		{
+			name: "request without message id",
+			msg:  &pb.PluginMessage{TargetPluginId: "economy", ExpectsReply: true},
+			err:  "request requires message_id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m.routePluginMessage(shop, tt.msg)
+			for _, p := range []*pluginProcess{economy, bank} {
+				want := 0
+				for _, d := range tt.delivered {
+					if d == p {
+						want = 1
+					}
+				}
+				msgs := received(p)
+				if len(msgs) != want {
+					t.Fatalf("%s received %d messages, want %d", p.id, len(msgs), want)
+				}
+				if want == 1 && msgs[0].SourcePluginId != "shop" {
+					t.Errorf("%s received source %q, want shop", p.id, msgs[0].SourcePluginId)
+				}
+			}
+			msgs := received(shop)
+			if tt.err == "" {
+				if len(msgs) != 0 {
+					t.Fatalf("sender received %v, want nothing", msgs)
+				}
+				return
+			}
+			if len(msgs) != 1 || msgs[0].GetError() != tt.err {
+				t.Fatalf("sender received %v, want error %q", msgs, tt.err)
+			}
+		})
+	}
+}
+
+// testReply is a reply sent by the plugin from to the request with the message ID replyTo.
+type testReply struct {
+	from    string
+	replyTo string
+}
+
+func TestRoutePluginReply(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *pb.PluginMessage
+		// replies are sent in order.
+		replies []testReply
+		// forwarded lists the plugins whose replies reach the requester.
+		forwarded []string
+		// rejected lists the plugins whose replies are rejected.
+		rejected []string
+	}{
+		{
+			name:      "target replies",
+			request:   &pb.PluginMessage{TargetPluginId: "economy", MessageId: "1", ExpectsReply: true},
+			replies:   []testReply{{"economy", "1"}},
+			forwarded: []string{"economy"},
+		},
+		{
+			name:      "other plugin replies",
+			request:   &pb.PluginMessage{TargetPluginId: "economy", MessageId: "2", ExpectsReply: true},
+			replies:   []testReply{{"bank", "2"}, {"economy", "2"}},
+			forwarded: []string{"economy"},
+			rejected:  []string{"bank"},
+		},
+		{
+			name:      "first channel subscriber wins",
+			request:   &pb.PluginMessage{Channel: "balances", MessageId: "3", ExpectsReply: true},
+			replies:   []testReply{{"bank", "3"}, {"economy", "3"}},
+			forwarded: []string{"bank"},
+			rejected:  []string{"economy"},
+		},
+		{
+			name:      "unknown request",
+			request:   &pb.PluginMessage{TargetPluginId: "economy", MessageId: "4", ExpectsReply: true},
+			replies:   []testReply{{"economy", "5"}, {"economy", "4"}},
+			forwarded: []string{"economy"},
+			rejected:  []string{"economy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			plugins := map[string]*pluginProcess{
+				"shop":    newTestPlugin(m, "shop"),
+				"economy": newTestPlugin(m, "economy", "balances"),
+				"bank":    newTestPlugin(m, "bank", "balances"),
+			}
+			m.routePluginMessage(plugins["shop"], tt.request)
+			for _, p := range plugins {
+				received(p)
+			}
+
+			var forwarded, rejected []string
+			for _, reply := range tt.replies {
+				from := plugins[reply.from]
+				m.routePluginMessage(from, &pb.PluginMessage{TargetPluginId: "shop", ReplyTo: reply.replyTo})
+				for _, msg := range received(plugins["shop"]) {
+					forwarded = append(forwarded, msg.SourcePluginId)
+				}
+				for _, msg := range received(from) {
+					if msg.GetError() != "" {
+						rejected = append(rejected, reply.from)
+					}
+				}
+			}
+			if !slices.Equal(forwarded, tt.forwarded) {
+				t.Errorf("forwarded replies from %v, want %v", forwarded, tt.forwarded)
+			}
+			if !slices.Equal(rejected, tt.rejected) {
+				t.Errorf("rejected replies from %v, want %v", rejected, tt.rejected)
+			}
+		})
+	}
+}
+
+func TestPluginRequestTimeout(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	shop := newTestPlugin(m, "shop")
+	economy := newTestPlugin(m, "economy")
+
+	m.routePluginMessage(shop, &pb.PluginMessage{TargetPluginId: "economy", MessageId: "1", ExpectsReply: true, TimeoutMs: 10})
+	received(economy)
+	select {
+	case msg := <-shop.sendCh:
+		if got := msg.GetPluginMessage(); got.GetError() != "request timed out" || got.ReplyTo != "1" {
+			t.Fatalf("received %v, want a timeout for request 1", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("request did not time out")
+	}
+
+	// A reply after the timeout no longer matches a request.
+	m.routePluginMessage(economy, &pb.PluginMessage{TargetPluginId: "shop", ReplyTo: "1"})
+	if msgs := received(shop); len(msgs) != 0 {
+		t.Fatalf("late reply forwarded: %v", msgs)
+	}
+	if msgs := received(economy); len(msgs) != 1 || msgs[0].GetError() == "" {
+		t.Fatalf("late reply not rejected: %v", msgs)
+	}
+}
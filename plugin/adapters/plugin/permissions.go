@@ -0,0 +1,222 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// actionFamilies lists every family actionFamily can return, for the capability audit.
+var actionFamilies = []string{
+	"chat.send",
+	"command.execute",
+	"player.inventory",
+	"player.kick",
+	"player.movement",
+	"player.state",
+	"player.teleport",
+	"player.transfer",
+	"player.visibility",
+	"ui.bossbar",
+	"ui.container",
+	"ui.form",
+	"ui.hud",
+	"ui.particle",
+	"ui.scoreboard",
+	"ui.sound",
+	"ui.title",
+	"world.effect",
+	"world.entity",
+	"world.mutate",
+	"world.query",
+}
+
+// actionFamily returns the permission family of an action, or "" for unknown actions.
+func actionFamily(action *pb.Action) string {
+	switch kind := action.Kind.(type) {
+	case *pb.Action_SendChat:
+		return "chat.send"
+	case *pb.Action_ExecuteCommand:
+		return "command.execute"
+	case *pb.Action_Kick:
+		return "player.kick"
+	case *pb.Action_PlayerTransfer:
+		return "player.transfer"
+	case *pb.Action_Teleport:
+		return "player.teleport"
+	case *pb.Action_GiveItem, *pb.Action_ClearInventory, *pb.Action_SetHeldItem, *pb.Action_PlayerSetArmour,
+		*pb.Action_PlayerSetHeldSlot, *pb.Action_PlayerDropItem, *pb.Action_PlayerSetItemCooldown:
+		return "player.inventory"
+	case *pb.Action_InventoryQuery:
+		return inventoryFamily(kind.InventoryQuery.GetTarget(), "world.query")
+	case *pb.Action_InventorySetSlot:
+		return inventoryFamily(kind.InventorySetSlot.GetTarget(), "world.mutate")
+	case *pb.Action_InventoryRemoveItem:
+		return inventoryFamily(kind.InventoryRemoveItem.GetTarget(), "world.mutate")
+	case *pb.Action_InventorySwapSlots:
+		return inventoryFamily(kind.InventorySwapSlots.GetTarget(), "world.mutate")
+	case *pb.Action_InventoryClearRange:
+		return inventoryFamily(kind.InventoryClearRange.GetTarget(), "world.mutate")
+	case *pb.Action_SetVelocity, *pb.Action_PlayerKnockBack,
+		*pb.Action_PlayerStartSprinting, *pb.Action_PlayerStopSprinting,
+		*pb.Action_PlayerStartSneaking, *pb.Action_PlayerStopSneaking,
+		*pb.Action_PlayerStartSwimming, *pb.Action_PlayerStopSwimming,
+		*pb.Action_PlayerStartCrawling, *pb.Action_PlayerStopCrawling,
+		*pb.Action_PlayerStartGliding, *pb.Action_PlayerStopGliding,
+		*pb.Action_PlayerStartFlying, *pb.Action_PlayerStopFlying,
+		*pb.Action_PlayerSetImmobile, *pb.Action_PlayerSetMobile,
+		*pb.Action_PlayerSetSpeed, *pb.Action_PlayerSetFlightSpeed, *pb.Action_PlayerSetVerticalFlightSpeed,
+		*pb.Action_PlayerSwingArm, *pb.Action_PlayerPunchAir:
+		return "player.movement"
+	case *pb.Action_SetGameMode, *pb.Action_SetHealth, *pb.Action_SetFood, *pb.Action_SetExperience,
+		*pb.Action_AddEffect, *pb.Action_RemoveEffect, *pb.Action_PlayerSetAbsorption,
+		*pb.Action_PlayerSetOnFire, *pb.Action_PlayerExtinguish, *pb.Action_PlayerSetScale,
+		*pb.Action_PlayerEnableInstantRespawn, *pb.Action_PlayerDisableInstantRespawn, *pb.Action_PlayerRespawn,
+		*pb.Action_PlayerSetNameTag, *pb.Action_PlayerSetScoreTag:
+		return "player.state"
+	case *pb.Action_PlayerSetInvisible, *pb.Action_PlayerSetVisible,
+		*pb.Action_PlayerHidePlayer, *pb.Action_PlayerShowPlayer:
+		return "player.visibility"
+	case *pb.Action_SendTitle, *pb.Action_SendPopup, *pb.Action_SendTip,
+		*pb.Action_PlayerSendToast, *pb.Action_PlayerSendJukeboxPopup:
+		return "ui.title"
+	case *pb.Action_PlayerSendMenuForm, *pb.Action_PlayerSendModalForm, *pb.Action_PlayerSendCustomForm,
+		*pb.Action_PlayerSendDialogue, *pb.Action_PlayerCloseForm, *pb.Action_PlayerCloseDialogue,
+		*pb.Action_PlayerOpenSign:
+		return "ui.form"
+	case *pb.Action_PlayerOpenBlockContainer, *pb.Action_PlayerOpenVirtualInventory,
+		*pb.Action_PlayerSetVirtualInventorySlots, *pb.Action_PlayerCloseVirtualInventory:
+		return "ui.container"
+	case *pb.Action_PlayerSendScoreboard, *pb.Action_PlayerRemoveScoreboard:
+		return "ui.scoreboard"
+	case *pb.Action_PlayerSendBossBar, *pb.Action_PlayerRemoveBossBar:
+		return "ui.bossbar"
+	case *pb.Action_PlayerShowHudElement, *pb.Action_PlayerHideHudElement,
+		*pb.Action_PlayerShowCoordinates, *pb.Action_PlayerHideCoordinates:
+		return "ui.hud"
+	case *pb.Action_PlaySound:
+		return "ui.sound"
+	case *pb.Action_PlayerShowParticle, *pb.Action_PlayerRemoveAllDebugShapes:
+		return "ui.particle"
+	case *pb.Action_WorldPlaySound, *pb.Action_WorldAddParticle:
+		return "world.effect"
+	case *pb.Action_WorldSpawnEntity, *pb.Action_EntityRemove, *pb.Action_EntityTeleport,
+		*pb.Action_EntitySetVelocity, *pb.Action_EntitySetNameTag:
+		return "world.entity"
+	case *pb.Action_WorldSetDefaultGameMode, *pb.Action_WorldSetDifficulty, *pb.Action_WorldSetTickRange,
+		*pb.Action_WorldSetBlock, *pb.Action_WorldSetTime, *pb.Action_WorldStopTime, *pb.Action_WorldStartTime,
+		*pb.Action_WorldSetSpawn, *pb.Action_WorldSetBiome, *pb.Action_WorldSetLiquid,
+		*pb.Action_WorldScheduleBlockUpdate, *pb.Action_WorldBuildStructure,
+		*pb.Action_PlayerEditSign, *pb.Action_PlayerTurnLecternPage:
+		return "world.mutate"
+	case *pb.Action_WorldQueryEntities, *pb.Action_WorldQueryPlayers, *pb.Action_WorldQueryEntitiesWithin,
+		*pb.Action_WorldQueryDefaultGameMode, *pb.Action_WorldQueryPlayerSpawn, *pb.Action_WorldQueryBlock,
+		*pb.Action_WorldQueryBiome, *pb.Action_WorldQueryLight, *pb.Action_WorldQuerySkyLight,
+		*pb.Action_WorldQueryTemperature, *pb.Action_WorldQueryHighestBlock, *pb.Action_WorldQueryRainingAt,
+		*pb.Action_WorldQuerySnowingAt, *pb.Action_WorldQueryThunderingAt, *pb.Action_WorldQueryLiquid:
+		return "world.query"
+	}
+	return ""
+}
+
+// inventoryFamily returns the family of an inventory action: player.inventory for the inventories
+// of a player and the container family for container blocks.
+func inventoryFamily(target *pb.InventoryTarget, container string) string {
+	if target.GetType() == pb.InventoryType_INVENTORY_TYPE_CONTAINER {
+		return container
+	}
+	return "player.inventory"
+}
+
+// permissions is the compiled allow/deny list of a plugin.
+type permissions struct {
+	allow []string
+	deny  []string
+}
+
+func newPermissions(cfg config.PermissionsConfig) permissions {
+	return permissions{allow: cfg.Allow, deny: cfg.Deny}
+}
+
+// unrestricted reports whether the plugin may run every action.
+func (p permissions) unrestricted() bool {
+	return len(p.allow) == 0 && len(p.deny) == 0
+}
+
+// check reports whether actions of family may run, and why not if they may not.
+func (p permissions) check(family string) (bool, string) {
+	if family == "" {
+		family = "unknown"
+	}
+	for _, pattern := range p.deny {
+		if permissionMatches(pattern, family) {
+			return false, fmt.Sprintf("permission denied: %s is denied by %q", family, pattern)
+		}
+	}
+	if len(p.allow) == 0 {
+		return true, ""
+	}
+	for _, pattern := range p.allow {
+		if permissionMatches(pattern, family) {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("permission denied: %s is not in the allow list", family)
+}
+
+// capabilities splits the known action families into those the plugin may and may not run.
+func (p permissions) capabilities() (allowed, denied []string) {
+	for _, family := range actionFamilies {
+		if ok, _ := p.check(family); ok {
+			allowed = append(allowed, family)
+		} else {
+			denied = append(denied, family)
+		}
+	}
+	return allowed, denied
+}
+
+// unknownPatterns returns the configured entries that match no known action family.
+func (p permissions) unknownPatterns() []string {
+	var unknown []string
+	for _, pattern := range append(append([]string(nil), p.allow...), p.deny...) {
+		matched := false
+		for _, family := range actionFamilies {
+			if permissionMatches(pattern, family) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unknown = append(unknown, pattern)
+		}
+	}
+	return unknown
+}
+
+// permissionMatches reports whether a configured entry matches family: "*" matches everything,
+// "group.*" matches every family in the group and anything else must match exactly.
+func permissionMatches(pattern, family string) bool {
+	if pattern == "*" || pattern == family {
+		return true
+	}
+	if group, ok := strings.CutSuffix(pattern, ".*"); ok {
+		return strings.HasPrefix(family, group+".")
+	}
+	return false
+}
+
+// auditPermissions logs the effective capabilities of a plugin.
+func (p *pluginProcess) auditPermissions() {
+	if unknown := p.permissions.unknownPatterns(); len(unknown) > 0 {
+		p.log.Warn("unknown permissions in config", "entries", unknown)
+	}
+	if p.permissions.unrestricted() {
+		p.log.Info("plugin capabilities", "allowed", "*")
+		return
+	}
+	allowed, denied := p.permissions.capabilities()
+	p.log.Info("plugin capabilities", "allowed", allowed, "denied", denied)
+}
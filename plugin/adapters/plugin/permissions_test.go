@@ -0,0 +1,189 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// actionResults returns the action results queued for p.
+func actionResults(p *pluginProcess) []*pb.ActionResult {
+	var results []*pb.ActionResult
+	for {
+		select {
+		case msg := <-p.sendCh:
+			if res := msg.GetActionResult(); res != nil {
+				results = append(results, res)
+			}
+		default:
+			return results
+		}
+	}
+}
+
+func TestPermissionMatches(t *testing.T) {
+	tests := []struct {
+		pattern, family string
+		want            bool
+	}{
+		{"*", "player.kick", true},
+		{"*", "unknown", true},
+		{"player.kick", "player.kick", true},
+		{"player.kick", "player.kickall", false},
+		{"player.*", "player.kick", true},
+		{"player.*", "player.inventory", true},
+		{"player.*", "player", false},
+		{"player.*", "ui.title", false},
+		// A group must match up to the dot.
+		{"play.*", "player.kick", false},
+		{"player", "player.kick", false},
+		{"", "player.kick", false},
+	}
+	for _, tt := range tests {
+		if got := permissionMatches(tt.pattern, tt.family); got != tt.want {
+			t.Errorf("permissionMatches(%q, %q) = %v, want %v", tt.pattern, tt.family, got, tt.want)
+		}
+	}
+}
+
+func TestPermissionsCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.PermissionsConfig
+		family string
+		want   bool
+		reason string
+	}{
+		{name: "unrestricted", family: "world.mutate", want: true},
+		{name: "unrestricted unknown family", family: "", want: true},
+		{name: "allowed", cfg: config.PermissionsConfig{Allow: []string{"ui.*", "chat.send"}}, family: "chat.send", want: true},
+		{name: "allowed by group", cfg: config.PermissionsConfig{Allow: []string{"ui.*", "chat.send"}}, family: "ui.form", want: true},
+		{
+			name:   "not allowed",
+			cfg:    config.PermissionsConfig{Allow: []string{"ui.*", "chat.send"}},
+			family: "player.kick",
+			reason: "permission denied: player.kick is not in the allow list",
+		},
+		{
+			name:   "denied",
+			cfg:    config.PermissionsConfig{Deny: []string{"world.*"}},
+			family: "world.mutate",
+			reason: `permission denied: world.mutate is denied by "world.*"`,
+		},
+		{name: "not denied", cfg: config.PermissionsConfig{Deny: []string{"world.*"}}, family: "ui.title", want: true},
+		{
+			name:   "deny wins over allow",
+			cfg:    config.PermissionsConfig{Allow: []string{"*"}, Deny: []string{"player.kick"}},
+			family: "player.kick",
+			reason: `permission denied: player.kick is denied by "player.kick"`,
+		},
+		{
+			name:   "deny wins over exact allow",
+			cfg:    config.PermissionsConfig{Allow: []string{"player.kick"}, Deny: []string{"player.*"}},
+			family: "player.kick",
+			reason: `permission denied: player.kick is denied by "player.*"`,
+		},
+		{
+			name:   "unknown family not allowed",
+			cfg:    config.PermissionsConfig{Allow: []string{"player.*"}},
+			family: "",
+			reason: "permission denied: unknown is not in the allow list",
+		},
+		{name: "unknown family allowed by wildcard", cfg: config.PermissionsConfig{Allow: []string{"*"}}, family: "", want: true},
+		{
+			name:   "unknown family denied by wildcard",
+			cfg:    config.PermissionsConfig{Deny: []string{"*"}},
+			family: "",
+			reason: `permission denied: unknown is denied by "*"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, reason := newPermissions(tt.cfg).check(tt.family)
+			if ok != tt.want || reason != tt.reason {
+				t.Errorf("check(%q) = %v, %q, want %v, %q", tt.family, ok, reason, tt.want, tt.reason)
+			}
+		})
+	}
+}
+
+func TestActionFamily(t *testing.T) {
+	container := &pb.InventoryTarget{Type: pb.InventoryType_INVENTORY_TYPE_CONTAINER}
+	tests := []struct {
+		name   string
+		action *pb.Action
+		want   string
+	}{
+		{"chat", &pb.Action{Kind: &pb.Action_SendChat{SendChat: &pb.SendChatAction{}}}, "chat.send"},
+		{"kick", &pb.Action{Kind: &pb.Action_Kick{Kick: &pb.KickAction{}}}, "player.kick"},
+		{"player inventory query", &pb.Action{Kind: &pb.Action_InventoryQuery{InventoryQuery: &pb.InventoryQueryAction{}}}, "player.inventory"},
+		{"container query", &pb.Action{Kind: &pb.Action_InventoryQuery{InventoryQuery: &pb.InventoryQueryAction{Target: container}}}, "world.query"},
+		{"container set slot", &pb.Action{Kind: &pb.Action_InventorySetSlot{InventorySetSlot: &pb.InventorySetSlotAction{Target: container}}}, "world.mutate"},
+		{"entity", &pb.Action{Kind: &pb.Action_EntityRemove{EntityRemove: &pb.EntityRemoveAction{}}}, "world.entity"},
+		{"unknown", &pb.Action{}, ""},
+	}
+	for _, tt := range tests {
+		if got := actionFamily(tt.action); got != tt.want {
+			t.Errorf("%s: actionFamily = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPermissionsCapabilities(t *testing.T) {
+	p := newPermissions(config.PermissionsConfig{Allow: []string{"ui.*", "chat.send", "nope.*"}, Deny: []string{"ui.hud", "typo"}})
+	allowed, denied := p.capabilities()
+	if !slices.Contains(allowed, "ui.title") || !slices.Contains(allowed, "chat.send") {
+		t.Errorf("allowed = %v, want the ui families and chat.send", allowed)
+	}
+	if slices.Contains(allowed, "ui.hud") || !slices.Contains(denied, "ui.hud") || !slices.Contains(denied, "player.kick") {
+		t.Errorf("denied = %v, want ui.hud and the families outside the allow list", denied)
+	}
+	if len(allowed)+len(denied) != len(actionFamilies) {
+		t.Errorf("capabilities split %d families, want %d", len(allowed)+len(denied), len(actionFamilies))
+	}
+	if got := p.unknownPatterns(); !slices.Equal(got, []string{"nope.*", "typo"}) {
+		t.Errorf("unknownPatterns = %v, want the entries matching no family", got)
+	}
+}
+
+func TestApplyActionsPermissionDenied(t *testing.T) {
+	id := func(s string) *string { return &s }
+	kick := &pb.Action{CorrelationId: id("kick"), Kind: &pb.Action_Kick{Kick: &pb.KickAction{PlayerUuid: "not-a-uuid"}}}
+	chat := &pb.Action{CorrelationId: id("chat"), Kind: &pb.Action_SendChat{SendChat: &pb.SendChatAction{TargetUuid: "not-a-uuid"}}}
+	unknown := &pb.Action{CorrelationId: id("unknown")}
+
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	p := newTestPlugin(m, "restricted")
+	p.permissions = newPermissions(config.PermissionsConfig{Allow: []string{"chat.*", "player.*"}, Deny: []string{"player.kick"}})
+
+	m.applyActions(p, &pb.ActionBatch{Actions: []*pb.Action{kick, chat, unknown}})
+	want := map[string]string{
+		"kick":    `permission denied: player.kick is denied by "player.kick"`,
+		"chat":    "invalid player_uuid",
+		"unknown": "permission denied: unknown is not in the allow list",
+	}
+	results := actionResults(p)
+	if len(results) != len(want) {
+		t.Fatalf("received %d results, want %d", len(results), len(want))
+	}
+	for _, res := range results {
+		if res.GetStatus().GetOk() || res.GetStatus().GetError() != want[res.CorrelationId] {
+			t.Errorf("result of %s = %v, want error %q", res.CorrelationId, res.GetStatus(), want[res.CorrelationId])
+		}
+	}
+
+	// A denied action rejects an atomic batch as a whole.
+	m.applyActions(p, &pb.ActionBatch{Atomic: true, CorrelationId: id("batch"), Actions: []*pb.Action{chat, kick}})
+	results = actionResults(p)
+	if len(results) != 1 || results[0].CorrelationId != "batch" || results[0].GetStatus().GetOk() {
+		t.Fatalf("received %v, want the failed batch", results)
+	}
+	batch := results[0].GetBatch().GetResults()
+	if len(batch) != 2 || batch[1].GetStatus().GetError() != want["kick"] {
+		t.Errorf("batch results = %v, want kick denied", batch)
+	}
+}
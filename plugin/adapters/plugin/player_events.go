@@ -153,6 +153,7 @@ func (m *Manager) EmitBlockBreak(ctx *player.Context, p *player.Player, pos cube
 				Name:       p.Name(),
 				World:      worldDim,
 				Position:   protoBlockPos(pos),
+				Block:      protoBlockState(p.Tx().Block(pos)),
 			},
 		},
 	})
@@ -400,7 +401,7 @@ func (m *Manager) EmitPlayerRespawn(p *player.Player, pos *mgl64.Vec3, w **world
 			},
 		},
 	}
-	results := m.dispatchEvent(envelope, true)
+	results := m.emitCancellable(nil, envelope)
 	applyMutations(results,
 		func(r *pb.EventResult) *pb.PlayerRespawnMutation { return r.GetPlayerRespawn() },
 		func(mut *pb.PlayerRespawnMutation) {
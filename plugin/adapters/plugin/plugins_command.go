@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"strings"
+
+	"github.com/df-mc/dragonfly/server/cmd"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+)
+
+// registerPluginsCommand registers the operator command used to manage plugins at runtime:
+//
+//	plugins list
+//	plugins load <id>
+//	plugins unload <id>
+//	plugins reload <id>
+//
+// Commands run inside a world transaction, so the work happens in the background: cloning a
+// plugin or waiting out its shutdown grace period would otherwise stall the world. Failures
+// are logged.
+func (m *Manager) registerPluginsCommand() {
+	cmd.Register(cmd.New("plugins", "Manage external plugins", nil,
+		pluginsListCommand{mgr: m},
+		pluginsLoadCommand{mgr: m},
+		pluginsUnloadCommand{mgr: m},
+		pluginsReloadCommand{mgr: m},
+	))
+}
+
+// operatorOnly restricts the plugins command to the server console. Players cannot run it.
+type operatorOnly struct{}
+
+func (operatorOnly) Allow(src cmd.Source) bool {
+	_, isPlayer := src.(*player.Player)
+	return !isPlayer
+}
+
+type pluginsListCommand struct {
+	operatorOnly
+	mgr  *Manager
+	List cmd.SubCommand `cmd:"list"`
+}
+
+func (c pluginsListCommand) Run(_ cmd.Source, output *cmd.Output, _ *world.Tx) {
+	ids := c.mgr.PluginIDs()
+	if len(ids) == 0 {
+		output.Print("No plugins loaded.")
+		return
+	}
+	output.Printf("Plugins (%d): %s", len(ids), strings.Join(ids, ", "))
+	for _, id := range ids {
+		if c.mgr.crashLooped(id) {
+			output.Printf("%s stopped restarting after crash looping. Use plugins reload %s to start it again.", id, id)
+		}
+		if events, actions := c.mgr.droppedCounts(id); events > 0 || actions > 0 {
+			output.Printf("%s dropped %d events and %d actions.", id, events, actions)
+		}
+	}
+}
+
+type pluginsLoadCommand struct {
+	operatorOnly
+	mgr  *Manager
+	Load cmd.SubCommand `cmd:"load"`
+	ID   string         `cmd:"id"`
+}
+
+func (c pluginsLoadCommand) Run(_ cmd.Source, output *cmd.Output, _ *world.Tx) {
+	if c.mgr.hasPlugin(c.ID) {
+		output.Errorf("Plugin %q is already loaded.", c.ID)
+		return
+	}
+	go func() {
+		if err := c.mgr.LoadPluginByID(c.ID); err != nil {
+			c.mgr.log.Error("load plugin", "plugin", c.ID, "error", err)
+		}
+	}()
+	output.Printf("Loading plugin %q.", c.ID)
+}
+
+type pluginsUnloadCommand struct {
+	operatorOnly
+	mgr    *Manager
+	Unload cmd.SubCommand `cmd:"unload"`
+	ID     string         `cmd:"id"`
+}
+
+func (c pluginsUnloadCommand) Run(_ cmd.Source, output *cmd.Output, _ *world.Tx) {
+	if !c.mgr.hasPlugin(c.ID) {
+		output.Errorf("Plugin %q is not loaded.", c.ID)
+		return
+	}
+	go func() {
+		if err := c.mgr.UnloadPlugin(c.ID); err != nil {
+			c.mgr.log.Error("unload plugin", "plugin", c.ID, "error", err)
+		}
+	}()
+	output.Printf("Unloading plugin %q.", c.ID)
+}
+
+type pluginsReloadCommand struct {
+	operatorOnly
+	mgr    *Manager
+	Reload cmd.SubCommand `cmd:"reload"`
+	ID     string         `cmd:"id"`
+}
+
+func (c pluginsReloadCommand) Run(_ cmd.Source, output *cmd.Output, _ *world.Tx) {
+	if !c.mgr.hasPlugin(c.ID) {
+		output.Errorf("Plugin %q is not loaded.", c.ID)
+		return
+	}
+	go func() {
+		if err := c.mgr.ReloadPlugin(c.ID); err != nil {
+			c.mgr.log.Error("reload plugin", "plugin", c.ID, "error", err)
+		}
+	}()
+	output.Printf("Reloading plugin %q.", c.ID)
+}
+
+func (m *Manager) hasPlugin(id string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.plugins[id]
+	return ok
+}
@@ -31,13 +31,22 @@ const (
 	shutdownTimeout   = 5 * time.Second
 )
 
+var errAlreadyConnected = errors.New("plugin already connected")
+
 type pluginProcess struct {
 	id      string
 	cfg     config.PluginConfig
+	token   string
 	manager *Manager
 	log     *slog.Logger
 
-	cmd      *exec.Cmd
+	cmdMu         sync.Mutex
+	cmd           *exec.Cmd
+	exited        chan struct{}
+	serverAddress string
+	supervisor    *supervisor
+	failed        atomic.Bool
+
 	stream   *grpc.GrpcStream
 	streamMu sync.RWMutex
 
@@ -45,22 +54,47 @@ type pluginProcess struct {
 	done   chan struct{}
 	wg     sync.WaitGroup
 
-	// unbounded actions queue and a 1-slot notify channel.
+	// bounded actions queue, a 1-slot notify channel for the worker and a 1-slot channel
+	// signalled when the worker frees a slot.
 	actionsMu     sync.Mutex
 	actionsQueue  []*pb.ActionBatch
 	actionsNotify chan struct{}
-
-	subscriptions sync.Map
+	actionsFreed  chan struct{}
+	// actionsPending counts batches that were queued but not applied yet.
+	actionsPending atomic.Int64
+	// atomic collects the operations and results of the atomic batch the worker is applying.
+	atomic *atomicBatch
+
+	limits         queueLimits
+	droppedEvents  atomic.Uint64
+	droppedActions atomic.Uint64
+	// missedEvents counts events dropped since the last EventsDropped notice.
+	missedEvents atomic.Uint64
+
+	subscriptions atomic.Pointer[map[pb.EventType]*eventSubscription]
+	channels      atomic.Pointer[map[string]struct{}]
 	connected     atomic.Bool
 	ready         atomic.Bool
+	// moves collects PLAYER_MOVE events for an observe-only subscription until the next tick.
+	moves moveBatch
 
 	helloMu sync.RWMutex
 	hello   *pb.PluginHello
 
-	closed atomic.Bool
+	closed       atomic.Bool
+	shuttingDown atomic.Bool
+	// reloading is set while the watcher restarts the plugin command in place.
+	reloading atomic.Bool
+
+	shutdownAckMu sync.Mutex
+	shutdownAck   chan struct{}
 
 	pendingMu sync.Mutex
 	pending   map[string]chan *pb.EventResult
+
+	eventTimeouts map[pb.EventType]time.Duration
+	breaker       *circuitBreaker
+	permissions   permissions
 }
 
 func newPluginProcess(m *Manager, cfg config.PluginConfig) *pluginProcess {
@@ -68,19 +102,33 @@ func newPluginProcess(m *Manager, cfg config.PluginConfig) *pluginProcess {
 	if cfg.Name != "" {
 		logger = logger.With("name", cfg.Name)
 	}
+	eventTimeouts, unknown := parseEventTimeouts(cfg.EventTimeoutsMs)
+	if len(unknown) > 0 {
+		logger.Warn("ignoring invalid event timeouts", "events", unknown)
+	}
+	limits := newQueueLimits(cfg.Queue)
 	return &pluginProcess{
 		id:            cfg.ID,
 		cfg:           cfg,
+		token:         pluginToken(cfg),
 		manager:       m,
 		log:           logger,
-		sendCh:        make(chan *pb.HostToPlugin, sendChannelBuffer),
+		sendCh:        make(chan *pb.HostToPlugin, limits.eventSize),
 		done:          make(chan struct{}),
 		pending:       make(map[string]chan *pb.EventResult),
 		actionsNotify: make(chan struct{}, 1),
+		actionsFreed:  make(chan struct{}, 1),
+		limits:        limits,
+		shutdownAck:   make(chan struct{}),
+		eventTimeouts: eventTimeouts,
+		breaker:       newCircuitBreaker(cfg.CircuitBreaker),
+		permissions:   newPermissions(cfg.Permissions),
+		supervisor:    newSupervisor(cfg.Restart),
 	}
 }
 
 func (p *pluginProcess) start(ctx context.Context, serverAddress string) {
+	p.serverAddress = serverAddress
 	if p.cfg.Command != "" {
 		if err := p.launchProcess(ctx, serverAddress); err != nil {
 			p.log.Error("launch plugin", "error", err)
@@ -89,20 +137,27 @@ func (p *pluginProcess) start(ctx context.Context, serverAddress string) {
 	}
 	p.wg.Add(1)
 	go p.actionsWorker()
+	if p.cfg.Watch.Enabled && p.cfg.Command != "" && p.cfg.WorkDir.Path != "" {
+		p.wg.Add(1)
+		go p.watchWorkDir(ctx)
+	}
 }
 
 // attachStream attaches an incoming stream to this plugin process
 func (p *pluginProcess) attachStream(stream *grpc.GrpcStream) error {
 	p.streamMu.Lock()
+	if p.connected.Load() {
+		p.streamMu.Unlock()
+		return errAlreadyConnected
+	}
 	// Allow replacing a stale/closed stream to support plugin hot-reload reconnections.
 	if p.stream != nil {
 		_ = p.stream.Close()
 		p.stream = nil
 	}
 	p.stream = stream
-	p.streamMu.Unlock()
-
 	p.connected.Store(true)
+	p.streamMu.Unlock()
 
 	if err := p.sendHello(); err != nil {
 		p.log.Error("send hello", "error", err)
@@ -111,8 +166,8 @@ func (p *pluginProcess) attachStream(stream *grpc.GrpcStream) error {
 	}
 
 	p.wg.Add(2)
-	go p.sendLoop()
-	go p.recvLoop()
+	go p.sendLoop(stream)
+	go p.recvLoop(stream)
 	return nil
 }
 
@@ -129,6 +184,17 @@ func (p *pluginProcess) clearStream() {
 	p.connected.Store(false)
 }
 
+// releaseStream clears the stream if it is still the current one. The send and receive loops of
+// a stream use it on errors, so that they never clear a stream the plugin reconnected with.
+func (p *pluginProcess) releaseStream(stream *grpc.GrpcStream) {
+	p.streamMu.Lock()
+	current := p.stream == stream
+	p.streamMu.Unlock()
+	if current {
+		p.clearStream()
+	}
+}
+
 func (p *pluginProcess) launchProcess(ctx context.Context, serverAddress string) error {
 	if ctx.Err() != nil {
 		return ctx.Err()
@@ -140,6 +206,7 @@ func (p *pluginProcess) launchProcess(ctx context.Context, serverAddress string)
 	}
 	env := os.Environ()
 	env = append(env, fmt.Sprintf("DF_PLUGIN_ID=%s", p.id))
+	env = append(env, fmt.Sprintf("DF_PLUGIN_TOKEN=%s", p.token))
 	// Normalize Unix socket address for plugin clients: bare paths -> unix:/path
 	passAddress := serverAddress
 	if strings.HasPrefix(serverAddress, "/") {
@@ -169,7 +236,12 @@ func (p *pluginProcess) launchProcess(ctx context.Context, serverAddress string)
 	if err := cmd.Start(); err != nil {
 		return err
 	}
+	started := time.Now()
+	exited := make(chan struct{})
+	p.cmdMu.Lock()
 	p.cmd = cmd
+	p.exited = exited
+	p.cmdMu.Unlock()
 
 	p.wg.Add(2)
 	go p.consumeOutput(stdout)
@@ -178,9 +250,17 @@ func (p *pluginProcess) launchProcess(ctx context.Context, serverAddress string)
 	p.wg.Add(1)
 	go func() {
 		defer p.wg.Done()
-		if err := cmd.Wait(); err != nil && !p.closed.Load() {
+		err := cmd.Wait()
+		// Read the flags before signalling the exit, as a reload clears its flag right after.
+		stopping := p.closed.Load() || p.shuttingDown.Load() || p.reloading.Load()
+		close(exited)
+		if stopping {
+			return
+		}
+		if err != nil {
 			p.log.Warn("process exited", "error", err)
 		}
+		p.superviseExit(ctx, err, time.Since(started))
 	}()
 	return nil
 }
@@ -234,17 +314,28 @@ func (p *pluginProcess) sendHello() error {
 	return p.stream.Send(payload)
 }
 
-// enqueueActions appends a batch to the unbounded queue and signals the worker.
+// enqueueActions appends a batch to the bounded queue and signals the worker. A full queue is
+// handled by the plugin's overflow policy.
 func (p *pluginProcess) enqueueActions(batch *pb.ActionBatch) {
 	if batch == nil {
 		return
 	}
 	p.actionsMu.Lock()
-	p.actionsQueue = append(p.actionsQueue, batch)
-	queueLen := len(p.actionsQueue)
+	dropped, ok := p.makeRoomForActions()
+	if ok {
+		p.actionsPending.Add(1)
+		p.actionsQueue = append(p.actionsQueue, batch)
+	}
 	p.actionsMu.Unlock()
-	if queueLen%1024 == 0 && queueLen >= 1024 {
-		p.log.Warn("actions queue growing", "size", queueLen)
+	if dropped != nil {
+		p.rejectActions(dropped)
+	}
+	if !ok {
+		p.rejectActions(batch)
+		if p.limits.overflow == config.OverflowDisconnect {
+			p.disconnectOverflow("action queue full")
+		}
+		return
 	}
 	select {
 	case p.actionsNotify <- struct{}{}: // Notify the worker to process actions.
@@ -252,6 +343,16 @@ func (p *pluginProcess) enqueueActions(batch *pb.ActionBatch) {
 	}
 }
 
+// discardActions drops every queued action batch and returns how many were dropped.
+func (p *pluginProcess) discardActions() int {
+	p.actionsMu.Lock()
+	n := len(p.actionsQueue)
+	p.actionsQueue = nil
+	p.actionsPending.Add(int64(-n))
+	p.actionsMu.Unlock()
+	return n
+}
+
 func (p *pluginProcess) actionsWorker() {
 	defer p.wg.Done()
 	for {
@@ -268,50 +369,72 @@ func (p *pluginProcess) actionsWorker() {
 				batch := p.actionsQueue[0]
 				p.actionsQueue = p.actionsQueue[1:]
 				p.actionsMu.Unlock()
+				select {
+				case p.actionsFreed <- struct{}{}:
+				default:
+				}
 				if batch != nil {
 					p.manager.applyActions(p, batch)
 				}
+				p.actionsPending.Add(-1)
 			}
 		}
 	}
 }
 
-func (p *pluginProcess) sendLoop() {
+func (p *pluginProcess) sendLoop(stream *grpc.GrpcStream) {
 	defer p.wg.Done()
 	for {
 		select {
 		case <-p.done:
 			return
+		case <-stream.Done():
+			return
 		case msg := <-p.sendCh:
 			if msg == nil {
 				continue
 			}
-			data, err := proto.Marshal(msg)
-			if err != nil {
-				p.log.Error("marshal message", "error", err)
-				continue
+			msg, next := p.batchEvents(msg)
+			if !p.send(stream, msg) {
+				return
 			}
-			if err := p.stream.Send(data); err != nil {
-				// Treat expected shutdown conditions as non-errors.
-				if st, ok := status.FromError(err); ok && (st.Code() == codes.Canceled || st.Code() == codes.Unavailable) {
-					p.log.Info("connection closed", "reason", st.Code().String())
-				} else if errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) {
-					p.log.Info("connection closed", "reason", "canceled")
-				} else {
-					p.log.Error("send message", "error", err)
-				}
-				// Do not kill the process on transient stream errors; allow reconnection.
-				p.clearStream()
+			if next != nil && !p.send(stream, next) {
 				return
 			}
+			if !p.sendDroppedNotice(stream) {
+				return
+			}
+		}
+	}
+}
+
+// send marshals and writes one message to the stream. It returns false once the stream is gone.
+func (p *pluginProcess) send(stream *grpc.GrpcStream, msg *pb.HostToPlugin) bool {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		p.log.Error("marshal message", "error", err)
+		return true
+	}
+	if err := stream.Send(data); err != nil {
+		// Treat expected shutdown conditions as non-errors.
+		if st, ok := status.FromError(err); ok && (st.Code() == codes.Canceled || st.Code() == codes.Unavailable) {
+			p.log.Info("connection closed", "reason", st.Code().String())
+		} else if errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) {
+			p.log.Info("connection closed", "reason", "canceled")
+		} else {
+			p.log.Error("send message", "error", err)
 		}
+		// Do not kill the process on transient stream errors; allow reconnection.
+		p.releaseStream(stream)
+		return false
 	}
+	return true
 }
 
-func (p *pluginProcess) recvLoop() {
+func (p *pluginProcess) recvLoop(stream *grpc.GrpcStream) {
 	defer p.wg.Done()
 	for {
-		data, err := p.stream.Recv()
+		data, err := stream.Recv()
 		if err != nil {
 			if st, ok := status.FromError(err); ok {
 				switch st.Code() {
@@ -326,7 +449,7 @@ func (p *pluginProcess) recvLoop() {
 				p.log.Error("receive message", "error", err)
 			}
 			// Do not kill the process on transient stream errors; allow reconnection.
-			p.clearStream()
+			p.releaseStream(stream)
 			return
 		}
 		msg := &pb.PluginToHost{}
@@ -339,33 +462,73 @@ func (p *pluginProcess) recvLoop() {
 }
 
 func (p *pluginProcess) HasSubscription(event pb.EventType) bool {
-	if !p.ready.Load() {
-		return false
+	_, ok := p.subscription(event)
+	return ok
+}
+
+// subscription returns the options the plugin subscribed to event with, falling back to an
+// EVENT_TYPE_ALL subscription.
+func (p *pluginProcess) subscription(event pb.EventType) (*eventSubscription, bool) {
+	if !p.ready.Load() || event == pb.EventType_EVENT_TYPE_UNSPECIFIED {
+		return nil, false
 	}
-	if _, ok := p.subscriptions.Load(pb.EventType_EVENT_TYPE_ALL); ok {
-		return true
+	subs := p.subscriptions.Load()
+	if subs == nil {
+		return nil, false
 	}
-	if event == pb.EventType_EVENT_TYPE_UNSPECIFIED {
-		return false
+	if sub, ok := (*subs)[event]; ok {
+		return sub, true
 	}
-	_, ok := p.subscriptions.Load(event)
-	return ok
+	if sub, ok := (*subs)[pb.EventType_EVENT_TYPE_ALL]; ok {
+		return sub, true
+	}
+	return nil, false
 }
 
-func (p *pluginProcess) updateSubscriptions(events []pb.EventType) {
-	p.subscriptions.Range(func(key, value any) bool {
-		p.subscriptions.Delete(key)
-		return true
-	})
+// updateSubscriptions replaces the plugin's subscriptions in one step, so events never see a
+// mix of the old and the new set.
+func (p *pluginProcess) updateSubscriptions(events []pb.EventType, options []*pb.EventSubscription) {
+	subs := make(map[pb.EventType]*eventSubscription, len(events)+len(options))
 	for _, evt := range events {
 		if evt == pb.EventType_EVENT_TYPE_UNSPECIFIED {
 			continue
 		}
-		p.subscriptions.Store(evt, struct{}{})
+		subs[evt] = &eventSubscription{}
+	}
+	for _, opt := range options {
+		if opt == nil || opt.Event == pb.EventType_EVENT_TYPE_UNSPECIFIED {
+			continue
+		}
+		subs[opt.Event] = newEventSubscription(opt)
 	}
+	p.subscriptions.Store(&subs)
 	p.ready.Store(true)
 }
 
+// HasChannel reports whether the plugin subscribed to the named plugin message channel.
+func (p *pluginProcess) HasChannel(channel string) bool {
+	if !p.ready.Load() {
+		return false
+	}
+	channels := p.channels.Load()
+	if channels == nil {
+		return false
+	}
+	_, ok := (*channels)[channel]
+	return ok
+}
+
+func (p *pluginProcess) updateChannels(channels []string) {
+	set := make(map[string]struct{}, len(channels))
+	for _, ch := range channels {
+		if ch == "" {
+			continue
+		}
+		set[ch] = struct{}{}
+	}
+	p.channels.Store(&set)
+}
+
 func (p *pluginProcess) queue(msg *pb.HostToPlugin) {
 	if p.closed.Load() || !p.connected.Load() {
 		return
@@ -373,7 +536,7 @@ func (p *pluginProcess) queue(msg *pb.HostToPlugin) {
 	select {
 	case p.sendCh <- msg:
 	default:
-		p.log.Warn("dropping message", "reason", "queue full")
+		p.sendOverflow(msg)
 	}
 }
 
@@ -407,6 +570,8 @@ func (p *pluginProcess) Stop() {
 }
 
 func (p *pluginProcess) stopProcess() {
+	p.cmdMu.Lock()
+	defer p.cmdMu.Unlock()
 	if p.cmd != nil && p.cmd.Process != nil {
 		_ = p.cmd.Process.Kill()
 	}
@@ -0,0 +1,144 @@
+package plugin
+
+import (
+	"syscall"
+	"time"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+const (
+	defaultShutdownGrace = 5 * time.Second
+	terminateTimeout     = 2 * time.Second
+	drainPollInterval    = 10 * time.Millisecond
+)
+
+// shutdownGrace returns how long the plugin gets to acknowledge HostShutdown or exit.
+func (p *pluginProcess) shutdownGrace() time.Duration {
+	if p.cfg.ShutdownGraceMs > 0 {
+		return time.Duration(p.cfg.ShutdownGraceMs) * time.Millisecond
+	}
+	return defaultShutdownGrace
+}
+
+// ackShutdown records a PluginShutdownAck from the plugin.
+func (p *pluginProcess) ackShutdown() {
+	p.shutdownAckMu.Lock()
+	defer p.shutdownAckMu.Unlock()
+	select {
+	case <-p.shutdownAck:
+	default:
+		close(p.shutdownAck)
+	}
+}
+
+// shutdownAcked returns a channel closed once the plugin acknowledges HostShutdown.
+func (p *pluginProcess) shutdownAcked() <-chan struct{} {
+	p.shutdownAckMu.Lock()
+	defer p.shutdownAckMu.Unlock()
+	return p.shutdownAck
+}
+
+// resetShutdownAck prepares for another HostShutdown after a plugin was restarted in place.
+func (p *pluginProcess) resetShutdownAck() {
+	p.shutdownAckMu.Lock()
+	defer p.shutdownAckMu.Unlock()
+	p.shutdownAck = make(chan struct{})
+}
+
+// shutdown drains the plugin before stopping it: it sends HostShutdown, waits for a
+// PluginShutdownAck, the process to exit or the stream to close, applies any actions queued in
+// the meantime, then escalates to SIGTERM and SIGKILL before releasing resources with Stop.
+func (p *pluginProcess) shutdown(reason string) {
+	if p.closed.Load() {
+		return
+	}
+	p.shuttingDown.Store(true)
+	grace := p.shutdownGrace()
+
+	exited := p.processExited()
+	if p.connected.Load() {
+		p.queue(&pb.HostToPlugin{
+			PluginId: p.id,
+			Payload: &pb.HostToPlugin_Shutdown{
+				Shutdown: &pb.HostShutdown{Reason: reason, GracePeriodMs: grace.Milliseconds()},
+			},
+		})
+		if p.waitShutdown(exited, grace) {
+			p.log.Debug("plugin acknowledged shutdown")
+		} else {
+			p.log.Warn("plugin did not shut down within grace period", "grace", grace)
+		}
+	}
+	p.waitActionsDrained(time.Now().Add(terminateTimeout))
+	p.terminate(exited)
+	p.Stop()
+}
+
+// waitShutdown waits until the plugin acknowledges the shutdown, its process exits or its stream
+// closes. It returns false if the grace period expired first.
+func (p *pluginProcess) waitShutdown(exited <-chan struct{}, grace time.Duration) bool {
+	acked := p.shutdownAcked()
+	timer := time.NewTimer(grace)
+	defer timer.Stop()
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-acked:
+			return true
+		case <-exited:
+			return true
+		case <-ticker.C:
+			if !p.connected.Load() {
+				return true
+			}
+		case <-timer.C:
+			return false
+		}
+	}
+}
+
+// waitActionsDrained blocks until every queued action batch was applied or the deadline passes.
+func (p *pluginProcess) waitActionsDrained(deadline time.Time) {
+	for p.actionsPending.Load() > 0 && time.Now().Before(deadline) {
+		time.Sleep(drainPollInterval)
+	}
+	if n := p.actionsPending.Load(); n > 0 {
+		p.log.Warn("dropping unapplied actions on shutdown", "batches", n)
+	}
+}
+
+// terminate sends SIGTERM to a still running plugin command and SIGKILL if it does not exit in time.
+func (p *pluginProcess) terminate(exited <-chan struct{}) {
+	p.cmdMu.Lock()
+	cmd := p.cmd
+	p.cmdMu.Unlock()
+	if cmd == nil || cmd.Process == nil {
+		return
+	}
+	select {
+	case <-exited:
+		return
+	default:
+	}
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		// Signals other than kill are unsupported on Windows.
+		_ = cmd.Process.Kill()
+		return
+	}
+	select {
+	case <-exited:
+	case <-time.After(terminateTimeout):
+		p.log.Warn("plugin ignored SIGTERM, killing")
+		_ = cmd.Process.Kill()
+	}
+}
+
+// processExited returns a channel closed when the current plugin command exits, or nil if no
+// command is running.
+func (p *pluginProcess) processExited() <-chan struct{} {
+	p.cmdMu.Lock()
+	defer p.cmdMu.Unlock()
+	return p.exited
+}
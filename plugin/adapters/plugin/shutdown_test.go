@@ -0,0 +1,199 @@
+package plugin
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// newShutdownTestPlugin returns a connected plugin with the grace period passed that runs script
+// with sh, or no command if script is empty. The script creates a file named ready once it set up,
+// which is waited for.
+func newShutdownTestPlugin(t *testing.T, graceMs int, script string) *pluginProcess {
+	t.Helper()
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	p := newPluginProcess(m, config.PluginConfig{ID: "shutdown", ShutdownGraceMs: graceMs})
+	p.connected.Store(true)
+	t.Cleanup(p.Stop)
+	if script == "" {
+		return p
+	}
+	if _, err := exec.LookPath("sh"); err != nil || runtime.GOOS == "windows" {
+		t.Skip("needs sh and POSIX signals")
+	}
+	p.cfg.Command, p.cfg.Args, p.cfg.WorkDir.Path = "sh", []string{"-c", script}, t.TempDir()
+	if err := p.launchProcess(context.Background(), "127.0.0.1:0"); err != nil {
+		t.Fatalf("launch plugin: %v", err)
+	}
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(drainPollInterval) {
+		if _, err := os.Stat(filepath.Join(p.cfg.WorkDir.Path, "ready")); err == nil {
+			return p
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("plugin command did not start")
+		}
+	}
+}
+
+// shutdownSent returns the HostShutdown queued for p, if any.
+func shutdownSent(p *pluginProcess) *pb.HostShutdown {
+	for {
+		select {
+		case msg := <-p.sendCh:
+			if s := msg.GetShutdown(); s != nil {
+				return s
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+func TestShutdownGrace(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	startTestServer(t, m)
+	if err := m.LoadPlugin(config.PluginConfig{ID: "slow", Token: "secret", ShutdownGraceMs: 900}); err != nil {
+		t.Fatalf("LoadPlugin: %v", err)
+	}
+	if err := m.LoadPlugin(config.PluginConfig{ID: "default", Token: "secret"}); err != nil {
+		t.Fatalf("LoadPlugin: %v", err)
+	}
+	m.mu.RLock()
+	slow, def := m.plugins["slow"], m.plugins["default"]
+	m.mu.RUnlock()
+	if got := slow.shutdownGrace(); got != 900*time.Millisecond {
+		t.Errorf("grace of a plugin with shutdown_grace_ms = %v, want its own 900ms", got)
+	}
+	if got := def.shutdownGrace(); got != 50*time.Millisecond {
+		t.Errorf("grace of a plugin without shutdown_grace_ms = %v, want the server's 50ms", got)
+	}
+	if got := newPluginProcess(m, config.PluginConfig{ID: "unset"}).shutdownGrace(); got != defaultShutdownGrace {
+		t.Errorf("grace without any shutdown_grace_ms = %v, want %v", got, defaultShutdownGrace)
+	}
+}
+
+func TestShutdownAcknowledged(t *testing.T) {
+	p := newShutdownTestPlugin(t, 5000, "")
+	// An action batch sent while shutting down is applied before the plugin is stopped.
+	p.actionsPending.Add(1)
+
+	stopped := make(chan struct{})
+	go func() {
+		p.shutdown("server shutting down")
+		close(stopped)
+	}()
+	var sent *pb.HostShutdown
+	for deadline := time.Now().Add(time.Second); sent == nil && time.Now().Before(deadline); {
+		time.Sleep(drainPollInterval)
+		sent = shutdownSent(p)
+	}
+	if sent == nil || sent.Reason != "server shutting down" || sent.GracePeriodMs != 5000 {
+		t.Fatalf("sent %v, want HostShutdown with the reason and a grace period of 5000ms", sent)
+	}
+
+	p.ackShutdown()
+	select {
+	case <-stopped:
+		t.Fatal("plugin stopped before its queued actions were applied")
+	case <-time.After(100 * time.Millisecond):
+	}
+	p.actionsPending.Add(-1)
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("plugin not stopped after it acknowledged the shutdown")
+	}
+	if !p.closed.Load() {
+		t.Error("plugin not closed after shutdown")
+	}
+}
+
+func TestShutdownGraceExpires(t *testing.T) {
+	p := newShutdownTestPlugin(t, 100, "")
+	start := time.Now()
+	p.shutdown("plugin unloaded")
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond || elapsed > time.Second {
+		t.Errorf("shutdown took %v, want the grace period of 100ms", elapsed)
+	}
+	if sent := shutdownSent(p); sent.GetGracePeriodMs() != 100 {
+		t.Errorf("sent %v, want HostShutdown with a grace period of 100ms", sent)
+	}
+}
+
+func TestShutdownTerminate(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		// signal is the signal that ended the process, or 0 if it exited by itself.
+		signal       syscall.Signal
+		min, max     time.Duration
+		disconnected bool
+	}{
+		{
+			name:   "exits within the grace period",
+			script: ": > ready; sleep 0.2",
+			max:    time.Second,
+		},
+		{
+			name:   "terminated after the grace period",
+			script: ": > ready; exec sleep 30",
+			signal: syscall.SIGTERM,
+			min:    300 * time.Millisecond,
+			max:    300*time.Millisecond + terminateTimeout,
+		},
+		{
+			// Without a stream there is nobody to send HostShutdown to.
+			name:         "terminated without a stream",
+			script:       ": > ready; exec sleep 30",
+			signal:       syscall.SIGTERM,
+			max:          terminateTimeout,
+			disconnected: true,
+		},
+		{
+			name:         "killed if it ignores SIGTERM",
+			script:       "trap '' TERM; : > ready; exec sleep 30",
+			signal:       syscall.SIGKILL,
+			min:          terminateTimeout,
+			max:          2 * terminateTimeout,
+			disconnected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newShutdownTestPlugin(t, 300, tt.script)
+			if tt.disconnected {
+				p.connected.Store(false)
+			}
+			exited := p.processExited()
+
+			start := time.Now()
+			p.shutdown("server shutting down")
+			elapsed := time.Since(start)
+			if elapsed < tt.min || elapsed > tt.max {
+				t.Errorf("shutdown took %v, want between %v and %v", elapsed, tt.min, tt.max)
+			}
+			select {
+			case <-exited:
+			case <-time.After(time.Second):
+				t.Fatal("process still running after shutdown")
+			}
+			status := p.cmd.ProcessState.Sys().(syscall.WaitStatus)
+			switch {
+			case tt.signal == 0 && (!status.Exited() || status.ExitStatus() != 0):
+				t.Errorf("process ended with %v, want it to exit by itself", p.cmd.ProcessState)
+			case tt.signal != 0 && (!status.Signaled() || status.Signal() != tt.signal):
+				t.Errorf("process ended with %v, want %v", p.cmd.ProcessState, tt.signal)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+)
+
+const (
+	defaultRestartBackoff    = time.Second
+	defaultRestartMaxBackoff = 30 * time.Second
+	defaultMaxRestarts       = 5
+	defaultRestartWindow     = time.Minute
+)
+
+// supervisor decides whether and when an exited plugin command is restarted. It is only used
+// from the goroutine waiting on the process, so it needs no locking.
+type supervisor struct {
+	policy         string
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxRestarts    int
+	window         time.Duration
+
+	backoff  time.Duration
+	restarts []time.Time
+}
+
+func newSupervisor(cfg config.RestartConfig) *supervisor {
+	s := &supervisor{
+		policy:         cfg.Policy,
+		initialBackoff: time.Duration(cfg.InitialBackoffMs) * time.Millisecond,
+		maxBackoff:     time.Duration(cfg.MaxBackoffMs) * time.Millisecond,
+		maxRestarts:    cfg.MaxRestarts,
+		window:         time.Duration(cfg.WindowMs) * time.Millisecond,
+	}
+	if s.policy == "" {
+		s.policy = config.RestartNever
+	}
+	if s.initialBackoff <= 0 {
+		s.initialBackoff = defaultRestartBackoff
+	}
+	if s.maxBackoff <= 0 {
+		s.maxBackoff = defaultRestartMaxBackoff
+	}
+	if s.maxRestarts <= 0 {
+		s.maxRestarts = defaultMaxRestarts
+	}
+	if s.window <= 0 {
+		s.window = defaultRestartWindow
+	}
+	return s
+}
+
+// next reports whether the process should be restarted after exiting with exitErr, and after
+// which delay. crashLoop is true when the restart limit for the window has been exceeded.
+func (s *supervisor) next(exitErr error, uptime time.Duration, now time.Time) (delay time.Duration, restart, crashLoop bool) {
+	switch s.policy {
+	case config.RestartAlways:
+	case config.RestartOnFailure:
+		if exitErr == nil {
+			return 0, false, false
+		}
+	default:
+		return 0, false, false
+	}
+
+	// Forget restarts that fell out of the window.
+	cutoff := now.Add(-s.window)
+	kept := s.restarts[:0]
+	for _, t := range s.restarts {
+		if t.After(cutoff) {
+			kept = append(kept, t)
+		}
+	}
+	s.restarts = kept
+	if len(s.restarts) >= s.maxRestarts {
+		return 0, false, true
+	}
+	s.restarts = append(s.restarts, now)
+
+	// A process that stayed up for a whole window starts over with the initial backoff.
+	if s.backoff == 0 || uptime >= s.window {
+		s.backoff = s.initialBackoff
+	} else {
+		s.backoff = min(s.backoff*2, s.maxBackoff)
+	}
+	return s.backoff, true, false
+}
+
+// crashLooped reports whether the plugin with the given ID gave up restarting after crash looping.
+// A reload starts it again.
+func (m *Manager) crashLooped(id string) bool {
+	m.mu.RLock()
+	proc, ok := m.plugins[id]
+	m.mu.RUnlock()
+	return ok && proc.failed.Load()
+}
+
+// superviseExit applies the restart policy after the plugin command exited on its own.
+func (p *pluginProcess) superviseExit(ctx context.Context, exitErr error, uptime time.Duration) {
+	delay, restart, crashLoop := p.supervisor.next(exitErr, uptime, time.Now())
+	if crashLoop {
+		p.failed.Store(true)
+		p.log.Error("plugin is crash looping, giving up",
+			"max_restarts", p.supervisor.maxRestarts,
+			"window", p.supervisor.window)
+		return
+	}
+	if !restart {
+		return
+	}
+	p.log.Info("restarting plugin", "delay", delay)
+	select {
+	case <-time.After(delay):
+	case <-p.done:
+		return
+	case <-ctx.Done():
+		return
+	}
+	// Subscriptions are kept across restarts, so events resume as soon as the plugin reconnects
+	// and receives a fresh HostHello in attachStream.
+	if err := p.launchProcess(ctx, p.serverAddress); err != nil {
+		p.log.Error("relaunch plugin", "error", err)
+		p.superviseExit(ctx, err, 0)
+	}
+}
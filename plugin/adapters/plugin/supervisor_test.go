@@ -0,0 +1,123 @@
+package plugin
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+)
+
+func TestSupervisorNext(t *testing.T) {
+	errExit := errors.New("exit status 1")
+	type exit struct {
+		// at is the time of the exit, relative to the first one.
+		at     time.Duration
+		err    error
+		uptime time.Duration
+	}
+	type decision struct {
+		delay     time.Duration
+		restart   bool
+		crashLoop bool
+	}
+	restartAfter := func(d time.Duration) decision { return decision{delay: d, restart: true} }
+
+	tests := []struct {
+		name  string
+		cfg   config.RestartConfig
+		exits []exit
+		want  []decision
+	}{
+		{
+			name:  "never",
+			cfg:   config.RestartConfig{Policy: config.RestartNever},
+			exits: []exit{{err: errExit}},
+			want:  []decision{{}},
+		},
+		{
+			name:  "default policy",
+			cfg:   config.RestartConfig{},
+			exits: []exit{{err: errExit}},
+			want:  []decision{{}},
+		},
+		{
+			name:  "on-failure after a clean exit",
+			cfg:   config.RestartConfig{Policy: config.RestartOnFailure},
+			exits: []exit{{}, {at: time.Second, err: errExit}},
+			want:  []decision{{}, restartAfter(time.Second)},
+		},
+		{
+			name:  "always after a clean exit",
+			cfg:   config.RestartConfig{Policy: config.RestartAlways},
+			exits: []exit{{}},
+			want:  []decision{restartAfter(time.Second)},
+		},
+		{
+			name: "exponential backoff",
+			cfg:  config.RestartConfig{Policy: config.RestartAlways, InitialBackoffMs: 100, MaxBackoffMs: 500, MaxRestarts: 10},
+			exits: []exit{
+				{}, {at: time.Second}, {at: 2 * time.Second}, {at: 3 * time.Second}, {at: 4 * time.Second},
+			},
+			want: []decision{
+				restartAfter(100 * time.Millisecond),
+				restartAfter(200 * time.Millisecond),
+				restartAfter(400 * time.Millisecond),
+				restartAfter(500 * time.Millisecond),
+				restartAfter(500 * time.Millisecond),
+			},
+		},
+		{
+			name: "backoff resets after a stable run",
+			cfg:  config.RestartConfig{Policy: config.RestartAlways, InitialBackoffMs: 100, WindowMs: 10000},
+			exits: []exit{
+				{}, {at: time.Second}, {at: 20 * time.Second, uptime: 10 * time.Second},
+			},
+			want: []decision{
+				restartAfter(100 * time.Millisecond),
+				restartAfter(200 * time.Millisecond),
+				restartAfter(100 * time.Millisecond),
+			},
+		},
+		{
+			name: "crash loop",
+			cfg:  config.RestartConfig{Policy: config.RestartOnFailure, InitialBackoffMs: 100, MaxRestarts: 2, WindowMs: 10000},
+			exits: []exit{
+				{err: errExit}, {at: time.Second, err: errExit}, {at: 2 * time.Second, err: errExit},
+			},
+			want: []decision{
+				restartAfter(100 * time.Millisecond),
+				restartAfter(200 * time.Millisecond),
+				{crashLoop: true},
+			},
+		},
+		{
+			name: "restarts leave the window",
+			cfg:  config.RestartConfig{Policy: config.RestartOnFailure, InitialBackoffMs: 100, MaxRestarts: 2, WindowMs: 10000},
+			exits: []exit{
+				{err: errExit}, {at: time.Second, err: errExit}, {at: 10500 * time.Millisecond, err: errExit},
+				{at: 10800 * time.Millisecond, err: errExit},
+			},
+			want: []decision{
+				restartAfter(100 * time.Millisecond),
+				restartAfter(200 * time.Millisecond),
+				// The first restart fell out of the window, the second is still in it.
+				restartAfter(400 * time.Millisecond),
+				{crashLoop: true},
+			},
+		},
+	}
+	start := time.Unix(1000, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSupervisor(tt.cfg)
+			for i, e := range tt.exits {
+				var got decision
+				got.delay, got.restart, got.crashLoop = s.next(e.err, e.uptime, start.Add(e.at))
+				if got != tt.want[i] {
+					t.Errorf("exit %d: next = %+v, want %+v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
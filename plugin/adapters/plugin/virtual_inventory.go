@@ -0,0 +1,610 @@
+package plugin
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/google/uuid"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+const (
+	// virtualWindowID is the window ID of virtual inventories. The session numbers its own windows
+	// from 1 to 99.
+	virtualWindowID byte = 100
+	// virtualOpenDelay is how long the window of a virtual inventory is opened after its blocks were
+	// sent, when the client needs time to pair a double chest or to close the previous window.
+	virtualOpenDelay = 100 * time.Millisecond
+)
+
+var (
+	errVirtualInventoryNotOpen = errors.New("virtual inventory not open")
+	errContainerOpen           = errors.New("player has a container open")
+	errNoConn                  = errors.New("player connection not available")
+)
+
+// virtualStackID numbers the item stacks sent in virtual inventories.
+var virtualStackID atomic.Int32
+
+// virtualInventory is a container window that exists only for one player. The client is shown a
+// fake chest or hopper block which holds the items; the world is never changed.
+type virtualInventory struct {
+	pluginID string
+	id       string
+	typ      pb.VirtualInventoryType
+	title    string
+	name     string
+	pos      cube.Pos
+	// allowTake is set if the plugin lets players take items out of the inventory.
+	allowTake bool
+
+	mu    sync.Mutex
+	slots []item.Stack
+}
+
+// virtualInventorySize returns the number of slots of a virtual inventory type.
+func virtualInventorySize(t pb.VirtualInventoryType) (int, bool) {
+	switch t {
+	case pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST:
+		return 27, true
+	case pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST:
+		return 54, true
+	case pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_HOPPER:
+		return 5, true
+	}
+	return 0, false
+}
+
+// blocks returns the positions of the fake blocks of the inventory.
+func (inv *virtualInventory) blocks() []cube.Pos {
+	if inv.typ == pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST {
+		return []cube.Pos{inv.pos, inv.pos.Add(cube.Pos{1, 0, 0})}
+	}
+	return []cube.Pos{inv.pos}
+}
+
+func (inv *virtualInventory) slot(slot int) (item.Stack, bool) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	if slot < 0 || slot >= len(inv.slots) {
+		return item.Stack{}, false
+	}
+	return inv.slots[slot], true
+}
+
+// convertInventorySlots converts the slots of a virtual inventory action. Slots without an item are
+// cleared.
+func convertInventorySlots(slots []*pb.InventorySlot) (map[int]item.Stack, bool) {
+	stacks := make(map[int]item.Stack, len(slots))
+	for _, s := range slots {
+		var stack item.Stack
+		if s.Item != nil {
+			var ok bool
+			if stack, ok = convertProtoItemStackValue(s.Item); !ok {
+				return nil, false
+			}
+		}
+		stacks[int(s.Slot)] = stack
+	}
+	return stacks, true
+}
+
+func (m *Manager) handlePlayerOpenVirtualInventory(p *pluginProcess, correlationID string, act *pb.PlayerOpenVirtualInventoryAction) {
+	size, ok := virtualInventorySize(act.Type)
+	if !ok {
+		m.sendActionError(p, correlationID, "unknown virtual inventory type")
+		return
+	}
+	stacks, ok := convertInventorySlots(act.Slots)
+	if !ok {
+		m.sendActionError(p, correlationID, "invalid item")
+		return
+	}
+	slots := make([]item.Stack, size)
+	for slot, stack := range stacks {
+		if slot < 0 || slot >= size {
+			m.sendActionError(p, correlationID, errInvalidSlot(slot, size).Error())
+			return
+		}
+		slots[slot] = stack
+	}
+	check := func(pl *player.Player) error {
+		c := m.playerConn(pl.UUID())
+		if c == nil {
+			return errNoConn
+		}
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.open {
+			return errContainerOpen
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		c := m.playerConn(pl.UUID())
+		if c == nil {
+			return nil, errNoConn
+		}
+		return nil, c.openVirtual(pl.Tx(), pl, &virtualInventory{
+			pluginID:  p.id,
+			id:        act.InventoryId,
+			typ:       act.Type,
+			title:     act.Title,
+			name:      pl.Name(),
+			slots:     slots,
+			allowTake: act.AllowTake,
+		})
+	})
+}
+
+func (m *Manager) handlePlayerSetVirtualInventorySlots(p *pluginProcess, correlationID string, act *pb.PlayerSetVirtualInventorySlotsAction) {
+	stacks, ok := convertInventorySlots(act.Slots)
+	if !ok {
+		m.sendActionError(p, correlationID, "invalid item")
+		return
+	}
+	// open returns the virtual inventory the action is for, if the player has it open.
+	open := func(pl *player.Player) (*playerConn, *virtualInventory, error) {
+		c := m.playerConn(pl.UUID())
+		if c == nil {
+			return nil, nil, errVirtualInventoryNotOpen
+		}
+		inv := c.currentVirtual()
+		if !inv.openedBy(p.id, act.InventoryId) {
+			return nil, nil, errVirtualInventoryNotOpen
+		}
+		return c, inv, nil
+	}
+	check := func(pl *player.Player) error {
+		_, inv, err := open(pl)
+		if err != nil {
+			return err
+		}
+		inv.mu.Lock()
+		defer inv.mu.Unlock()
+		for slot := range stacks {
+			if slot < 0 || slot >= len(inv.slots) {
+				return errInvalidSlot(slot, len(inv.slots))
+			}
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		c, inv, err := open(pl)
+		if err != nil {
+			return nil, err
+		}
+		inv.mu.Lock()
+		for slot := range stacks {
+			if slot < 0 || slot >= len(inv.slots) {
+				inv.mu.Unlock()
+				return nil, errInvalidSlot(slot, len(inv.slots))
+			}
+		}
+		for slot, stack := range stacks {
+			inv.slots[slot] = stack
+		}
+		inv.mu.Unlock()
+		for slot := range stacks {
+			c.sendVirtualSlot(inv, slot)
+		}
+		return nil, nil
+	})
+}
+
+func (m *Manager) handlePlayerCloseVirtualInventory(p *pluginProcess, correlationID string, act *pb.PlayerCloseVirtualInventoryAction) {
+	check := func(pl *player.Player) error {
+		if c := m.playerConn(pl.UUID()); c == nil || !c.currentVirtual().openedBy(p.id, act.InventoryId) {
+			return errVirtualInventoryNotOpen
+		}
+		return nil
+	}
+	m.execPlayerChecked(p, correlationID, act.PlayerUuid, check, func(pl *player.Player) (*pb.ActionResult, error) {
+		c := m.playerConn(pl.UUID())
+		if c == nil {
+			return nil, errVirtualInventoryNotOpen
+		}
+		c.mu.Lock()
+		inv := c.virtual
+		if !inv.openedBy(p.id, act.InventoryId) {
+			c.mu.Unlock()
+			return nil, errVirtualInventoryNotOpen
+		}
+		c.virtual = nil
+		c.mu.Unlock()
+		_ = c.Conn.WritePacket(&packet.ContainerClose{WindowID: virtualWindowID, ServerSide: true})
+		c.restoreBlocks(pl.Tx(), inv)
+		m.sendVirtualClose(c.id, inv)
+		return nil, nil
+	})
+}
+
+// openedBy reports whether inv, which may be nil, was opened by the plugin with the given ID as the
+// virtual inventory id.
+func (inv *virtualInventory) openedBy(pluginID, id string) bool {
+	return inv != nil && inv.pluginID == pluginID && inv.id == id
+}
+
+// currentVirtual returns the virtual inventory the player has open, or nil.
+func (c *playerConn) currentVirtual() *virtualInventory {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.virtual
+}
+
+// openVirtual shows inv to the player, replacing the virtual inventory the player has open. It fails
+// if the player has a container block open.
+func (c *playerConn) openVirtual(tx *world.Tx, pl *player.Player, inv *virtualInventory) error {
+	c.mu.Lock()
+	if c.open {
+		c.mu.Unlock()
+		return errContainerOpen
+	}
+	prev := c.virtual
+	c.virtual = inv
+	c.mu.Unlock()
+
+	var delay time.Duration
+	if prev != nil {
+		_ = c.Conn.WritePacket(&packet.ContainerClose{WindowID: virtualWindowID, ServerSide: true})
+		c.restoreBlocks(tx, prev)
+		c.m.sendVirtualClose(c.id, prev)
+		delay = virtualOpenDelay
+	}
+	if inv.typ == pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST {
+		delay = virtualOpenDelay
+	}
+	// The fake blocks are placed above the head of the player, where they are out of the way.
+	pos := cube.PosFromVec3(pl.Position())
+	if inv.pos = pos.Add(cube.Pos{0, 2, 0}); inv.pos.OutOfBounds(tx.Range()) {
+		inv.pos = pos.Add(cube.Pos{0, -1, 0})
+	}
+	c.sendBlocks(inv)
+	if delay == 0 {
+		c.sendWindow(inv)
+	} else {
+		time.AfterFunc(delay, func() { c.sendWindow(inv) })
+	}
+	return nil
+}
+
+// sendBlocks sends the fake chest or hopper blocks of inv to the client.
+func (c *playerConn) sendBlocks(inv *virtualInventory) {
+	var b world.Block = block.NewChest()
+	id := "Chest"
+	if inv.typ == pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_HOPPER {
+		b, id = block.NewHopper(), "Hopper"
+	}
+	blocks := inv.blocks()
+	for i, pos := range blocks {
+		_ = c.Conn.WritePacket(&packet.UpdateBlock{
+			Position:          blockPos(pos),
+			NewBlockRuntimeID: world.BlockRuntimeID(b),
+			Flags:             packet.BlockUpdateNetwork,
+		})
+		data := map[string]any{"id": id, "x": int32(pos[0]), "y": int32(pos[1]), "z": int32(pos[2])}
+		if inv.title != "" {
+			data["CustomName"] = inv.title
+		}
+		if len(blocks) == 2 {
+			pair := blocks[1-i]
+			data["pairx"], data["pairz"] = int32(pair[0]), int32(pair[2])
+			data["pairlead"] = boolByte(i == 0)
+		}
+		_ = c.Conn.WritePacket(&packet.BlockActorData{Position: blockPos(pos), NBTData: data})
+	}
+}
+
+// restoreBlocks sends the real blocks at the positions of the fake blocks of inv to the client.
+func (c *playerConn) restoreBlocks(tx *world.Tx, inv *virtualInventory) {
+	for _, pos := range inv.blocks() {
+		b := tx.Block(pos)
+		_ = c.Conn.WritePacket(&packet.UpdateBlock{
+			Position:          blockPos(pos),
+			NewBlockRuntimeID: world.BlockRuntimeID(b),
+			Flags:             packet.BlockUpdateNetwork,
+		})
+		if nbter, ok := b.(world.NBTer); ok {
+			data := nbter.EncodeNBT()
+			data["x"], data["y"], data["z"] = int32(pos[0]), int32(pos[1]), int32(pos[2])
+			_ = c.Conn.WritePacket(&packet.BlockActorData{Position: blockPos(pos), NBTData: data})
+		}
+	}
+}
+
+// sendWindow opens the window of inv and sends its contents, unless the inventory was closed
+// meanwhile.
+func (c *playerConn) sendWindow(inv *virtualInventory) {
+	if c.currentVirtual() != inv {
+		return
+	}
+	containerType := byte(protocol.ContainerTypeContainer)
+	if inv.typ == pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_HOPPER {
+		containerType = protocol.ContainerTypeHopper
+	}
+	_ = c.Conn.WritePacket(&packet.ContainerOpen{
+		WindowID:                virtualWindowID,
+		ContainerType:           containerType,
+		ContainerPosition:       blockPos(inv.pos),
+		ContainerEntityUniqueID: -1,
+	})
+	inv.mu.Lock()
+	content := make([]protocol.ItemInstance, len(inv.slots))
+	for i, it := range inv.slots {
+		content[i] = networkItem(it)
+	}
+	inv.mu.Unlock()
+	_ = c.Conn.WritePacket(&packet.InventoryContent{WindowID: uint32(virtualWindowID), Content: content})
+}
+
+// sendVirtualSlot sends the item in a slot of inv to the client if the inventory is still open.
+func (c *playerConn) sendVirtualSlot(inv *virtualInventory, slot int) {
+	it, ok := inv.slot(slot)
+	if !ok || c.currentVirtual() != inv {
+		return
+	}
+	_ = c.Conn.WritePacket(&packet.InventorySlot{
+		WindowID: uint32(virtualWindowID),
+		Slot:     uint32(slot),
+		NewItem:  networkItem(it),
+	})
+}
+
+// closeVirtualWindow handles the client closing window. It reports whether the window was that of a
+// virtual inventory, in which case the packet must not reach the session: it does not know the window
+// and would disconnect the player.
+func (c *playerConn) closeVirtualWindow(window byte) bool {
+	if window != virtualWindowID {
+		return false
+	}
+	c.mu.Lock()
+	inv := c.virtual
+	c.virtual = nil
+	c.mu.Unlock()
+	_ = c.Conn.WritePacket(&packet.ContainerClose{WindowID: virtualWindowID})
+	if inv != nil {
+		c.m.virtualClosed(c.id, inv)
+	}
+	return true
+}
+
+// virtualClick is a single action of an ItemStackRequest on a slot of a virtual inventory.
+type virtualClick struct {
+	slot   int
+	action pb.VirtualInventoryClickType
+	count  int
+}
+
+// handleVirtualClicks reports the actions of an ItemStackRequest on the open virtual inventory to the
+// plugin that opened it. The slots are locked unless the plugin allowed takes: a TAKE then waits for
+// the plugin and moves the items to the player's inventory if it answered without cancelling. The
+// session rejects the request itself, as it has no container open, which reverts the client, so the
+// clicked slots are sent again once it did.
+func (c *playerConn) handleVirtualClicks(pk *packet.ItemStackRequest) {
+	inv := c.currentVirtual()
+	if inv == nil {
+		return
+	}
+	var clicks []virtualClick
+	for _, req := range pk.Requests {
+		for _, action := range req.Actions {
+			if click, ok := virtualClickOf(action); ok {
+				clicks = append(clicks, click)
+			}
+		}
+	}
+	if len(clicks) == 0 {
+		return
+	}
+	c.m.mu.RLock()
+	p, ok := c.m.players[c.id]
+	c.m.mu.RUnlock()
+	if !ok {
+		return
+	}
+	p.H().ExecWorld(func(tx *world.Tx, e world.Entity) {
+		pl, ok := e.(*player.Player)
+		if !ok {
+			return
+		}
+		for _, click := range clicks {
+			c.m.virtualClick(pl, inv, click)
+		}
+	})
+	c.after = append(c.after, func() {
+		for _, click := range clicks {
+			c.sendVirtualSlot(inv, click.slot)
+		}
+	})
+}
+
+// virtualClick reports a click on a slot of inv to the plugin that opened it and applies it if it
+// is a take the plugin allowed.
+func (m *Manager) virtualClick(pl *player.Player, inv *virtualInventory, click virtualClick) {
+	it, ok := inv.slot(click.slot)
+	if !ok {
+		return
+	}
+	envelope := &pb.EventEnvelope{
+		Type: pb.EventType_VIRTUAL_INVENTORY_CLICK,
+		Payload: &pb.EventEnvelope_VirtualInventoryClick{
+			VirtualInventoryClick: &pb.VirtualInventoryClickEvent{
+				PlayerUuid:  pl.UUID().String(),
+				Name:        pl.Name(),
+				World:       playerWorldDimension(pl),
+				PluginId:    inv.pluginID,
+				InventoryId: inv.id,
+				Slot:        int32(click.slot),
+				Action:      click.action,
+				Item:        protoItemStack(it),
+				Count:       int32(click.count),
+			},
+		},
+	}
+	if !inv.allowTake || click.action != pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_TAKE || it.Empty() {
+		m.sendEventTo(inv.pluginID, envelope)
+		return
+	}
+	// A plugin that timed out or is not connected does not get its items taken.
+	if res := m.awaitEventFrom(inv.pluginID, envelope); res == nil || res.GetCancel() {
+		return
+	}
+	count := it.Count()
+	if click.count > 0 {
+		count = min(click.count, count)
+	}
+	n, _ := pl.Inventory().AddItem(it.Grow(count - it.Count()))
+	if n == 0 {
+		return
+	}
+	inv.mu.Lock()
+	inv.slots[click.slot] = inv.slots[click.slot].Grow(-n)
+	inv.mu.Unlock()
+}
+
+// virtualClickOf returns the click of a stack request action on a virtual inventory slot.
+func virtualClickOf(action protocol.StackRequestAction) (virtualClick, bool) {
+	switch a := action.(type) {
+	case *protocol.TakeStackRequestAction:
+		if isVirtualSlot(a.Source) {
+			return virtualClick{slot: int(a.Source.Slot), action: pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_TAKE, count: int(a.Count)}, true
+		}
+	case *protocol.PlaceStackRequestAction:
+		if isVirtualSlot(a.Destination) {
+			return virtualClick{slot: int(a.Destination.Slot), action: pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_PLACE, count: int(a.Count)}, true
+		}
+		if isVirtualSlot(a.Source) {
+			// Placing from the virtual inventory into another one takes from it.
+			return virtualClick{slot: int(a.Source.Slot), action: pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_TAKE, count: int(a.Count)}, true
+		}
+	case *protocol.SwapStackRequestAction:
+		if isVirtualSlot(a.Source) {
+			return virtualClick{slot: int(a.Source.Slot), action: pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_SWAP}, true
+		}
+		if isVirtualSlot(a.Destination) {
+			return virtualClick{slot: int(a.Destination.Slot), action: pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_SWAP}, true
+		}
+	case *protocol.DropStackRequestAction:
+		if isVirtualSlot(a.Source) {
+			return virtualClick{slot: int(a.Source.Slot), action: pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_DROP, count: int(a.Count)}, true
+		}
+	}
+	return virtualClick{}, false
+}
+
+func isVirtualSlot(slot protocol.StackRequestSlotInfo) bool {
+	return slot.Container.ContainerID == protocol.ContainerLevelEntity
+}
+
+// virtualClosed restores the blocks of a virtual inventory that was closed without a transaction and
+// tells the plugin that opened it. It must not be called from a transaction.
+func (m *Manager) virtualClosed(id uuid.UUID, inv *virtualInventory) {
+	m.mu.RLock()
+	p, ok := m.players[id]
+	c := m.conns[id]
+	m.mu.RUnlock()
+	if ok && c != nil {
+		p.H().ExecWorld(func(tx *world.Tx, _ world.Entity) {
+			c.restoreBlocks(tx, inv)
+		})
+	}
+	m.sendVirtualClose(id, inv)
+}
+
+// sendVirtualClose sends VIRTUAL_INVENTORY_CLOSE to the plugin that opened inv.
+func (m *Manager) sendVirtualClose(id uuid.UUID, inv *virtualInventory) {
+	m.sendEventTo(inv.pluginID, &pb.EventEnvelope{
+		Type: pb.EventType_VIRTUAL_INVENTORY_CLOSE,
+		Payload: &pb.EventEnvelope_VirtualInventoryClose{
+			VirtualInventoryClose: &pb.VirtualInventoryCloseEvent{
+				PlayerUuid:  id.String(),
+				Name:        inv.name,
+				PluginId:    inv.pluginID,
+				InventoryId: inv.id,
+			},
+		},
+	})
+}
+
+// networkItem converts an item stack to its network representation, like the session does for the
+// inventories it sends.
+func networkItem(it item.Stack) protocol.ItemInstance {
+	if it.Empty() {
+		return protocol.ItemInstance{}
+	}
+	var blockRuntimeID uint32
+	if b, ok := it.Item().(world.Block); ok {
+		blockRuntimeID = world.BlockRuntimeID(b)
+	}
+	rid, meta, _ := world.ItemRuntimeID(it.Item())
+	return protocol.ItemInstance{
+		StackNetworkID: virtualStackID.Add(1),
+		Stack: protocol.ItemStack{
+			ItemType:       protocol.ItemType{NetworkID: rid, MetadataValue: uint32(meta)},
+			HasNetworkID:   true,
+			Count:          uint16(it.Count()),
+			BlockRuntimeID: int32(blockRuntimeID),
+			NBTData:        networkItemNBT(it),
+		},
+	}
+}
+
+// networkItemNBT encodes the NBT of an item stack sent over the network: its item NBT, custom name,
+// lore, damage, enchantments, anvil cost and unbreakable flag.
+func networkItemNBT(it item.Stack) map[string]any {
+	data := map[string]any{}
+	if nbter, ok := it.Item().(world.NBTer); ok {
+		for k, v := range nbter.EncodeNBT() {
+			data[k] = v
+		}
+	}
+	if cost := it.AnvilCost(); cost > 0 {
+		data["RepairCost"] = int32(cost)
+	}
+	if _, ok := it.Item().(item.Durable); ok {
+		data["Damage"] = int32(it.MaxDurability() - it.Durability())
+	}
+	display := map[string]any{}
+	if name := it.CustomName(); name != "" {
+		display["Name"] = name
+	}
+	if lore := it.Lore(); len(lore) != 0 {
+		display["Lore"] = lore
+	}
+	if len(display) != 0 {
+		data["display"] = display
+	}
+	var enchantments []map[string]any
+	for _, e := range it.Enchantments() {
+		if id, ok := item.EnchantmentID(e.Type()); ok {
+			enchantments = append(enchantments, map[string]any{"id": int16(id), "lvl": int16(e.Level())})
+		}
+	}
+	if len(enchantments) != 0 {
+		data["ench"] = enchantments
+	}
+	if it.Unbreakable() {
+		data["Unbreakable"] = byte(1)
+	}
+	return data
+}
+
+func blockPos(pos cube.Pos) protocol.BlockPos {
+	return protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,395 @@
+package plugin
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/df-mc/dragonfly/server/block"
+	"github.com/df-mc/dragonfly/server/block/cube"
+	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/player"
+	"github.com/df-mc/dragonfly/server/world"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+// virtualSlot returns a slot of the open virtual inventory, or of the player's inventory if virtual
+// is false.
+func virtualSlot(virtual bool, slot byte) protocol.StackRequestSlotInfo {
+	container := byte(protocol.ContainerCombinedHotBarAndInventory)
+	if virtual {
+		container = protocol.ContainerLevelEntity
+	}
+	return protocol.StackRequestSlotInfo{Container: protocol.FullContainerName{ContainerID: container}, Slot: slot}
+}
+
+func takeAction(count byte, src, dst protocol.StackRequestSlotInfo) *protocol.TakeStackRequestAction {
+	a := &protocol.TakeStackRequestAction{}
+	a.Count, a.Source, a.Destination = count, src, dst
+	return a
+}
+
+func placeAction(count byte, src, dst protocol.StackRequestSlotInfo) *protocol.PlaceStackRequestAction {
+	a := &protocol.PlaceStackRequestAction{}
+	a.Count, a.Source, a.Destination = count, src, dst
+	return a
+}
+
+func TestVirtualClickOf(t *testing.T) {
+	const (
+		take  = pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_TAKE
+		place = pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_PLACE
+		swap  = pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_SWAP
+		drop  = pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_DROP
+	)
+	virtual, own := virtualSlot(true, 4), virtualSlot(false, 9)
+	tests := []struct {
+		name   string
+		action protocol.StackRequestAction
+		want   virtualClick
+		ok     bool
+	}{
+		{name: "take from virtual", action: takeAction(3, virtual, own), want: virtualClick{slot: 4, action: take, count: 3}, ok: true},
+		{name: "take from own", action: takeAction(3, own, virtualSlot(false, 1))},
+		{name: "place into virtual", action: placeAction(2, own, virtual), want: virtualClick{slot: 4, action: place, count: 2}, ok: true},
+		{name: "place from virtual", action: placeAction(2, virtual, own), want: virtualClick{slot: 4, action: take, count: 2}, ok: true},
+		{name: "place within own", action: placeAction(2, own, virtualSlot(false, 1))},
+		{name: "swap from virtual", action: &protocol.SwapStackRequestAction{Source: virtual, Destination: own}, want: virtualClick{slot: 4, action: swap}, ok: true},
+		{name: "swap into virtual", action: &protocol.SwapStackRequestAction{Source: own, Destination: virtual}, want: virtualClick{slot: 4, action: swap}, ok: true},
+		{name: "swap within own", action: &protocol.SwapStackRequestAction{Source: own, Destination: virtualSlot(false, 1)}},
+		{name: "drop from virtual", action: &protocol.DropStackRequestAction{Count: 1, Source: virtual}, want: virtualClick{slot: 4, action: drop, count: 1}, ok: true},
+		{name: "drop from own", action: &protocol.DropStackRequestAction{Count: 1, Source: own}},
+		{name: "other action", action: &protocol.DestroyStackRequestAction{Count: 1, Source: virtual}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := virtualClickOf(tt.action)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("virtualClickOf = %+v, %v, want %+v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+// waitPacket waits for the connection to be written a packet of type T and returns the packets
+// written until then.
+func waitPacket[T packet.Packet](t *testing.T, fake *fakeConn) []packet.Packet {
+	t.Helper()
+	var pks []packet.Packet
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		for _, pk := range fake.packets() {
+			pks = append(pks, pk)
+			if _, ok := pk.(T); ok {
+				return pks
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("no %T written, got %v", *new(T), pks)
+	return nil
+}
+
+func TestOpenVirtual(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     pb.VirtualInventoryType
+		blockID string
+		// blocks are the positions of the fake blocks relative to the player.
+		blocks        []cube.Pos
+		containerType byte
+		delayed       bool
+	}{
+		{name: "chest", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST, blockID: "Chest", blocks: []cube.Pos{{0, 2, 0}}, containerType: protocol.ContainerTypeContainer},
+		{name: "hopper", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_HOPPER, blockID: "Hopper", blocks: []cube.Pos{{0, 2, 0}}, containerType: protocol.ContainerTypeHopper},
+		// The client needs time to pair the chests before the window opens.
+		{name: "double chest", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST, blockID: "Chest", blocks: []cube.Pos{{0, 2, 0}, {1, 2, 0}}, containerType: protocol.ContainerTypeContainer, delayed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			w, h, c, fake := joinTestPlayer(t, m)
+			size, _ := virtualInventorySize(tt.typ)
+			slots := make([]item.Stack, size)
+			slots[1] = item.NewStack(item.Diamond{}, 5)
+			inv := &virtualInventory{pluginID: "shop", id: "menu", typ: tt.typ, title: "Shop", slots: slots}
+
+			var err error
+			blocks := make([]cube.Pos, len(tt.blocks))
+			inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+				err = c.openVirtual(tx, pl, inv)
+				for i, pos := range tt.blocks {
+					blocks[i] = pos.Add(cube.PosFromVec3(pl.Position()))
+				}
+			})
+			if err != nil {
+				t.Fatalf("openVirtual: %v", err)
+			}
+			if c.currentVirtual() != inv {
+				t.Fatal("inventory not recorded as open")
+			}
+
+			pks := fake.packets()
+			if len(pks) < 2*len(blocks) {
+				t.Fatalf("wrote %v, want the fake blocks", pks)
+			}
+			for i, pos := range blocks {
+				update, ok := pks[2*i].(*packet.UpdateBlock)
+				if !ok || update.Position != blockPos(pos) {
+					t.Errorf("packet %d = %#v, want a block update at %v", 2*i, pks[2*i], pos)
+				}
+				data, ok := pks[2*i+1].(*packet.BlockActorData)
+				if !ok || data.NBTData["id"] != tt.blockID || data.NBTData["CustomName"] != "Shop" {
+					t.Fatalf("packet %d = %#v, want the %s block entity named Shop", 2*i+1, pks[2*i+1], tt.blockID)
+				}
+				if len(blocks) == 2 {
+					pair := blocks[1-i]
+					if data.NBTData["pairx"] != int32(pair[0]) || data.NBTData["pairz"] != int32(pair[2]) || data.NBTData["pairlead"] != boolByte(i == 0) {
+						t.Errorf("block entity at %v = %v, want it paired with %v", pos, data.NBTData, pair)
+					}
+				}
+			}
+			pks = pks[2*len(blocks):]
+			if tt.delayed {
+				if len(pks) != 0 {
+					t.Fatalf("window opened with the blocks: %v", pks)
+				}
+				pks = waitPacket[*packet.InventoryContent](t, fake)
+			}
+			if len(pks) != 2 {
+				t.Fatalf("wrote %v, want the window opened and filled", pks)
+			}
+			open, ok := pks[0].(*packet.ContainerOpen)
+			if !ok || open.WindowID != virtualWindowID || open.ContainerType != tt.containerType || open.ContainerPosition != blockPos(blocks[0]) {
+				t.Errorf("packet = %#v, want window %d of type %d at %v", pks[0], virtualWindowID, tt.containerType, blocks[0])
+			}
+			content, ok := pks[1].(*packet.InventoryContent)
+			if !ok || content.WindowID != uint32(virtualWindowID) || len(content.Content) != size || content.Content[1].Stack.Count != 5 {
+				t.Errorf("packet = %#v, want the %d slots of the inventory", pks[1], size)
+			}
+		})
+	}
+}
+
+func TestOpenVirtualReplaces(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	first, second := newTestPlugin(m, "first"), newTestPlugin(m, "second")
+	w, h, c, fake := joinTestPlayer(t, m)
+
+	prev := &virtualInventory{pluginID: "first", id: "a", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST, slots: make([]item.Stack, 27)}
+	inv := &virtualInventory{pluginID: "second", id: "b", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_HOPPER, slots: make([]item.Stack, 5)}
+	inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+		if err := c.openVirtual(tx, pl, prev); err != nil {
+			t.Fatalf("openVirtual: %v", err)
+		}
+	})
+	fake.packets()
+	// The player moved, so the new blocks are placed elsewhere and the old ones must be restored.
+	inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+		pl.Teleport(pl.Position().Add([3]float64{5, 0, 0}))
+		if err := c.openVirtual(tx, pl, inv); err != nil {
+			t.Fatalf("openVirtual: %v", err)
+		}
+	})
+	if c.currentVirtual() != inv {
+		t.Fatal("new inventory not recorded as open")
+	}
+
+	pks := fake.packets()
+	if len(pks) != 4 {
+		t.Fatalf("wrote %v, want the old window closed, its block restored and the new block placed", pks)
+	}
+	if closed, ok := pks[0].(*packet.ContainerClose); !ok || closed.WindowID != virtualWindowID || !closed.ServerSide {
+		t.Errorf("packet = %#v, want the old window closed", pks[0])
+	}
+	if update, ok := pks[1].(*packet.UpdateBlock); !ok || update.Position != blockPos(prev.pos) || update.NewBlockRuntimeID != world.BlockRuntimeID(block.Air{}) {
+		t.Errorf("packet = %#v, want the air at %v restored", pks[1], prev.pos)
+	}
+	if update, ok := pks[2].(*packet.UpdateBlock); !ok || update.Position != blockPos(inv.pos) || inv.pos == prev.pos {
+		t.Errorf("packet = %#v, want the new block placed at %v", pks[2], inv.pos)
+	}
+	// The client needs time to close the old window before it opens the new one.
+	if pks = waitPacket[*packet.ContainerOpen](t, fake); len(pks) != 1 {
+		t.Errorf("wrote %v before the new window, want nothing", pks[:len(pks)-1])
+	}
+
+	if evts := events(first); len(evts) != 1 || evts[0].GetVirtualInventoryClose().GetInventoryId() != "a" {
+		t.Errorf("first received %v, want the close of its inventory", evts)
+	}
+	if evts := events(second); len(evts) != 0 {
+		t.Errorf("second received %v, want nothing", evts)
+	}
+}
+
+func TestOpenVirtualContainerOpen(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	w, h, c, fake := joinTestPlayer(t, m)
+	c.open = true
+
+	inv := &virtualInventory{pluginID: "shop", id: "menu", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST, slots: make([]item.Stack, 27)}
+	var err error
+	inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+		err = c.openVirtual(tx, pl, inv)
+	})
+	if err != errContainerOpen {
+		t.Errorf("openVirtual error = %v, want %v", err, errContainerOpen)
+	}
+	if c.currentVirtual() != nil || len(fake.packets()) != 0 {
+		t.Error("virtual inventory opened over an open container")
+	}
+}
+
+func TestCloseVirtualWindow(t *testing.T) {
+	m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+	shop := newTestPlugin(m, "shop")
+	w, h, c, fake := joinTestPlayer(t, m)
+	// The fake chest hides a real one, which must be shown again with its block entity.
+	pos := cube.Pos{0, 66, 0}
+	inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+		tx.SetBlock(pos, block.NewChest(), nil)
+	})
+	c.virtual = &virtualInventory{pluginID: "shop", id: "menu", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST, pos: pos}
+
+	if c.closeVirtualWindow(3) {
+		t.Fatal("closeVirtualWindow handled a window of the session")
+	}
+	if c.currentVirtual() == nil || len(fake.packets()) != 0 {
+		t.Fatal("closing a window of the session closed the virtual inventory")
+	}
+
+	if !c.closeVirtualWindow(virtualWindowID) {
+		t.Fatal("closeVirtualWindow did not handle the virtual window")
+	}
+	if c.currentVirtual() != nil {
+		t.Error("virtual inventory still open")
+	}
+	pks := fake.packets()
+	if len(pks) != 3 {
+		t.Fatalf("wrote %v, want the close acknowledged and the chest restored", pks)
+	}
+	if closed, ok := pks[0].(*packet.ContainerClose); !ok || closed.WindowID != virtualWindowID || closed.ServerSide {
+		t.Errorf("packet = %#v, want the close acknowledged", pks[0])
+	}
+	if update, ok := pks[1].(*packet.UpdateBlock); !ok || update.Position != blockPos(pos) || update.NewBlockRuntimeID != world.BlockRuntimeID(block.NewChest()) {
+		t.Errorf("packet = %#v, want the chest at %v restored", pks[1], pos)
+	}
+	if data, ok := pks[2].(*packet.BlockActorData); !ok || data.Position != blockPos(pos) || data.NBTData["id"] != "Chest" {
+		t.Errorf("packet = %#v, want the block entity of the chest", pks[2])
+	}
+	if evts := events(shop); len(evts) != 1 || evts[0].GetVirtualInventoryClose().GetInventoryId() != "menu" {
+		t.Errorf("shop received %v, want the close of its inventory", evts)
+	}
+
+	// A second close of the window is acknowledged without reporting it again.
+	if !c.closeVirtualWindow(virtualWindowID) || len(fake.packets()) != 1 || len(events(shop)) != 0 {
+		t.Error("closing the virtual window again was not just acknowledged")
+	}
+}
+
+func TestHandleVirtualClicks(t *testing.T) {
+	const (
+		take  = pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_TAKE
+		place = pb.VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_PLACE
+	)
+	tests := []struct {
+		name      string
+		allowTake bool
+		action    protocol.StackRequestAction
+		// answer is how the shop answers the click, one of "", "ok", "cancel" or "disconnected". The
+		// click is only awaited if answer is not empty.
+		answer     string
+		wantAction pb.VirtualInventoryClickType
+		// taken is the number of diamonds moved to the player.
+		taken int
+	}{
+		{name: "locked", action: takeAction(5, virtualSlot(true, 0), virtualSlot(false, 0)), wantAction: take},
+		{name: "allowed", allowTake: true, action: takeAction(3, virtualSlot(true, 0), virtualSlot(false, 0)), answer: "ok", wantAction: take, taken: 3},
+		{name: "cancelled", allowTake: true, action: takeAction(3, virtualSlot(true, 0), virtualSlot(false, 0)), answer: "cancel", wantAction: take},
+		{name: "not answered", allowTake: true, action: takeAction(3, virtualSlot(true, 0), virtualSlot(false, 0)), answer: "disconnected", wantAction: take},
+		{name: "place", allowTake: true, action: placeAction(1, virtualSlot(false, 0), virtualSlot(true, 0)), wantAction: place},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager(nil, slog.New(slog.NewTextHandler(io.Discard, nil)), nil, nil, nil)
+			shop := newTestPlugin(m, "shop")
+			spy := newTestPlugin(m, "spy")
+			subscribe(spy, pb.EventType_VIRTUAL_INVENTORY_CLICK, &pb.EventSubscription{})
+			w, h, c, fake := joinTestPlayer(t, m)
+			slots := make([]item.Stack, 27)
+			slots[0] = item.NewStack(item.Diamond{}, 5)
+			inv := &virtualInventory{pluginID: "shop", id: "menu", typ: pb.VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST, allowTake: tt.allowTake, slots: slots}
+			c.virtual = inv
+
+			clicks := make(chan *pb.EventEnvelope, 1)
+			switch tt.answer {
+			case "ok":
+				respond(t, shop, func(evt *pb.EventEnvelope) *pb.EventResult {
+					clicks <- evt
+					return &pb.EventResult{}
+				})
+			case "cancel":
+				respond(t, shop, func(evt *pb.EventEnvelope) *pb.EventResult {
+					clicks <- evt
+					return cancel(evt)
+				})
+			case "disconnected":
+				shop.connected.Store(false)
+			}
+
+			c.handleVirtualClicks(&packet.ItemStackRequest{Requests: []protocol.ItemStackRequest{{
+				Actions: []protocol.StackRequestAction{tt.action, takeAction(1, virtualSlot(false, 0), virtualSlot(false, 1))},
+			}}})
+
+			var evt *pb.EventEnvelope
+			switch tt.answer {
+			case "ok", "cancel":
+				evt = <-clicks
+			case "":
+				if evts := events(shop); len(evts) == 1 && !evts[0].ExpectsResponse {
+					evt = evts[0]
+				} else {
+					t.Fatalf("shop received %v, want the click reported", evts)
+				}
+			}
+			if evt != nil {
+				click := evt.GetVirtualInventoryClick()
+				if click.GetInventoryId() != "menu" || click.GetSlot() != 0 || click.GetAction() != tt.wantAction || click.GetItem().GetCount() != 5 {
+					t.Errorf("click = %v, want a %v on slot 0 of menu", click, tt.wantAction)
+				}
+			}
+			if evts := events(spy); len(evts) != 0 {
+				t.Errorf("spy received %v, want the click sent only to the shop", evts)
+			}
+
+			var taken int
+			inTx(w, h, func(tx *world.Tx, pl *player.Player) {
+				for _, it := range pl.Inventory().Items() {
+					taken += it.Count()
+				}
+			})
+			if taken != tt.taken {
+				t.Errorf("player has %d diamonds, want %d", taken, tt.taken)
+			}
+			if it, _ := inv.slot(0); it.Count() != 5-tt.taken {
+				t.Errorf("slot holds %d diamonds, want %d", it.Count(), 5-tt.taken)
+			}
+
+			// The clicked slot is sent again once the session rejected the request.
+			if len(c.after) != 1 {
+				t.Fatalf("%d functions run after the request, want 1", len(c.after))
+			}
+			c.after[0]()
+			pks := fake.packets()
+			if len(pks) != 1 {
+				t.Fatalf("wrote %v, want the slot sent again", pks)
+			}
+			if slot, ok := pks[0].(*packet.InventorySlot); !ok || slot.WindowID != uint32(virtualWindowID) || slot.Slot != 0 || int(slot.NewItem.Stack.Count) != 5-tt.taken {
+				t.Errorf("packet = %#v, want slot 0 with %d diamonds", pks[0], 5-tt.taken)
+			}
+		})
+	}
+}
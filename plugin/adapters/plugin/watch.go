@@ -0,0 +1,197 @@
+package plugin
+
+import (
+	"context"
+	"io/fs"
+	"maps"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+	pb "github.com/secmc/plugin/proto/generated/go"
+)
+
+const (
+	defaultWatchDebounce = 500 * time.Millisecond
+	watchPollInterval    = 250 * time.Millisecond
+	reloadHelloTimeout   = 10 * time.Second
+)
+
+// fileStamp identifies a version of a watched file.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// workDirWatcher detects changes to a plugin's work directory by polling it, so that it works
+// the same on every platform and file system.
+type workDirWatcher struct {
+	root     string
+	globs    []string
+	debounce time.Duration
+	interval time.Duration
+}
+
+func newWorkDirWatcher(root string, cfg config.WatchConfig) *workDirWatcher {
+	w := &workDirWatcher{
+		root:     root,
+		globs:    cfg.Globs,
+		debounce: time.Duration(cfg.DebounceMs) * time.Millisecond,
+		interval: watchPollInterval,
+	}
+	if w.debounce <= 0 {
+		w.debounce = defaultWatchDebounce
+	}
+	return w
+}
+
+// snapshot returns the stamps of all watched files. VCS metadata and dependency directories are
+// skipped.
+func (w *workDirWatcher) snapshot() map[string]fileStamp {
+	files := make(map[string]fileStamp)
+	_ = filepath.WalkDir(w.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			switch d.Name() {
+			case ".git", "node_modules", "vendor":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(w.root, p)
+		if err != nil || !w.matches(filepath.ToSlash(rel)) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		files[rel] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+		return nil
+	})
+	return files
+}
+
+// matches reports whether the slash separated relative path rel is selected by the globs.
+func (w *workDirWatcher) matches(rel string) bool {
+	if len(w.globs) == 0 {
+		return true
+	}
+	for _, glob := range w.globs {
+		if ok, _ := path.Match(glob, rel); ok {
+			return true
+		}
+		if ok, _ := path.Match(glob, path.Base(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// watchWorkDir restarts the plugin whenever its watched files change and then stay unchanged for
+// the debounce period.
+func (p *pluginProcess) watchWorkDir(ctx context.Context) {
+	defer p.wg.Done()
+	w := newWorkDirWatcher(p.cfg.WorkDir.Path, p.cfg.Watch)
+	p.log.Info("watching plugin files", "path", w.root, "globs", w.globs)
+	w.watch(ctx, p.done, func() { p.hotReload(ctx) })
+}
+
+// watch polls the watched files until ctx is done or done is closed and calls reload once they
+// changed and then stayed unchanged for the debounce period.
+func (w *workDirWatcher) watch(ctx context.Context, done <-chan struct{}, reload func()) {
+	last := w.snapshot()
+	var changedAt time.Time
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		current := w.snapshot()
+		if !maps.Equal(current, last) {
+			last = current
+			changedAt = time.Now()
+			continue
+		}
+		if !changedAt.IsZero() && time.Since(changedAt) >= w.debounce {
+			changedAt = time.Time{}
+			reload()
+		}
+	}
+}
+
+// hotReload restarts the plugin command in place. The old process gets HostShutdown and its grace
+// period, the new one is launched with the same DF_HOST_BOOT_ID so that it can tell the reload
+// from a cold start. Commands and subscriptions stay active until the new process replaces them
+// with its Hello and EventSubscribe.
+func (p *pluginProcess) hotReload(ctx context.Context) {
+	if p.closed.Load() || p.shuttingDown.Load() {
+		return
+	}
+	p.log.Info("plugin files changed, reloading")
+
+	p.reloading.Store(true)
+	exited := p.processExited()
+	if p.connected.Load() {
+		grace := p.shutdownGrace()
+		p.queue(&pb.HostToPlugin{
+			PluginId: p.id,
+			Payload: &pb.HostToPlugin_Shutdown{
+				Shutdown: &pb.HostShutdown{Reason: "reloading", GracePeriodMs: grace.Milliseconds()},
+			},
+		})
+		if !p.waitShutdown(exited, grace) {
+			p.log.Warn("plugin did not shut down within grace period", "grace", grace)
+		}
+	}
+	p.terminate(exited)
+	if exited != nil {
+		select {
+		case <-exited:
+		case <-time.After(terminateTimeout):
+		}
+	}
+	p.clearStream()
+	p.setHello(nil)
+	p.resetShutdownAck()
+	p.reloading.Store(false)
+
+	if p.closed.Load() || p.shuttingDown.Load() {
+		return
+	}
+	// Changed files may well fix a plugin that gave up after crash looping.
+	p.failed.Store(false)
+	if err := p.launchProcess(ctx, p.serverAddress); err != nil {
+		p.log.Error("relaunch plugin", "error", err)
+		return
+	}
+	if p.waitHello(reloadHelloTimeout) {
+		p.log.Info("plugin reloaded")
+	} else {
+		p.log.Warn("reloaded plugin did not send hello", "timeout", reloadHelloTimeout)
+	}
+}
+
+// waitHello blocks until the plugin sent a PluginHello, or the timeout or plugin shutdown.
+func (p *pluginProcess) waitHello(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		if p.helloInfo() != nil {
+			return true
+		}
+		select {
+		case <-p.done:
+			return false
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	return p.helloInfo() != nil
+}
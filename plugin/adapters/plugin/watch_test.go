@@ -0,0 +1,139 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/secmc/plugin/plugin/config"
+)
+
+// writeFile writes data to the slash separated path rel under root, creating its directories.
+func writeFile(t *testing.T, root, rel, data string) {
+	t.Helper()
+	p := filepath.Join(root, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWorkDirWatcherMatches(t *testing.T) {
+	tests := []struct {
+		globs []string
+		rel   string
+		want  bool
+	}{
+		{nil, "main.go", true},
+		{nil, "deep/dir/file.txt", true},
+		{[]string{"*.go"}, "main.go", true},
+		// Globs without a directory match the base name at any depth.
+		{[]string{"*.go"}, "internal/shop/shop.go", true},
+		{[]string{"*.go"}, "go.mod", false},
+		{[]string{"config.yaml"}, "sub/config.yaml", true},
+		// Globs with a directory match the relative path.
+		{[]string{"src/*.ts"}, "src/index.ts", true},
+		{[]string{"src/*.ts"}, "lib/src/index.ts", false},
+		{[]string{"src/*.ts"}, "src/util/index.ts", false},
+		{[]string{"*.ts", "*.json"}, "package.json", true},
+		{[]string{"*.ts", "*.json"}, "README.md", false},
+		{[]string{"[bad"}, "main.go", false},
+	}
+	for _, tt := range tests {
+		w := newWorkDirWatcher("", config.WatchConfig{Globs: tt.globs})
+		if got := w.matches(tt.rel); got != tt.want {
+			t.Errorf("globs %q: matches(%q) = %v, want %v", tt.globs, tt.rel, got, tt.want)
+		}
+	}
+}
+
+func TestWorkDirWatcherSnapshot(t *testing.T) {
+	root := t.TempDir()
+	for _, rel := range []string{"main.go", "pkg/shop.go", "README.md", ".git/HEAD.go", "node_modules/x/index.go", "vendor/y/y.go"} {
+		writeFile(t, root, rel, "package x")
+	}
+	w := newWorkDirWatcher(root, config.WatchConfig{Globs: []string{"*.go"}})
+
+	var files []string
+	for rel := range w.snapshot() {
+		files = append(files, filepath.ToSlash(rel))
+	}
+	slices.Sort(files)
+	if want := []string{"main.go", "pkg/shop.go"}; !slices.Equal(files, want) {
+		t.Errorf("snapshot has %v, want %v", files, want)
+	}
+}
+
+func TestNewWorkDirWatcherDebounce(t *testing.T) {
+	if w := newWorkDirWatcher("", config.WatchConfig{}); w.debounce != defaultWatchDebounce {
+		t.Errorf("debounce = %v, want the default of %v", w.debounce, defaultWatchDebounce)
+	}
+	if w := newWorkDirWatcher("", config.WatchConfig{DebounceMs: 1500}); w.debounce != 1500*time.Millisecond {
+		t.Errorf("debounce = %v, want 1.5s", w.debounce)
+	}
+}
+
+func TestWorkDirWatcherWatch(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "main.go", "package main")
+	w := newWorkDirWatcher(root, config.WatchConfig{Globs: []string{"*.go"}, DebounceMs: 200})
+	w.interval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reloads := make(chan time.Time, 4)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		w.watch(ctx, nil, func() { reloads <- time.Now() })
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-stopped
+	})
+	expectNoReload := func(d time.Duration) {
+		t.Helper()
+		select {
+		case <-reloads:
+			t.Fatal("reloaded without a change to a watched file")
+		case <-time.After(d):
+		}
+	}
+
+	// Let the watcher take its first snapshot.
+	expectNoReload(50 * time.Millisecond)
+	writeFile(t, root, "README.md", "unwatched")
+	expectNoReload(300 * time.Millisecond)
+
+	// Every change within the debounce period postpones the reload.
+	writeFile(t, root, "main.go", "package main // 1")
+	time.Sleep(100 * time.Millisecond)
+	writeFile(t, root, "pkg/shop.go", "package pkg")
+	time.Sleep(100 * time.Millisecond)
+	writeFile(t, root, "main.go", strings.Repeat("package main // 3", 2))
+	last := time.Now()
+	select {
+	case at := <-reloads:
+		if since := at.Sub(last); since < 200*time.Millisecond {
+			t.Errorf("reloaded %v after the last change, want the debounce period of 200ms", since)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("not reloaded after watched files changed")
+	}
+	expectNoReload(400 * time.Millisecond)
+
+	// Removed files count as changes too.
+	if err := os.Remove(filepath.Join(root, "pkg", "shop.go")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-reloads:
+	case <-time.After(2 * time.Second):
+		t.Fatal("not reloaded after a watched file was removed")
+	}
+}
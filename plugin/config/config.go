@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 
 	"gopkg.in/yaml.v2"
@@ -13,13 +14,47 @@ import (
 // ConfigFile is the default configuration file used for plugin definitions.
 const ConfigFile = "plugins/plugins.yaml"
 
+// Restart policies accepted in RestartConfig.Policy.
+const (
+	RestartNever     = "never"
+	RestartOnFailure = "on-failure"
+	RestartAlways    = "always"
+)
+
+// Overflow policies accepted in QueueConfig.Overflow.
+const (
+	OverflowDropNewest = "drop-newest"
+	OverflowDropOldest = "drop-oldest"
+	OverflowBlock      = "block"
+	OverflowDisconnect = "disconnect"
+)
+
 type Config struct {
-	ServerAddr      string         `yaml:"server_addr"`
-	RequiredPlugins []string       `yaml:"required_plugins"`
-	HelloTimeoutMs  int            `yaml:"hello_timeout_ms"`
+	ServerAddr      string   `yaml:"server_addr"`
+	RequiredPlugins []string `yaml:"required_plugins"`
+	HelloTimeoutMs  int      `yaml:"hello_timeout_ms"`
+	// EventTimeoutMs is the default time to wait for a plugin's result to a cancellable event.
+	EventTimeoutMs int `yaml:"event_timeout_ms"`
+	// ShutdownGraceMs is the default time a plugin gets to acknowledge HostShutdown or exit.
+	ShutdownGraceMs int            `yaml:"shutdown_grace_ms"`
+	TLS             TLSConfig      `yaml:"tls"`
 	Plugins         []PluginConfig `yaml:"plugins"`
 }
 
+// TLSConfig secures the plugin gRPC server. TLS is enabled when CertFile is set.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ClientCAFile enables mutual TLS: plugins must present a certificate signed by one of these
+	// CAs, whose common name or a SAN names the plugin ID.
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+// Enabled reports whether the plugin server should use TLS.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
 type PluginConfig struct {
 	ID      string   `yaml:"id"`
 	Name    string   `yaml:"name"`
@@ -35,9 +70,119 @@ type PluginConfig struct {
 	} `yaml:"work_dir"`
 	Env     map[string]string `yaml:"env"`
 	Address string            `yaml:"address"`
+	// Token is the secret the plugin must present when connecting. Plugins launched from Command
+	// get a generated one through DF_PLUGIN_TOKEN when it is empty. External plugins must set it
+	// unless mutual TLS is configured or Insecure is set.
+	Token string `yaml:"token"`
+	// Insecure allows an external plugin without a token to load without mutual TLS. Any client
+	// using its ID is then accepted.
+	Insecure bool `yaml:"insecure"`
+
+	// EventTimeoutMs overrides Config.EventTimeoutMs for this plugin.
+	EventTimeoutMs int `yaml:"event_timeout_ms"`
+	// EventTimeoutsMs overrides the timeout per event type, keyed by EventType name (e.g. CHAT).
+	EventTimeoutsMs map[string]int       `yaml:"event_timeouts_ms"`
+	CircuitBreaker  CircuitBreakerConfig `yaml:"circuit_breaker"`
+	Restart         RestartConfig        `yaml:"restart"`
+	// ShutdownGraceMs overrides Config.ShutdownGraceMs for this plugin.
+	ShutdownGraceMs int               `yaml:"shutdown_grace_ms"`
+	Watch           WatchConfig       `yaml:"watch"`
+	Permissions     PermissionsConfig `yaml:"permissions"`
+	Queue           QueueConfig       `yaml:"queue"`
+}
+
+// QueueConfig bounds the queues between the host and a plugin and decides what happens when one
+// is full.
+type QueueConfig struct {
+	// EventSize is the number of messages, mostly events, queued for sending to the plugin. Defaults to 256.
+	EventSize int `yaml:"event_size"`
+	// ActionSize is the number of action batches queued for execution. Defaults to 1024.
+	ActionSize int `yaml:"action_size"`
+	// Overflow is one of "drop-newest" (default), "drop-oldest", "block" or "disconnect".
+	Overflow string `yaml:"overflow"`
+	// BlockTimeoutMs is how long the "block" policy waits for room before dropping. Defaults to 100.
+	BlockTimeoutMs int `yaml:"block_timeout_ms"`
+}
+
+// PermissionsConfig limits the actions a plugin may run, by action family such as "player.kick"
+// or "world.query". Entries may end in ".*" to match a whole group, and "*" matches everything.
+type PermissionsConfig struct {
+	// Allow lists the permitted families. An empty list permits every family.
+	Allow []string `yaml:"allow"`
+	// Deny lists families that are refused even if Allow matches them.
+	Deny []string `yaml:"deny"`
+}
+
+// WatchConfig enables hot reload: the plugin command is restarted when files under WorkDir.Path change.
+type WatchConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Globs selects the watched files. Each pattern is matched against the path relative to
+	// WorkDir.Path and against the file name. Defaults to every file.
+	Globs []string `yaml:"globs"`
+	// DebounceMs is how long the files must stay unchanged before the plugin is restarted. Defaults to 500.
+	DebounceMs int `yaml:"debounce_ms"`
+}
+
+// RestartConfig controls how a launched plugin command is supervised after it exits.
+type RestartConfig struct {
+	// Policy is one of "never" (default), "on-failure" or "always".
+	Policy string `yaml:"policy"`
+	// InitialBackoffMs is the delay before the first restart. Defaults to 1000.
+	InitialBackoffMs int `yaml:"initial_backoff_ms"`
+	// MaxBackoffMs caps the exponential backoff between restarts. Defaults to 30000.
+	MaxBackoffMs int `yaml:"max_backoff_ms"`
+	// MaxRestarts is the number of restarts allowed within WindowMs before the plugin is
+	// considered crash looping and marked failed. Defaults to 5.
+	MaxRestarts int `yaml:"max_restarts"`
+	// WindowMs is the crash-loop detection window. A process that stays up this long also
+	// resets the backoff. Defaults to 60000.
+	WindowMs int `yaml:"window_ms"`
+}
+
+// CircuitBreakerConfig controls when the host stops waiting on a plugin that keeps timing out.
+type CircuitBreakerConfig struct {
+	Disabled bool `yaml:"disabled"`
+	// Threshold is the number of consecutive timeouts that opens the breaker. Defaults to 5.
+	Threshold int `yaml:"threshold"`
+	// CooldownMs is how long the breaker stays open before the next event is awaited again. Defaults to 10000.
+	CooldownMs int `yaml:"cooldown_ms"`
 }
 
 func LoadConfig(path string) (Config, error) {
+	cfg, err := decodeConfig(path)
+	if err != nil {
+		return Config{}, err
+	}
+	for i := range cfg.Plugins {
+		if err := preparePlugin(&cfg.Plugins[i]); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
+
+// LoadPluginConfig reads the configuration at path and prepares only the plugin with the given ID.
+// Work directories of other plugins are left untouched, so it is safe to call while they run.
+func LoadPluginConfig(path, id string) (PluginConfig, error) {
+	cfg, err := decodeConfig(path)
+	if err != nil {
+		return PluginConfig{}, err
+	}
+	for i := range cfg.Plugins {
+		pl := &cfg.Plugins[i]
+		if pl.ID != id {
+			continue
+		}
+		if err := preparePlugin(pl); err != nil {
+			return PluginConfig{}, err
+		}
+		return *pl, nil
+	}
+	return PluginConfig{}, fmt.Errorf("plugin %q not found in %s", id, path)
+}
+
+// decodeConfig reads and validates the configuration file and fills in defaults.
+func decodeConfig(path string) (Config, error) {
 	if path == "" {
 		path = ConfigFile
 	}
@@ -65,47 +210,68 @@ func LoadConfig(path string) (Config, error) {
 		if pl.ID == "" {
 			pl.ID = fmt.Sprintf("plugin-%d", i+1)
 		}
-		if pl.Command == "" || pl.WorkDir.Path == "" {
-			continue
+	}
+	return cfg, nil
+}
+
+// preparePlugin validates a plugin entry and resolves its work directory, cloning it first if
+// it is a git remote.
+func preparePlugin(pl *PluginConfig) error {
+	switch pl.Restart.Policy {
+	case "", RestartNever, RestartOnFailure, RestartAlways:
+	default:
+		return fmt.Errorf("plugin %q: unknown restart policy %q", pl.ID, pl.Restart.Policy)
+	}
+	switch pl.Queue.Overflow {
+	case "", OverflowDropNewest, OverflowDropOldest, OverflowBlock, OverflowDisconnect:
+	default:
+		return fmt.Errorf("plugin %q: unknown queue overflow policy %q", pl.ID, pl.Queue.Overflow)
+	}
+	for _, glob := range pl.Watch.Globs {
+		if _, err := path.Match(glob, ""); err != nil {
+			return fmt.Errorf("plugin %q: invalid watch glob %q: %w", pl.ID, glob, err)
 		}
+	}
+	if pl.Command == "" || pl.WorkDir.Path == "" {
+		return nil
+	}
 
-		if pl.WorkDir.Git.Enabled {
-			path := filepath.Join(os.TempDir(), pl.ID)
-			remote := pl.WorkDir.Path
+	if pl.WorkDir.Git.Enabled {
+		path := filepath.Join(os.TempDir(), pl.ID)
+		remote := pl.WorkDir.Path
 
-			needClone := true
-			if pl.WorkDir.Git.Persistent {
-				if _, err := os.Stat(path); err == nil {
-					needClone = false
-				} else if !errors.Is(err, os.ErrNotExist) {
-					return cfg, fmt.Errorf("stat remote plugin %q: %w", pl.ID, err)
-				}
-			} else {
-				if err := os.RemoveAll(path); err != nil {
-					return cfg, fmt.Errorf("reset remote plugin %q: %w", pl.ID, err)
-				}
+		needClone := true
+		if pl.WorkDir.Git.Persistent {
+			if _, err := os.Stat(path); err == nil {
+				needClone = false
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("stat remote plugin %q: %w", pl.ID, err)
+			}
+		} else {
+			if err := os.RemoveAll(path); err != nil {
+				return fmt.Errorf("reset remote plugin %q: %w", pl.ID, err)
 			}
+		}
 
-			if needClone {
-				if err := run("git", "", "clone", remote, path, "--depth=1"); err != nil {
-					return cfg, fmt.Errorf("clone remote plugin %q: %w", pl.ID, err)
-				}
+		if needClone {
+			if err := run("git", "", "clone", remote, path, "--depth=1"); err != nil {
+				return fmt.Errorf("clone remote plugin %q: %w", pl.ID, err)
+			}
 
-				if pl.WorkDir.Git.Version != "" {
-					if err := run("git", path, "checkout", "--detach", pl.WorkDir.Git.Version); err != nil {
-						return cfg, err
-					}
+			if pl.WorkDir.Git.Version != "" {
+				if err := run("git", path, "checkout", "--detach", pl.WorkDir.Git.Version); err != nil {
+					return err
 				}
 			}
-
-			pl.WorkDir.Path = path
 		}
 
-		if !filepath.IsAbs(pl.WorkDir.Path) {
-			pl.WorkDir.Path = filepath.Clean(pl.WorkDir.Path)
-		}
+		pl.WorkDir.Path = path
 	}
-	return cfg, nil
+
+	if !filepath.IsAbs(pl.WorkDir.Path) {
+		pl.WorkDir.Path = filepath.Clean(pl.WorkDir.Path)
+	}
+	return nil
 }
 
 func run(bin string, path string, args ...string) error {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/df-mc/dragonfly/server/block/cube"
 	"github.com/df-mc/dragonfly/server/item"
+	"github.com/df-mc/dragonfly/server/item/inventory"
 	"github.com/df-mc/dragonfly/server/player"
 	"github.com/df-mc/dragonfly/server/player/skin"
 	"github.com/df-mc/dragonfly/server/session"
@@ -72,6 +73,9 @@ type EventManager interface {
 	EmitPlayerItemDrop(ctx *player.Context, p *player.Player, it item.Stack)
 	EmitPlayerTransfer(ctx *player.Context, p *player.Player, addr *net.UDPAddr)
 	EmitPlayerDiagnostics(p *player.Player, d session.Diagnostics)
+	EmitPlayerInventorySlotChange(ctx *inventory.Context, p *player.Player, kind pb.InventoryType, container *cube.Pos, slot int, action pb.InventorySlotAction, it, before, after item.Stack)
+	EmitContainerOpen(ctx *player.Context, p *player.Player, pos cube.Pos, b world.Block)
+	EmitContainerClose(ctx *player.Context, p *player.Player, pos cube.Pos, b world.Block, serverSide bool)
 	EmitWorldLiquidFlow(ctx *world.Context, from, into cube.Pos, liquid world.Liquid, replaced world.Block)
 	EmitWorldLiquidDecay(ctx *world.Context, pos cube.Pos, before, after world.Liquid)
 	EmitWorldLiquidHarden(ctx *world.Context, pos cube.Pos, liquidHardened, otherLiquid world.Block, newBlock world.Block)
@@ -90,6 +94,10 @@ type PlayerHandlerFactory func(manager EventManager) player.Handler
 
 type WorldHandlerFactory func(manager EventManager) world.Handler
 
+// InventoryHandlerFactory creates the handler of inv, an inventory of a player or, if container is set, the
+// inventory of the container block at that position.
+type InventoryHandlerFactory func(manager EventManager, inv *inventory.Inventory, kind pb.InventoryType, container *cube.Pos) inventory.Handler
+
 type PluginService interface {
 	PluginManager
 	EventManager
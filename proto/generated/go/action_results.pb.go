@@ -42,6 +42,13 @@ type ActionResult struct {
 	//	*ActionResult_WorldSnowingAt
 	//	*ActionResult_WorldThunderingAt
 	//	*ActionResult_WorldLiquid
+	//	*ActionResult_Batch
+	//	*ActionResult_GiveItem
+	//	*ActionResult_ClearInventory
+	//	*ActionResult_PlayerDropItem
+	//	*ActionResult_Inventory
+	//	*ActionResult_InventoryRemove
+	//	*ActionResult_WorldSpawnEntity
 	Result        isActionResult_Result `protobuf_oneof:"result"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -233,6 +240,69 @@ func (x *ActionResult) GetWorldLiquid() *WorldLiquidResult {
 	return nil
 }
 
+func (x *ActionResult) GetBatch() *BatchResult {
+	if x != nil {
+		if x, ok := x.Result.(*ActionResult_Batch); ok {
+			return x.Batch
+		}
+	}
+	return nil
+}
+
+func (x *ActionResult) GetGiveItem() *GiveItemResult {
+	if x != nil {
+		if x, ok := x.Result.(*ActionResult_GiveItem); ok {
+			return x.GiveItem
+		}
+	}
+	return nil
+}
+
+func (x *ActionResult) GetClearInventory() *ClearInventoryResult {
+	if x != nil {
+		if x, ok := x.Result.(*ActionResult_ClearInventory); ok {
+			return x.ClearInventory
+		}
+	}
+	return nil
+}
+
+func (x *ActionResult) GetPlayerDropItem() *PlayerDropItemResult {
+	if x != nil {
+		if x, ok := x.Result.(*ActionResult_PlayerDropItem); ok {
+			return x.PlayerDropItem
+		}
+	}
+	return nil
+}
+
+func (x *ActionResult) GetInventory() *InventoryResult {
+	if x != nil {
+		if x, ok := x.Result.(*ActionResult_Inventory); ok {
+			return x.Inventory
+		}
+	}
+	return nil
+}
+
+func (x *ActionResult) GetInventoryRemove() *InventoryRemoveResult {
+	if x != nil {
+		if x, ok := x.Result.(*ActionResult_InventoryRemove); ok {
+			return x.InventoryRemove
+		}
+	}
+	return nil
+}
+
+func (x *ActionResult) GetWorldSpawnEntity() *WorldSpawnEntityResult {
+	if x != nil {
+		if x, ok := x.Result.(*ActionResult_WorldSpawnEntity); ok {
+			return x.WorldSpawnEntity
+		}
+	}
+	return nil
+}
+
 type isActionResult_Result interface {
 	isActionResult_Result()
 }
@@ -297,6 +367,34 @@ type ActionResult_WorldLiquid struct {
 	WorldLiquid *WorldLiquidResult `protobuf:"bytes,24,opt,name=world_liquid,json=worldLiquid,proto3,oneof"`
 }
 
+type ActionResult_Batch struct {
+	Batch *BatchResult `protobuf:"bytes,25,opt,name=batch,proto3,oneof"`
+}
+
+type ActionResult_GiveItem struct {
+	GiveItem *GiveItemResult `protobuf:"bytes,26,opt,name=give_item,json=giveItem,proto3,oneof"`
+}
+
+type ActionResult_ClearInventory struct {
+	ClearInventory *ClearInventoryResult `protobuf:"bytes,27,opt,name=clear_inventory,json=clearInventory,proto3,oneof"`
+}
+
+type ActionResult_PlayerDropItem struct {
+	PlayerDropItem *PlayerDropItemResult `protobuf:"bytes,28,opt,name=player_drop_item,json=playerDropItem,proto3,oneof"`
+}
+
+type ActionResult_Inventory struct {
+	Inventory *InventoryResult `protobuf:"bytes,29,opt,name=inventory,proto3,oneof"`
+}
+
+type ActionResult_InventoryRemove struct {
+	InventoryRemove *InventoryRemoveResult `protobuf:"bytes,30,opt,name=inventory_remove,json=inventoryRemove,proto3,oneof"`
+}
+
+type ActionResult_WorldSpawnEntity struct {
+	WorldSpawnEntity *WorldSpawnEntityResult `protobuf:"bytes,31,opt,name=world_spawn_entity,json=worldSpawnEntity,proto3,oneof"`
+}
+
 func (*ActionResult_WorldEntities) isActionResult_Result() {}
 
 func (*ActionResult_WorldPlayers) isActionResult_Result() {}
@@ -327,6 +425,20 @@ func (*ActionResult_WorldThunderingAt) isActionResult_Result() {}
 
 func (*ActionResult_WorldLiquid) isActionResult_Result() {}
 
+func (*ActionResult_Batch) isActionResult_Result() {}
+
+func (*ActionResult_GiveItem) isActionResult_Result() {}
+
+func (*ActionResult_ClearInventory) isActionResult_Result() {}
+
+func (*ActionResult_PlayerDropItem) isActionResult_Result() {}
+
+func (*ActionResult_Inventory) isActionResult_Result() {}
+
+func (*ActionResult_InventoryRemove) isActionResult_Result() {}
+
+func (*ActionResult_WorldSpawnEntity) isActionResult_Result() {}
+
 type ActionStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
@@ -1263,12 +1375,337 @@ func (x *WorldLiquidResult) GetLiquid() *LiquidState {
 	return nil
 }
 
+// BatchResult holds the result of every action of an atomic ActionBatch, in batch order.
+type BatchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ActionResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_action_results_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_action_results_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_action_results_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BatchResult) GetResults() []*ActionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GiveItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Leftover      int32                  `protobuf:"varint,2,opt,name=leftover,proto3" json:"leftover,omitempty"` // items that did not fit into the inventory
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiveItemResult) Reset() {
+	*x = GiveItemResult{}
+	mi := &file_action_results_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiveItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiveItemResult) ProtoMessage() {}
+
+func (x *GiveItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_action_results_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiveItemResult.ProtoReflect.Descriptor instead.
+func (*GiveItemResult) Descriptor() ([]byte, []int) {
+	return file_action_results_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GiveItemResult) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *GiveItemResult) GetLeftover() int32 {
+	if x != nil {
+		return x.Leftover
+	}
+	return 0
+}
+
+type ClearInventoryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int32                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"` // total count of the items removed, including the offhand
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearInventoryResult) Reset() {
+	*x = ClearInventoryResult{}
+	mi := &file_action_results_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearInventoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearInventoryResult) ProtoMessage() {}
+
+func (x *ClearInventoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_action_results_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearInventoryResult.ProtoReflect.Descriptor instead.
+func (*ClearInventoryResult) Descriptor() ([]byte, []int) {
+	return file_action_results_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ClearInventoryResult) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type PlayerDropItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dropped       int32                  `protobuf:"varint,1,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerDropItemResult) Reset() {
+	*x = PlayerDropItemResult{}
+	mi := &file_action_results_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDropItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDropItemResult) ProtoMessage() {}
+
+func (x *PlayerDropItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_action_results_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDropItemResult.ProtoReflect.Descriptor instead.
+func (*PlayerDropItemResult) Descriptor() ([]byte, []int) {
+	return file_action_results_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PlayerDropItemResult) GetDropped() int32 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+// InventoryResult holds every slot of an inventory, in slot order. Empty slots have no item.
+type InventoryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *InventoryTarget       `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Slots         []*InventorySlot       `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryResult) Reset() {
+	*x = InventoryResult{}
+	mi := &file_action_results_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryResult) ProtoMessage() {}
+
+func (x *InventoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_action_results_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryResult.ProtoReflect.Descriptor instead.
+func (*InventoryResult) Descriptor() ([]byte, []int) {
+	return file_action_results_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InventoryResult) GetTarget() *InventoryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *InventoryResult) GetSlots() []*InventorySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type InventoryRemoveResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int32                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"` // total count of the items removed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryRemoveResult) Reset() {
+	*x = InventoryRemoveResult{}
+	mi := &file_action_results_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryRemoveResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryRemoveResult) ProtoMessage() {}
+
+func (x *InventoryRemoveResult) ProtoReflect() protoreflect.Message {
+	mi := &file_action_results_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryRemoveResult.ProtoReflect.Descriptor instead.
+func (*InventoryRemoveResult) Descriptor() ([]byte, []int) {
+	return file_action_results_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InventoryRemoveResult) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type WorldSpawnEntityResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entity        *EntityRef             `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldSpawnEntityResult) Reset() {
+	*x = WorldSpawnEntityResult{}
+	mi := &file_action_results_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldSpawnEntityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSpawnEntityResult) ProtoMessage() {}
+
+func (x *WorldSpawnEntityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_action_results_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSpawnEntityResult.ProtoReflect.Descriptor instead.
+func (*WorldSpawnEntityResult) Descriptor() ([]byte, []int) {
+	return file_action_results_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WorldSpawnEntityResult) GetEntity() *EntityRef {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
 var File_action_results_proto protoreflect.FileDescriptor
 
 const file_action_results_proto_rawDesc = "" +
 	"\n" +
-	"\x14action_results.proto\x12\tdf.plugin\x1a\fcommon.proto\"\x83\n" +
-	"\n" +
+	"\x14action_results.proto\x12\tdf.plugin\x1a\fcommon.proto\"\xe4\r\n" +
 	"\fActionResult\x12%\n" +
 	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x124\n" +
 	"\x06status\x18\x02 \x01(\v2\x17.df.plugin.ActionStatusH\x01R\x06status\x88\x01\x01\x12G\n" +
@@ -1290,7 +1727,14 @@ const file_action_results_proto_rawDesc = "" +
 	"\x10world_raining_at\x18\x15 \x01(\v2\x1f.df.plugin.WorldRainingAtResultH\x00R\x0eworldRainingAt\x12K\n" +
 	"\x10world_snowing_at\x18\x16 \x01(\v2\x1f.df.plugin.WorldSnowingAtResultH\x00R\x0eworldSnowingAt\x12T\n" +
 	"\x13world_thundering_at\x18\x17 \x01(\v2\".df.plugin.WorldThunderingAtResultH\x00R\x11worldThunderingAt\x12A\n" +
-	"\fworld_liquid\x18\x18 \x01(\v2\x1c.df.plugin.WorldLiquidResultH\x00R\vworldLiquidB\b\n" +
+	"\fworld_liquid\x18\x18 \x01(\v2\x1c.df.plugin.WorldLiquidResultH\x00R\vworldLiquid\x12.\n" +
+	"\x05batch\x18\x19 \x01(\v2\x16.df.plugin.BatchResultH\x00R\x05batch\x128\n" +
+	"\tgive_item\x18\x1a \x01(\v2\x19.df.plugin.GiveItemResultH\x00R\bgiveItem\x12J\n" +
+	"\x0fclear_inventory\x18\x1b \x01(\v2\x1f.df.plugin.ClearInventoryResultH\x00R\x0eclearInventory\x12K\n" +
+	"\x10player_drop_item\x18\x1c \x01(\v2\x1f.df.plugin.PlayerDropItemResultH\x00R\x0eplayerDropItem\x12:\n" +
+	"\tinventory\x18\x1d \x01(\v2\x1a.df.plugin.InventoryResultH\x00R\tinventory\x12M\n" +
+	"\x10inventory_remove\x18\x1e \x01(\v2 .df.plugin.InventoryRemoveResultH\x00R\x0finventoryRemove\x12Q\n" +
+	"\x12world_spawn_entity\x18\x1f \x01(\v2!.df.plugin.WorldSpawnEntityResultH\x00R\x10worldSpawnEntityB\b\n" +
 	"\x06resultB\t\n" +
 	"\a_status\"C\n" +
 	"\fActionStatus\x12\x0e\n" +
@@ -1359,7 +1803,23 @@ const file_action_results_proto_rawDesc = "" +
 	"\x05world\x18\x01 \x01(\v2\x13.df.plugin.WorldRefR\x05world\x12/\n" +
 	"\bposition\x18\x02 \x01(\v2\x13.df.plugin.BlockPosR\bposition\x123\n" +
 	"\x06liquid\x18\x03 \x01(\v2\x16.df.plugin.LiquidStateH\x00R\x06liquid\x88\x01\x01B\t\n" +
-	"\a_liquidB\x91\x01\n" +
+	"\a_liquid\"@\n" +
+	"\vBatchResult\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.df.plugin.ActionResultR\aresults\"B\n" +
+	"\x0eGiveItemResult\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\x12\x1a\n" +
+	"\bleftover\x18\x02 \x01(\x05R\bleftover\"0\n" +
+	"\x14ClearInventoryResult\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\"0\n" +
+	"\x14PlayerDropItemResult\x12\x18\n" +
+	"\adropped\x18\x01 \x01(\x05R\adropped\"u\n" +
+	"\x0fInventoryResult\x122\n" +
+	"\x06target\x18\x01 \x01(\v2\x1a.df.plugin.InventoryTargetR\x06target\x12.\n" +
+	"\x05slots\x18\x02 \x03(\v2\x18.df.plugin.InventorySlotR\x05slots\"1\n" +
+	"\x15InventoryRemoveResult\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\"F\n" +
+	"\x16WorldSpawnEntityResult\x12,\n" +
+	"\x06entity\x18\x01 \x01(\v2\x14.df.plugin.EntityRefR\x06entityB\x91\x01\n" +
 	"\rcom.df.pluginB\x12ActionResultsProtoP\x01Z'github.com/secmc/plugin/proto/generated\xa2\x02\x03DPX\xaa\x02\tDf.Plugin\xca\x02\tDf\\Plugin\xe2\x02\x15Df\\Plugin\\GPBMetadata\xea\x02\n" +
 	"Df::Pluginb\x06proto3"
 
@@ -1375,7 +1835,7 @@ func file_action_results_proto_rawDescGZIP() []byte {
 	return file_action_results_proto_rawDescData
 }
 
-var file_action_results_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_action_results_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_action_results_proto_goTypes = []any{
 	(*ActionResult)(nil),               // 0: df.plugin.ActionResult
 	(*ActionStatus)(nil),               // 1: df.plugin.ActionStatus
@@ -1394,13 +1854,22 @@ var file_action_results_proto_goTypes = []any{
 	(*WorldSnowingAtResult)(nil),       // 14: df.plugin.WorldSnowingAtResult
 	(*WorldThunderingAtResult)(nil),    // 15: df.plugin.WorldThunderingAtResult
 	(*WorldLiquidResult)(nil),          // 16: df.plugin.WorldLiquidResult
-	(*WorldRef)(nil),                   // 17: df.plugin.WorldRef
-	(*EntityRef)(nil),                  // 18: df.plugin.EntityRef
-	(*BBox)(nil),                       // 19: df.plugin.BBox
-	(GameMode)(0),                      // 20: df.plugin.GameMode
-	(*BlockPos)(nil),                   // 21: df.plugin.BlockPos
-	(*BlockState)(nil),                 // 22: df.plugin.BlockState
-	(*LiquidState)(nil),                // 23: df.plugin.LiquidState
+	(*BatchResult)(nil),                // 17: df.plugin.BatchResult
+	(*GiveItemResult)(nil),             // 18: df.plugin.GiveItemResult
+	(*ClearInventoryResult)(nil),       // 19: df.plugin.ClearInventoryResult
+	(*PlayerDropItemResult)(nil),       // 20: df.plugin.PlayerDropItemResult
+	(*InventoryResult)(nil),            // 21: df.plugin.InventoryResult
+	(*InventoryRemoveResult)(nil),      // 22: df.plugin.InventoryRemoveResult
+	(*WorldSpawnEntityResult)(nil),     // 23: df.plugin.WorldSpawnEntityResult
+	(*WorldRef)(nil),                   // 24: df.plugin.WorldRef
+	(*EntityRef)(nil),                  // 25: df.plugin.EntityRef
+	(*BBox)(nil),                       // 26: df.plugin.BBox
+	(GameMode)(0),                      // 27: df.plugin.GameMode
+	(*BlockPos)(nil),                   // 28: df.plugin.BlockPos
+	(*BlockState)(nil),                 // 29: df.plugin.BlockState
+	(*LiquidState)(nil),                // 30: df.plugin.LiquidState
+	(*InventoryTarget)(nil),            // 31: df.plugin.InventoryTarget
+	(*InventorySlot)(nil),              // 32: df.plugin.InventorySlot
 }
 var file_action_results_proto_depIdxs = []int32{
 	1,  // 0: df.plugin.ActionResult.status:type_name -> df.plugin.ActionStatus
@@ -1419,43 +1888,54 @@ var file_action_results_proto_depIdxs = []int32{
 	14, // 13: df.plugin.ActionResult.world_snowing_at:type_name -> df.plugin.WorldSnowingAtResult
 	15, // 14: df.plugin.ActionResult.world_thundering_at:type_name -> df.plugin.WorldThunderingAtResult
 	16, // 15: df.plugin.ActionResult.world_liquid:type_name -> df.plugin.WorldLiquidResult
-	17, // 16: df.plugin.WorldEntitiesResult.world:type_name -> df.plugin.WorldRef
-	18, // 17: df.plugin.WorldEntitiesResult.entities:type_name -> df.plugin.EntityRef
-	17, // 18: df.plugin.WorldEntitiesWithinResult.world:type_name -> df.plugin.WorldRef
-	19, // 19: df.plugin.WorldEntitiesWithinResult.box:type_name -> df.plugin.BBox
-	18, // 20: df.plugin.WorldEntitiesWithinResult.entities:type_name -> df.plugin.EntityRef
-	17, // 21: df.plugin.WorldPlayersResult.world:type_name -> df.plugin.WorldRef
-	18, // 22: df.plugin.WorldPlayersResult.players:type_name -> df.plugin.EntityRef
-	17, // 23: df.plugin.WorldDefaultGameModeResult.world:type_name -> df.plugin.WorldRef
-	20, // 24: df.plugin.WorldDefaultGameModeResult.game_mode:type_name -> df.plugin.GameMode
-	17, // 25: df.plugin.WorldPlayerSpawnResult.world:type_name -> df.plugin.WorldRef
-	21, // 26: df.plugin.WorldPlayerSpawnResult.spawn:type_name -> df.plugin.BlockPos
-	17, // 27: df.plugin.WorldBlockResult.world:type_name -> df.plugin.WorldRef
-	21, // 28: df.plugin.WorldBlockResult.position:type_name -> df.plugin.BlockPos
-	22, // 29: df.plugin.WorldBlockResult.block:type_name -> df.plugin.BlockState
-	17, // 30: df.plugin.WorldBiomeResult.world:type_name -> df.plugin.WorldRef
-	21, // 31: df.plugin.WorldBiomeResult.position:type_name -> df.plugin.BlockPos
-	17, // 32: df.plugin.WorldLightResult.world:type_name -> df.plugin.WorldRef
-	21, // 33: df.plugin.WorldLightResult.position:type_name -> df.plugin.BlockPos
-	17, // 34: df.plugin.WorldSkyLightResult.world:type_name -> df.plugin.WorldRef
-	21, // 35: df.plugin.WorldSkyLightResult.position:type_name -> df.plugin.BlockPos
-	17, // 36: df.plugin.WorldTemperatureResult.world:type_name -> df.plugin.WorldRef
-	21, // 37: df.plugin.WorldTemperatureResult.position:type_name -> df.plugin.BlockPos
-	17, // 38: df.plugin.WorldHighestBlockResult.world:type_name -> df.plugin.WorldRef
-	17, // 39: df.plugin.WorldRainingAtResult.world:type_name -> df.plugin.WorldRef
-	21, // 40: df.plugin.WorldRainingAtResult.position:type_name -> df.plugin.BlockPos
-	17, // 41: df.plugin.WorldSnowingAtResult.world:type_name -> df.plugin.WorldRef
-	21, // 42: df.plugin.WorldSnowingAtResult.position:type_name -> df.plugin.BlockPos
-	17, // 43: df.plugin.WorldThunderingAtResult.world:type_name -> df.plugin.WorldRef
-	21, // 44: df.plugin.WorldThunderingAtResult.position:type_name -> df.plugin.BlockPos
-	17, // 45: df.plugin.WorldLiquidResult.world:type_name -> df.plugin.WorldRef
-	21, // 46: df.plugin.WorldLiquidResult.position:type_name -> df.plugin.BlockPos
-	23, // 47: df.plugin.WorldLiquidResult.liquid:type_name -> df.plugin.LiquidState
-	48, // [48:48] is the sub-list for method output_type
-	48, // [48:48] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	17, // 16: df.plugin.ActionResult.batch:type_name -> df.plugin.BatchResult
+	18, // 17: df.plugin.ActionResult.give_item:type_name -> df.plugin.GiveItemResult
+	19, // 18: df.plugin.ActionResult.clear_inventory:type_name -> df.plugin.ClearInventoryResult
+	20, // 19: df.plugin.ActionResult.player_drop_item:type_name -> df.plugin.PlayerDropItemResult
+	21, // 20: df.plugin.ActionResult.inventory:type_name -> df.plugin.InventoryResult
+	22, // 21: df.plugin.ActionResult.inventory_remove:type_name -> df.plugin.InventoryRemoveResult
+	23, // 22: df.plugin.ActionResult.world_spawn_entity:type_name -> df.plugin.WorldSpawnEntityResult
+	24, // 23: df.plugin.WorldEntitiesResult.world:type_name -> df.plugin.WorldRef
+	25, // 24: df.plugin.WorldEntitiesResult.entities:type_name -> df.plugin.EntityRef
+	24, // 25: df.plugin.WorldEntitiesWithinResult.world:type_name -> df.plugin.WorldRef
+	26, // 26: df.plugin.WorldEntitiesWithinResult.box:type_name -> df.plugin.BBox
+	25, // 27: df.plugin.WorldEntitiesWithinResult.entities:type_name -> df.plugin.EntityRef
+	24, // 28: df.plugin.WorldPlayersResult.world:type_name -> df.plugin.WorldRef
+	25, // 29: df.plugin.WorldPlayersResult.players:type_name -> df.plugin.EntityRef
+	24, // 30: df.plugin.WorldDefaultGameModeResult.world:type_name -> df.plugin.WorldRef
+	27, // 31: df.plugin.WorldDefaultGameModeResult.game_mode:type_name -> df.plugin.GameMode
+	24, // 32: df.plugin.WorldPlayerSpawnResult.world:type_name -> df.plugin.WorldRef
+	28, // 33: df.plugin.WorldPlayerSpawnResult.spawn:type_name -> df.plugin.BlockPos
+	24, // 34: df.plugin.WorldBlockResult.world:type_name -> df.plugin.WorldRef
+	28, // 35: df.plugin.WorldBlockResult.position:type_name -> df.plugin.BlockPos
+	29, // 36: df.plugin.WorldBlockResult.block:type_name -> df.plugin.BlockState
+	24, // 37: df.plugin.WorldBiomeResult.world:type_name -> df.plugin.WorldRef
+	28, // 38: df.plugin.WorldBiomeResult.position:type_name -> df.plugin.BlockPos
+	24, // 39: df.plugin.WorldLightResult.world:type_name -> df.plugin.WorldRef
+	28, // 40: df.plugin.WorldLightResult.position:type_name -> df.plugin.BlockPos
+	24, // 41: df.plugin.WorldSkyLightResult.world:type_name -> df.plugin.WorldRef
+	28, // 42: df.plugin.WorldSkyLightResult.position:type_name -> df.plugin.BlockPos
+	24, // 43: df.plugin.WorldTemperatureResult.world:type_name -> df.plugin.WorldRef
+	28, // 44: df.plugin.WorldTemperatureResult.position:type_name -> df.plugin.BlockPos
+	24, // 45: df.plugin.WorldHighestBlockResult.world:type_name -> df.plugin.WorldRef
+	24, // 46: df.plugin.WorldRainingAtResult.world:type_name -> df.plugin.WorldRef
+	28, // 47: df.plugin.WorldRainingAtResult.position:type_name -> df.plugin.BlockPos
+	24, // 48: df.plugin.WorldSnowingAtResult.world:type_name -> df.plugin.WorldRef
+	28, // 49: df.plugin.WorldSnowingAtResult.position:type_name -> df.plugin.BlockPos
+	24, // 50: df.plugin.WorldThunderingAtResult.world:type_name -> df.plugin.WorldRef
+	28, // 51: df.plugin.WorldThunderingAtResult.position:type_name -> df.plugin.BlockPos
+	24, // 52: df.plugin.WorldLiquidResult.world:type_name -> df.plugin.WorldRef
+	28, // 53: df.plugin.WorldLiquidResult.position:type_name -> df.plugin.BlockPos
+	30, // 54: df.plugin.WorldLiquidResult.liquid:type_name -> df.plugin.LiquidState
+	0,  // 55: df.plugin.BatchResult.results:type_name -> df.plugin.ActionResult
+	31, // 56: df.plugin.InventoryResult.target:type_name -> df.plugin.InventoryTarget
+	32, // 57: df.plugin.InventoryResult.slots:type_name -> df.plugin.InventorySlot
+	25, // 58: df.plugin.WorldSpawnEntityResult.entity:type_name -> df.plugin.EntityRef
+	59, // [59:59] is the sub-list for method output_type
+	59, // [59:59] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_action_results_proto_init() }
@@ -1480,6 +1960,13 @@ func file_action_results_proto_init() {
 		(*ActionResult_WorldSnowingAt)(nil),
 		(*ActionResult_WorldThunderingAt)(nil),
 		(*ActionResult_WorldLiquid)(nil),
+		(*ActionResult_Batch)(nil),
+		(*ActionResult_GiveItem)(nil),
+		(*ActionResult_ClearInventory)(nil),
+		(*ActionResult_PlayerDropItem)(nil),
+		(*ActionResult_Inventory)(nil),
+		(*ActionResult_InventoryRemove)(nil),
+		(*ActionResult_WorldSpawnEntity)(nil),
 	}
 	file_action_results_proto_msgTypes[1].OneofWrappers = []any{}
 	file_action_results_proto_msgTypes[16].OneofWrappers = []any{}
@@ -1489,7 +1976,7 @@ func file_action_results_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_action_results_proto_rawDesc), len(file_action_results_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
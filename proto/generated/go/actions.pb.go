@@ -260,9 +260,65 @@ func (HudElement) EnumDescriptor() ([]byte, []int) {
 	return file_actions_proto_rawDescGZIP(), []int{2}
 }
 
+// Virtual inventories
+type VirtualInventoryType int32
+
+const (
+	VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST        VirtualInventoryType = 0 // 27 slots
+	VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST VirtualInventoryType = 1 // 54 slots
+	VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_HOPPER       VirtualInventoryType = 2 // 5 slots
+)
+
+// Enum value maps for VirtualInventoryType.
+var (
+	VirtualInventoryType_name = map[int32]string{
+		0: "VIRTUAL_INVENTORY_TYPE_CHEST",
+		1: "VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST",
+		2: "VIRTUAL_INVENTORY_TYPE_HOPPER",
+	}
+	VirtualInventoryType_value = map[string]int32{
+		"VIRTUAL_INVENTORY_TYPE_CHEST":        0,
+		"VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST": 1,
+		"VIRTUAL_INVENTORY_TYPE_HOPPER":       2,
+	}
+)
+
+func (x VirtualInventoryType) Enum() *VirtualInventoryType {
+	p := new(VirtualInventoryType)
+	*p = x
+	return p
+}
+
+func (x VirtualInventoryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualInventoryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_actions_proto_enumTypes[3].Descriptor()
+}
+
+func (VirtualInventoryType) Type() protoreflect.EnumType {
+	return &file_actions_proto_enumTypes[3]
+}
+
+func (x VirtualInventoryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VirtualInventoryType.Descriptor instead.
+func (VirtualInventoryType) EnumDescriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{3}
+}
+
 type ActionBatch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Actions       []*Action              `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Actions []*Action              `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	// If set, every action is validated before any is applied, actions on the same world run in a
+	// single world transaction and one ActionResult with a BatchResult is returned for the batch.
+	// An action failing while it is applied keeps the actions applied before it, which report ok;
+	// the combined result is then "atomic batch partially applied".
+	Atomic        bool    `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	CorrelationId *string `protobuf:"bytes,3,opt,name=correlation_id,json=correlationId,proto3,oneof" json:"correlation_id,omitempty"` // correlation ID of the combined result of an atomic batch
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -304,6 +360,20 @@ func (x *ActionBatch) GetActions() []*Action {
 	return nil
 }
 
+func (x *ActionBatch) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *ActionBatch) GetCorrelationId() string {
+	if x != nil && x.CorrelationId != nil {
+		return *x.CorrelationId
+	}
+	return ""
+}
+
 type Action struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CorrelationId *string                `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3,oneof" json:"correlation_id,omitempty"`
@@ -320,6 +390,14 @@ type Action struct {
 	//	*Action_PlayerOpenBlockContainer
 	//	*Action_PlayerDropItem
 	//	*Action_PlayerSetItemCooldown
+	//	*Action_InventoryQuery
+	//	*Action_InventorySetSlot
+	//	*Action_InventoryRemoveItem
+	//	*Action_InventorySwapSlots
+	//	*Action_InventoryClearRange
+	//	*Action_PlayerOpenVirtualInventory
+	//	*Action_PlayerSetVirtualInventorySlots
+	//	*Action_PlayerCloseVirtualInventory
 	//	*Action_SetHealth
 	//	*Action_SetFood
 	//	*Action_SetExperience
@@ -344,6 +422,7 @@ type Action struct {
 	//	*Action_PlayerSendMenuForm
 	//	*Action_PlayerSendModalForm
 	//	*Action_PlayerSendDialogue
+	//	*Action_PlayerSendCustomForm
 	//	*Action_PlayerCloseDialogue
 	//	*Action_PlayerCloseForm
 	//	*Action_ExecuteCommand
@@ -400,6 +479,11 @@ type Action struct {
 	//	*Action_WorldSetLiquid
 	//	*Action_WorldScheduleBlockUpdate
 	//	*Action_WorldBuildStructure
+	//	*Action_WorldSpawnEntity
+	//	*Action_EntityRemove
+	//	*Action_EntityTeleport
+	//	*Action_EntitySetVelocity
+	//	*Action_EntitySetNameTag
 	//	*Action_WorldQueryEntities
 	//	*Action_WorldQueryPlayers
 	//	*Action_WorldQueryEntitiesWithin
@@ -563,6 +647,78 @@ func (x *Action) GetPlayerSetItemCooldown() *PlayerSetItemCooldownAction {
 	return nil
 }
 
+func (x *Action) GetInventoryQuery() *InventoryQueryAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_InventoryQuery); ok {
+			return x.InventoryQuery
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetInventorySetSlot() *InventorySetSlotAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_InventorySetSlot); ok {
+			return x.InventorySetSlot
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetInventoryRemoveItem() *InventoryRemoveItemAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_InventoryRemoveItem); ok {
+			return x.InventoryRemoveItem
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetInventorySwapSlots() *InventorySwapSlotsAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_InventorySwapSlots); ok {
+			return x.InventorySwapSlots
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetInventoryClearRange() *InventoryClearRangeAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_InventoryClearRange); ok {
+			return x.InventoryClearRange
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetPlayerOpenVirtualInventory() *PlayerOpenVirtualInventoryAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_PlayerOpenVirtualInventory); ok {
+			return x.PlayerOpenVirtualInventory
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetPlayerSetVirtualInventorySlots() *PlayerSetVirtualInventorySlotsAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_PlayerSetVirtualInventorySlots); ok {
+			return x.PlayerSetVirtualInventorySlots
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetPlayerCloseVirtualInventory() *PlayerCloseVirtualInventoryAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_PlayerCloseVirtualInventory); ok {
+			return x.PlayerCloseVirtualInventory
+		}
+	}
+	return nil
+}
+
 func (x *Action) GetSetHealth() *SetHealthAction {
 	if x != nil {
 		if x, ok := x.Kind.(*Action_SetHealth); ok {
@@ -779,6 +935,15 @@ func (x *Action) GetPlayerSendDialogue() *PlayerSendDialogueAction {
 	return nil
 }
 
+func (x *Action) GetPlayerSendCustomForm() *PlayerSendCustomFormAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_PlayerSendCustomForm); ok {
+			return x.PlayerSendCustomForm
+		}
+	}
+	return nil
+}
+
 func (x *Action) GetPlayerCloseDialogue() *PlayerCloseDialogueAction {
 	if x != nil {
 		if x, ok := x.Kind.(*Action_PlayerCloseDialogue); ok {
@@ -1283,6 +1448,51 @@ func (x *Action) GetWorldBuildStructure() *WorldBuildStructureAction {
 	return nil
 }
 
+func (x *Action) GetWorldSpawnEntity() *WorldSpawnEntityAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_WorldSpawnEntity); ok {
+			return x.WorldSpawnEntity
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetEntityRemove() *EntityRemoveAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_EntityRemove); ok {
+			return x.EntityRemove
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetEntityTeleport() *EntityTeleportAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_EntityTeleport); ok {
+			return x.EntityTeleport
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetEntitySetVelocity() *EntitySetVelocityAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_EntitySetVelocity); ok {
+			return x.EntitySetVelocity
+		}
+	}
+	return nil
+}
+
+func (x *Action) GetEntitySetNameTag() *EntitySetNameTagAction {
+	if x != nil {
+		if x, ok := x.Kind.(*Action_EntitySetNameTag); ok {
+			return x.EntitySetNameTag
+		}
+	}
+	return nil
+}
+
 func (x *Action) GetWorldQueryEntities() *WorldQueryEntitiesAction {
 	if x != nil {
 		if x, ok := x.Kind.(*Action_WorldQueryEntities); ok {
@@ -1468,6 +1678,40 @@ type Action_PlayerSetItemCooldown struct {
 	PlayerSetItemCooldown *PlayerSetItemCooldownAction `protobuf:"bytes,149,opt,name=player_set_item_cooldown,json=playerSetItemCooldown,proto3,oneof"`
 }
 
+type Action_InventoryQuery struct {
+	// Inventories: players and containers
+	InventoryQuery *InventoryQueryAction `protobuf:"bytes,160,opt,name=inventory_query,json=inventoryQuery,proto3,oneof"`
+}
+
+type Action_InventorySetSlot struct {
+	InventorySetSlot *InventorySetSlotAction `protobuf:"bytes,161,opt,name=inventory_set_slot,json=inventorySetSlot,proto3,oneof"`
+}
+
+type Action_InventoryRemoveItem struct {
+	InventoryRemoveItem *InventoryRemoveItemAction `protobuf:"bytes,162,opt,name=inventory_remove_item,json=inventoryRemoveItem,proto3,oneof"`
+}
+
+type Action_InventorySwapSlots struct {
+	InventorySwapSlots *InventorySwapSlotsAction `protobuf:"bytes,163,opt,name=inventory_swap_slots,json=inventorySwapSlots,proto3,oneof"`
+}
+
+type Action_InventoryClearRange struct {
+	InventoryClearRange *InventoryClearRangeAction `protobuf:"bytes,164,opt,name=inventory_clear_range,json=inventoryClearRange,proto3,oneof"`
+}
+
+type Action_PlayerOpenVirtualInventory struct {
+	// Virtual inventories: container windows that exist only for one player
+	PlayerOpenVirtualInventory *PlayerOpenVirtualInventoryAction `protobuf:"bytes,165,opt,name=player_open_virtual_inventory,json=playerOpenVirtualInventory,proto3,oneof"`
+}
+
+type Action_PlayerSetVirtualInventorySlots struct {
+	PlayerSetVirtualInventorySlots *PlayerSetVirtualInventorySlotsAction `protobuf:"bytes,166,opt,name=player_set_virtual_inventory_slots,json=playerSetVirtualInventorySlots,proto3,oneof"`
+}
+
+type Action_PlayerCloseVirtualInventory struct {
+	PlayerCloseVirtualInventory *PlayerCloseVirtualInventoryAction `protobuf:"bytes,167,opt,name=player_close_virtual_inventory,json=playerCloseVirtualInventory,proto3,oneof"`
+}
+
 type Action_SetHealth struct {
 	// Player: State & Attributes
 	SetHealth *SetHealthAction `protobuf:"bytes,20,opt,name=set_health,json=setHealth,proto3,oneof"`
@@ -1571,6 +1815,10 @@ type Action_PlayerSendDialogue struct {
 	PlayerSendDialogue *PlayerSendDialogueAction `protobuf:"bytes,152,opt,name=player_send_dialogue,json=playerSendDialogue,proto3,oneof"`
 }
 
+type Action_PlayerSendCustomForm struct {
+	PlayerSendCustomForm *PlayerSendCustomFormAction `protobuf:"bytes,153,opt,name=player_send_custom_form,json=playerSendCustomForm,proto3,oneof"`
+}
+
 type Action_PlayerCloseDialogue struct {
 	PlayerCloseDialogue *PlayerCloseDialogueAction `protobuf:"bytes,139,opt,name=player_close_dialogue,json=playerCloseDialogue,proto3,oneof"`
 }
@@ -1810,6 +2058,28 @@ type Action_WorldBuildStructure struct {
 	WorldBuildStructure *WorldBuildStructureAction `protobuf:"bytes,93,opt,name=world_build_structure,json=worldBuildStructure,proto3,oneof"`
 }
 
+type Action_WorldSpawnEntity struct {
+	// World: Entities
+	WorldSpawnEntity *WorldSpawnEntityAction `protobuf:"bytes,168,opt,name=world_spawn_entity,json=worldSpawnEntity,proto3,oneof"`
+}
+
+type Action_EntityRemove struct {
+	// Entities: non-player entities by UUID
+	EntityRemove *EntityRemoveAction `protobuf:"bytes,169,opt,name=entity_remove,json=entityRemove,proto3,oneof"`
+}
+
+type Action_EntityTeleport struct {
+	EntityTeleport *EntityTeleportAction `protobuf:"bytes,170,opt,name=entity_teleport,json=entityTeleport,proto3,oneof"`
+}
+
+type Action_EntitySetVelocity struct {
+	EntitySetVelocity *EntitySetVelocityAction `protobuf:"bytes,171,opt,name=entity_set_velocity,json=entitySetVelocity,proto3,oneof"`
+}
+
+type Action_EntitySetNameTag struct {
+	EntitySetNameTag *EntitySetNameTagAction `protobuf:"bytes,172,opt,name=entity_set_name_tag,json=entitySetNameTag,proto3,oneof"`
+}
+
 type Action_WorldQueryEntities struct {
 	// World: Queries - Entities & Players
 	WorldQueryEntities *WorldQueryEntitiesAction `protobuf:"bytes,70,opt,name=world_query_entities,json=worldQueryEntities,proto3,oneof"`
@@ -1896,6 +2166,22 @@ func (*Action_PlayerDropItem) isAction_Kind() {}
 
 func (*Action_PlayerSetItemCooldown) isAction_Kind() {}
 
+func (*Action_InventoryQuery) isAction_Kind() {}
+
+func (*Action_InventorySetSlot) isAction_Kind() {}
+
+func (*Action_InventoryRemoveItem) isAction_Kind() {}
+
+func (*Action_InventorySwapSlots) isAction_Kind() {}
+
+func (*Action_InventoryClearRange) isAction_Kind() {}
+
+func (*Action_PlayerOpenVirtualInventory) isAction_Kind() {}
+
+func (*Action_PlayerSetVirtualInventorySlots) isAction_Kind() {}
+
+func (*Action_PlayerCloseVirtualInventory) isAction_Kind() {}
+
 func (*Action_SetHealth) isAction_Kind() {}
 
 func (*Action_SetFood) isAction_Kind() {}
@@ -1944,6 +2230,8 @@ func (*Action_PlayerSendModalForm) isAction_Kind() {}
 
 func (*Action_PlayerSendDialogue) isAction_Kind() {}
 
+func (*Action_PlayerSendCustomForm) isAction_Kind() {}
+
 func (*Action_PlayerCloseDialogue) isAction_Kind() {}
 
 func (*Action_PlayerCloseForm) isAction_Kind() {}
@@ -2056,6 +2344,16 @@ func (*Action_WorldScheduleBlockUpdate) isAction_Kind() {}
 
 func (*Action_WorldBuildStructure) isAction_Kind() {}
 
+func (*Action_WorldSpawnEntity) isAction_Kind() {}
+
+func (*Action_EntityRemove) isAction_Kind() {}
+
+func (*Action_EntityTeleport) isAction_Kind() {}
+
+func (*Action_EntitySetVelocity) isAction_Kind() {}
+
+func (*Action_EntitySetNameTag) isAction_Kind() {}
+
 func (*Action_WorldQueryEntities) isAction_Kind() {}
 
 func (*Action_WorldQueryPlayers) isAction_Kind() {}
@@ -2141,10 +2439,11 @@ func (x *SendChatAction) GetMessage() string {
 type TeleportAction struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Position   *Vec3                  `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Position   *Vec3                  `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"` // required
 	// rotation vector mapping:
 	//
 	//	x = pitch, y = yaw, z = head_yaw
+	// Unset keeps the rotation of the player.
 	Rotation      *Vec3 `protobuf:"bytes,3,opt,name=rotation,proto3" json:"rotation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -6886,11 +7185,16 @@ func (x *PlayerRemoveScoreboardAction) GetPlayerUuid() string {
 
 // Player forms (show)
 type PlayerSendMenuFormAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Body          *string                `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
-	Buttons       []string               `protobuf:"bytes,4,rep,name=buttons,proto3" json:"buttons,omitempty"` // up to 6
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Title      string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body       *string                `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	// Deprecated: use form_buttons. Plain text buttons, used only when form_buttons is empty.
+	//
+	// Deprecated: Marked as deprecated in actions.proto.
+	Buttons       []string      `protobuf:"bytes,4,rep,name=buttons,proto3" json:"buttons,omitempty"`
+	FormId        *string       `protobuf:"bytes,5,opt,name=form_id,json=formId,proto3,oneof" json:"form_id,omitempty"` // echoed in PlayerFormResponseEvent; defaults to the action correlation_id
+	FormButtons   []*FormButton `protobuf:"bytes,6,rep,name=form_buttons,json=formButtons,proto3" json:"form_buttons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -6946,6 +7250,7 @@ func (x *PlayerSendMenuFormAction) GetBody() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in actions.proto.
 func (x *PlayerSendMenuFormAction) GetButtons() []string {
 	if x != nil {
 		return x.Buttons
@@ -6953,20 +7258,95 @@ func (x *PlayerSendMenuFormAction) GetButtons() []string {
 	return nil
 }
 
+func (x *PlayerSendMenuFormAction) GetFormId() string {
+	if x != nil && x.FormId != nil {
+		return *x.FormId
+	}
+	return ""
+}
+
+func (x *PlayerSendMenuFormAction) GetFormButtons() []*FormButton {
+	if x != nil {
+		return x.FormButtons
+	}
+	return nil
+}
+
+type FormButton struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Image         *string                `protobuf:"bytes,2,opt,name=image,proto3,oneof" json:"image,omitempty"` // texture path (e.g. textures/items/apple) or http(s) URL
+	Id            *string                `protobuf:"bytes,3,opt,name=id,proto3,oneof" json:"id,omitempty"`       // echoed in PlayerFormResponseEvent.button_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormButton) Reset() {
+	*x = FormButton{}
+	mi := &file_actions_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormButton) ProtoMessage() {}
+
+func (x *FormButton) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormButton.ProtoReflect.Descriptor instead.
+func (*FormButton) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *FormButton) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *FormButton) GetImage() string {
+	if x != nil && x.Image != nil {
+		return *x.Image
+	}
+	return ""
+}
+
+func (x *FormButton) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
 type PlayerSendModalFormAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	YesText       string                 `protobuf:"bytes,4,opt,name=yes_text,json=yesText,proto3" json:"yes_text,omitempty"` // default: gui.yes
-	NoText        string                 `protobuf:"bytes,5,opt,name=no_text,json=noText,proto3" json:"no_text,omitempty"`    // default: gui.no
+	YesText       string                 `protobuf:"bytes,4,opt,name=yes_text,json=yesText,proto3" json:"yes_text,omitempty"`    // default: gui.yes
+	NoText        string                 `protobuf:"bytes,5,opt,name=no_text,json=noText,proto3" json:"no_text,omitempty"`       // default: gui.no
+	FormId        *string                `protobuf:"bytes,6,opt,name=form_id,json=formId,proto3,oneof" json:"form_id,omitempty"` // echoed in PlayerFormResponseEvent; defaults to the action correlation_id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PlayerSendModalFormAction) Reset() {
 	*x = PlayerSendModalFormAction{}
-	mi := &file_actions_proto_msgTypes[93]
+	mi := &file_actions_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6978,7 +7358,7 @@ func (x *PlayerSendModalFormAction) String() string {
 func (*PlayerSendModalFormAction) ProtoMessage() {}
 
 func (x *PlayerSendModalFormAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[93]
+	mi := &file_actions_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6991,7 +7371,7 @@ func (x *PlayerSendModalFormAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerSendModalFormAction.ProtoReflect.Descriptor instead.
 func (*PlayerSendModalFormAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{93}
+	return file_actions_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *PlayerSendModalFormAction) GetPlayerUuid() string {
@@ -7029,33 +7409,39 @@ func (x *PlayerSendModalFormAction) GetNoText() string {
 	return ""
 }
 
-// Dialogue (show)
-type PlayerSendDialogueAction struct {
+func (x *PlayerSendModalFormAction) GetFormId() string {
+	if x != nil && x.FormId != nil {
+		return *x.FormId
+	}
+	return ""
+}
+
+// Custom form (show)
+type PlayerSendCustomFormAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Body          *string                `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
-	Buttons       []string               `protobuf:"bytes,4,rep,name=buttons,proto3" json:"buttons,omitempty"` // up to 6
-	Entity        *EntityRef             `protobuf:"bytes,5,opt,name=entity,proto3" json:"entity,omitempty"`   // target entity to display as NPC
+	Elements      []*FormElement         `protobuf:"bytes,3,rep,name=elements,proto3" json:"elements,omitempty"`                 // submitted values are reported in PlayerFormResponseEvent.values
+	FormId        *string                `protobuf:"bytes,4,opt,name=form_id,json=formId,proto3,oneof" json:"form_id,omitempty"` // echoed in PlayerFormResponseEvent; defaults to the action correlation_id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerSendDialogueAction) Reset() {
-	*x = PlayerSendDialogueAction{}
-	mi := &file_actions_proto_msgTypes[94]
+func (x *PlayerSendCustomFormAction) Reset() {
+	*x = PlayerSendCustomFormAction{}
+	mi := &file_actions_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerSendDialogueAction) String() string {
+func (x *PlayerSendCustomFormAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerSendDialogueAction) ProtoMessage() {}
+func (*PlayerSendCustomFormAction) ProtoMessage() {}
 
-func (x *PlayerSendDialogueAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[94]
+func (x *PlayerSendCustomFormAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7066,71 +7452,69 @@ func (x *PlayerSendDialogueAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerSendDialogueAction.ProtoReflect.Descriptor instead.
-func (*PlayerSendDialogueAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use PlayerSendCustomFormAction.ProtoReflect.Descriptor instead.
+func (*PlayerSendCustomFormAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *PlayerSendDialogueAction) GetPlayerUuid() string {
+func (x *PlayerSendCustomFormAction) GetPlayerUuid() string {
 	if x != nil {
 		return x.PlayerUuid
 	}
 	return ""
 }
 
-func (x *PlayerSendDialogueAction) GetTitle() string {
+func (x *PlayerSendCustomFormAction) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *PlayerSendDialogueAction) GetBody() string {
-	if x != nil && x.Body != nil {
-		return *x.Body
+func (x *PlayerSendCustomFormAction) GetElements() []*FormElement {
+	if x != nil {
+		return x.Elements
 	}
-	return ""
+	return nil
 }
 
-func (x *PlayerSendDialogueAction) GetButtons() []string {
-	if x != nil {
-		return x.Buttons
-	}
-	return nil
-}
-
-func (x *PlayerSendDialogueAction) GetEntity() *EntityRef {
-	if x != nil {
-		return x.Entity
+func (x *PlayerSendCustomFormAction) GetFormId() string {
+	if x != nil && x.FormId != nil {
+		return *x.FormId
 	}
-	return nil
+	return ""
 }
 
-type PlayerSendBossBarAction struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid       string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Text             string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
-	HealthPercentage *float32               `protobuf:"fixed32,3,opt,name=health_percentage,json=healthPercentage,proto3,oneof" json:"health_percentage,omitempty"` // 0.0 - 1.0 (default 1.0)
-	Colour           *BossBarColour         `protobuf:"varint,4,opt,name=colour,proto3,enum=df.plugin.BossBarColour,oneof" json:"colour,omitempty"`                 // default PURPLE
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type FormElement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Element:
+	//
+	//	*FormElement_Label
+	//	*FormElement_Input
+	//	*FormElement_Toggle
+	//	*FormElement_Slider
+	//	*FormElement_Dropdown
+	//	*FormElement_StepSlider
+	Element       isFormElement_Element `protobuf_oneof:"element"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerSendBossBarAction) Reset() {
-	*x = PlayerSendBossBarAction{}
-	mi := &file_actions_proto_msgTypes[95]
+func (x *FormElement) Reset() {
+	*x = FormElement{}
+	mi := &file_actions_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerSendBossBarAction) String() string {
+func (x *FormElement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerSendBossBarAction) ProtoMessage() {}
+func (*FormElement) ProtoMessage() {}
 
-func (x *PlayerSendBossBarAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[95]
+func (x *FormElement) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7141,61 +7525,134 @@ func (x *PlayerSendBossBarAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerSendBossBarAction.ProtoReflect.Descriptor instead.
-func (*PlayerSendBossBarAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use FormElement.ProtoReflect.Descriptor instead.
+func (*FormElement) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *PlayerSendBossBarAction) GetPlayerUuid() string {
+func (x *FormElement) GetElement() isFormElement_Element {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Element
 	}
-	return ""
+	return nil
 }
 
-func (x *PlayerSendBossBarAction) GetText() string {
+func (x *FormElement) GetLabel() *FormLabel {
 	if x != nil {
-		return x.Text
+		if x, ok := x.Element.(*FormElement_Label); ok {
+			return x.Label
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *PlayerSendBossBarAction) GetHealthPercentage() float32 {
-	if x != nil && x.HealthPercentage != nil {
-		return *x.HealthPercentage
+func (x *FormElement) GetInput() *FormInput {
+	if x != nil {
+		if x, ok := x.Element.(*FormElement_Input); ok {
+			return x.Input
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *PlayerSendBossBarAction) GetColour() BossBarColour {
-	if x != nil && x.Colour != nil {
-		return *x.Colour
+func (x *FormElement) GetToggle() *FormToggle {
+	if x != nil {
+		if x, ok := x.Element.(*FormElement_Toggle); ok {
+			return x.Toggle
+		}
 	}
-	return BossBarColour_BOSS_BAR_COLOUR_GREY
+	return nil
 }
 
-type PlayerRemoveBossBarAction struct {
+func (x *FormElement) GetSlider() *FormSlider {
+	if x != nil {
+		if x, ok := x.Element.(*FormElement_Slider); ok {
+			return x.Slider
+		}
+	}
+	return nil
+}
+
+func (x *FormElement) GetDropdown() *FormDropdown {
+	if x != nil {
+		if x, ok := x.Element.(*FormElement_Dropdown); ok {
+			return x.Dropdown
+		}
+	}
+	return nil
+}
+
+func (x *FormElement) GetStepSlider() *FormStepSlider {
+	if x != nil {
+		if x, ok := x.Element.(*FormElement_StepSlider); ok {
+			return x.StepSlider
+		}
+	}
+	return nil
+}
+
+type isFormElement_Element interface {
+	isFormElement_Element()
+}
+
+type FormElement_Label struct {
+	Label *FormLabel `protobuf:"bytes,1,opt,name=label,proto3,oneof"`
+}
+
+type FormElement_Input struct {
+	Input *FormInput `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+}
+
+type FormElement_Toggle struct {
+	Toggle *FormToggle `protobuf:"bytes,3,opt,name=toggle,proto3,oneof"`
+}
+
+type FormElement_Slider struct {
+	Slider *FormSlider `protobuf:"bytes,4,opt,name=slider,proto3,oneof"`
+}
+
+type FormElement_Dropdown struct {
+	Dropdown *FormDropdown `protobuf:"bytes,5,opt,name=dropdown,proto3,oneof"`
+}
+
+type FormElement_StepSlider struct {
+	StepSlider *FormStepSlider `protobuf:"bytes,6,opt,name=step_slider,json=stepSlider,proto3,oneof"`
+}
+
+func (*FormElement_Label) isFormElement_Element() {}
+
+func (*FormElement_Input) isFormElement_Element() {}
+
+func (*FormElement_Toggle) isFormElement_Element() {}
+
+func (*FormElement_Slider) isFormElement_Element() {}
+
+func (*FormElement_Dropdown) isFormElement_Element() {}
+
+func (*FormElement_StepSlider) isFormElement_Element() {}
+
+type FormLabel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerRemoveBossBarAction) Reset() {
-	*x = PlayerRemoveBossBarAction{}
-	mi := &file_actions_proto_msgTypes[96]
+func (x *FormLabel) Reset() {
+	*x = FormLabel{}
+	mi := &file_actions_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerRemoveBossBarAction) String() string {
+func (x *FormLabel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerRemoveBossBarAction) ProtoMessage() {}
+func (*FormLabel) ProtoMessage() {}
 
-func (x *PlayerRemoveBossBarAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[96]
+func (x *FormLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7206,41 +7663,42 @@ func (x *PlayerRemoveBossBarAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerRemoveBossBarAction.ProtoReflect.Descriptor instead.
-func (*PlayerRemoveBossBarAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use FormLabel.ProtoReflect.Descriptor instead.
+func (*FormLabel) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *PlayerRemoveBossBarAction) GetPlayerUuid() string {
+func (x *FormLabel) GetText() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Text
 	}
 	return ""
 }
 
-type PlayerShowHudElementAction struct {
+type FormInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Element       HudElement             `protobuf:"varint,2,opt,name=element,proto3,enum=df.plugin.HudElement" json:"element,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,2,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerShowHudElementAction) Reset() {
-	*x = PlayerShowHudElementAction{}
-	mi := &file_actions_proto_msgTypes[97]
+func (x *FormInput) Reset() {
+	*x = FormInput{}
+	mi := &file_actions_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerShowHudElementAction) String() string {
+func (x *FormInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerShowHudElementAction) ProtoMessage() {}
+func (*FormInput) ProtoMessage() {}
 
-func (x *PlayerShowHudElementAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[97]
+func (x *FormInput) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7251,48 +7709,55 @@ func (x *PlayerShowHudElementAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerShowHudElementAction.ProtoReflect.Descriptor instead.
-func (*PlayerShowHudElementAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use FormInput.ProtoReflect.Descriptor instead.
+func (*FormInput) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *PlayerShowHudElementAction) GetPlayerUuid() string {
+func (x *FormInput) GetText() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Text
 	}
 	return ""
 }
 
-func (x *PlayerShowHudElementAction) GetElement() HudElement {
+func (x *FormInput) GetDefaultValue() string {
 	if x != nil {
-		return x.Element
+		return x.DefaultValue
 	}
-	return HudElement_HUD_ELEMENT_PAPER_DOLL
+	return ""
 }
 
-type PlayerHideHudElementAction struct {
+func (x *FormInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+type FormToggle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Element       HudElement             `protobuf:"varint,2,opt,name=element,proto3,enum=df.plugin.HudElement" json:"element,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	DefaultValue  bool                   `protobuf:"varint,2,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerHideHudElementAction) Reset() {
-	*x = PlayerHideHudElementAction{}
-	mi := &file_actions_proto_msgTypes[98]
+func (x *FormToggle) Reset() {
+	*x = FormToggle{}
+	mi := &file_actions_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerHideHudElementAction) String() string {
+func (x *FormToggle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerHideHudElementAction) ProtoMessage() {}
+func (*FormToggle) ProtoMessage() {}
 
-func (x *PlayerHideHudElementAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[98]
+func (x *FormToggle) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7303,48 +7768,51 @@ func (x *PlayerHideHudElementAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerHideHudElementAction.ProtoReflect.Descriptor instead.
-func (*PlayerHideHudElementAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use FormToggle.ProtoReflect.Descriptor instead.
+func (*FormToggle) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *PlayerHideHudElementAction) GetPlayerUuid() string {
+func (x *FormToggle) GetText() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Text
 	}
 	return ""
 }
 
-func (x *PlayerHideHudElementAction) GetElement() HudElement {
+func (x *FormToggle) GetDefaultValue() bool {
 	if x != nil {
-		return x.Element
+		return x.DefaultValue
 	}
-	return HudElement_HUD_ELEMENT_PAPER_DOLL
+	return false
 }
 
-// Player UI closers
-type PlayerCloseDialogueAction struct {
+type FormSlider struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Min           float64                `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	StepSize      float64                `protobuf:"fixed64,4,opt,name=step_size,json=stepSize,proto3" json:"step_size,omitempty"`
+	DefaultValue  float64                `protobuf:"fixed64,5,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerCloseDialogueAction) Reset() {
-	*x = PlayerCloseDialogueAction{}
-	mi := &file_actions_proto_msgTypes[99]
+func (x *FormSlider) Reset() {
+	*x = FormSlider{}
+	mi := &file_actions_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerCloseDialogueAction) String() string {
+func (x *FormSlider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerCloseDialogueAction) ProtoMessage() {}
+func (*FormSlider) ProtoMessage() {}
 
-func (x *PlayerCloseDialogueAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[99]
+func (x *FormSlider) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7355,40 +7823,70 @@ func (x *PlayerCloseDialogueAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerCloseDialogueAction.ProtoReflect.Descriptor instead.
-func (*PlayerCloseDialogueAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use FormSlider.ProtoReflect.Descriptor instead.
+func (*FormSlider) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *PlayerCloseDialogueAction) GetPlayerUuid() string {
+func (x *FormSlider) GetText() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Text
 	}
 	return ""
 }
 
-type PlayerCloseFormAction struct {
+func (x *FormSlider) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *FormSlider) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *FormSlider) GetStepSize() float64 {
+	if x != nil {
+		return x.StepSize
+	}
+	return 0
+}
+
+func (x *FormSlider) GetDefaultValue() float64 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return 0
+}
+
+type FormDropdown struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Options       []string               `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultIndex  int32                  `protobuf:"varint,3,opt,name=default_index,json=defaultIndex,proto3" json:"default_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerCloseFormAction) Reset() {
-	*x = PlayerCloseFormAction{}
-	mi := &file_actions_proto_msgTypes[100]
+func (x *FormDropdown) Reset() {
+	*x = FormDropdown{}
+	mi := &file_actions_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerCloseFormAction) String() string {
+func (x *FormDropdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerCloseFormAction) ProtoMessage() {}
+func (*FormDropdown) ProtoMessage() {}
 
-func (x *PlayerCloseFormAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[100]
+func (x *FormDropdown) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7399,43 +7897,56 @@ func (x *PlayerCloseFormAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerCloseFormAction.ProtoReflect.Descriptor instead.
-func (*PlayerCloseFormAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use FormDropdown.ProtoReflect.Descriptor instead.
+func (*FormDropdown) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *PlayerCloseFormAction) GetPlayerUuid() string {
+func (x *FormDropdown) GetText() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Text
 	}
 	return ""
 }
 
-// Player: Signs & Lecterns
-type PlayerOpenSignAction struct {
+func (x *FormDropdown) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *FormDropdown) GetDefaultIndex() int32 {
+	if x != nil {
+		return x.DefaultIndex
+	}
+	return 0
+}
+
+type FormStepSlider struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	FrontSide     bool                   `protobuf:"varint,3,opt,name=front_side,json=frontSide,proto3" json:"front_side,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Options       []string               `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultIndex  int32                  `protobuf:"varint,3,opt,name=default_index,json=defaultIndex,proto3" json:"default_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerOpenSignAction) Reset() {
-	*x = PlayerOpenSignAction{}
-	mi := &file_actions_proto_msgTypes[101]
+func (x *FormStepSlider) Reset() {
+	*x = FormStepSlider{}
+	mi := &file_actions_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerOpenSignAction) String() string {
+func (x *FormStepSlider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerOpenSignAction) ProtoMessage() {}
+func (*FormStepSlider) ProtoMessage() {}
 
-func (x *PlayerOpenSignAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[101]
+func (x *FormStepSlider) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7446,57 +7957,1730 @@ func (x *PlayerOpenSignAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerOpenSignAction.ProtoReflect.Descriptor instead.
-func (*PlayerOpenSignAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use FormStepSlider.ProtoReflect.Descriptor instead.
+func (*FormStepSlider) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *PlayerOpenSignAction) GetPlayerUuid() string {
+func (x *FormStepSlider) GetText() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.Text
 	}
 	return ""
 }
 
-func (x *PlayerOpenSignAction) GetPosition() *BlockPos {
+func (x *FormStepSlider) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *FormStepSlider) GetDefaultIndex() int32 {
+	if x != nil {
+		return x.DefaultIndex
+	}
+	return 0
+}
+
+// Dialogue (show)
+type PlayerSendDialogueAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          *string                `protobuf:"bytes,3,opt,name=body,proto3,oneof" json:"body,omitempty"`
+	Buttons       []string               `protobuf:"bytes,4,rep,name=buttons,proto3" json:"buttons,omitempty"`                   // up to 6
+	Entity        *EntityRef             `protobuf:"bytes,5,opt,name=entity,proto3" json:"entity,omitempty"`                     // target entity to display as NPC
+	FormId        *string                `protobuf:"bytes,6,opt,name=form_id,json=formId,proto3,oneof" json:"form_id,omitempty"` // echoed in PlayerDialogueResponseEvent; defaults to the action correlation_id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSendDialogueAction) Reset() {
+	*x = PlayerSendDialogueAction{}
+	mi := &file_actions_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSendDialogueAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSendDialogueAction) ProtoMessage() {}
+
+func (x *PlayerSendDialogueAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSendDialogueAction.ProtoReflect.Descriptor instead.
+func (*PlayerSendDialogueAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *PlayerSendDialogueAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerSendDialogueAction) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlayerSendDialogueAction) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *PlayerSendDialogueAction) GetButtons() []string {
+	if x != nil {
+		return x.Buttons
+	}
+	return nil
+}
+
+func (x *PlayerSendDialogueAction) GetEntity() *EntityRef {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *PlayerSendDialogueAction) GetFormId() string {
+	if x != nil && x.FormId != nil {
+		return *x.FormId
+	}
+	return ""
+}
+
+type PlayerSendBossBarAction struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid       string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Text             string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	HealthPercentage *float32               `protobuf:"fixed32,3,opt,name=health_percentage,json=healthPercentage,proto3,oneof" json:"health_percentage,omitempty"` // 0.0 - 1.0 (default 1.0)
+	Colour           *BossBarColour         `protobuf:"varint,4,opt,name=colour,proto3,enum=df.plugin.BossBarColour,oneof" json:"colour,omitempty"`                 // default PURPLE
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PlayerSendBossBarAction) Reset() {
+	*x = PlayerSendBossBarAction{}
+	mi := &file_actions_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSendBossBarAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSendBossBarAction) ProtoMessage() {}
+
+func (x *PlayerSendBossBarAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSendBossBarAction.ProtoReflect.Descriptor instead.
+func (*PlayerSendBossBarAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *PlayerSendBossBarAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerSendBossBarAction) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PlayerSendBossBarAction) GetHealthPercentage() float32 {
+	if x != nil && x.HealthPercentage != nil {
+		return *x.HealthPercentage
+	}
+	return 0
+}
+
+func (x *PlayerSendBossBarAction) GetColour() BossBarColour {
+	if x != nil && x.Colour != nil {
+		return *x.Colour
+	}
+	return BossBarColour_BOSS_BAR_COLOUR_GREY
+}
+
+type PlayerRemoveBossBarAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRemoveBossBarAction) Reset() {
+	*x = PlayerRemoveBossBarAction{}
+	mi := &file_actions_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRemoveBossBarAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRemoveBossBarAction) ProtoMessage() {}
+
+func (x *PlayerRemoveBossBarAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRemoveBossBarAction.ProtoReflect.Descriptor instead.
+func (*PlayerRemoveBossBarAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *PlayerRemoveBossBarAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+type PlayerShowHudElementAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Element       HudElement             `protobuf:"varint,2,opt,name=element,proto3,enum=df.plugin.HudElement" json:"element,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerShowHudElementAction) Reset() {
+	*x = PlayerShowHudElementAction{}
+	mi := &file_actions_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerShowHudElementAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerShowHudElementAction) ProtoMessage() {}
+
+func (x *PlayerShowHudElementAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerShowHudElementAction.ProtoReflect.Descriptor instead.
+func (*PlayerShowHudElementAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *PlayerShowHudElementAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerShowHudElementAction) GetElement() HudElement {
+	if x != nil {
+		return x.Element
+	}
+	return HudElement_HUD_ELEMENT_PAPER_DOLL
+}
+
+type PlayerHideHudElementAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Element       HudElement             `protobuf:"varint,2,opt,name=element,proto3,enum=df.plugin.HudElement" json:"element,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerHideHudElementAction) Reset() {
+	*x = PlayerHideHudElementAction{}
+	mi := &file_actions_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerHideHudElementAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerHideHudElementAction) ProtoMessage() {}
+
+func (x *PlayerHideHudElementAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerHideHudElementAction.ProtoReflect.Descriptor instead.
+func (*PlayerHideHudElementAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *PlayerHideHudElementAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerHideHudElementAction) GetElement() HudElement {
+	if x != nil {
+		return x.Element
+	}
+	return HudElement_HUD_ELEMENT_PAPER_DOLL
+}
+
+// Player UI closers
+type PlayerCloseDialogueAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerCloseDialogueAction) Reset() {
+	*x = PlayerCloseDialogueAction{}
+	mi := &file_actions_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerCloseDialogueAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerCloseDialogueAction) ProtoMessage() {}
+
+func (x *PlayerCloseDialogueAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerCloseDialogueAction.ProtoReflect.Descriptor instead.
+func (*PlayerCloseDialogueAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PlayerCloseDialogueAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+type PlayerCloseFormAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerCloseFormAction) Reset() {
+	*x = PlayerCloseFormAction{}
+	mi := &file_actions_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerCloseFormAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerCloseFormAction) ProtoMessage() {}
+
+func (x *PlayerCloseFormAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerCloseFormAction.ProtoReflect.Descriptor instead.
+func (*PlayerCloseFormAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PlayerCloseFormAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+// Player: Signs & Lecterns
+type PlayerOpenSignAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	FrontSide     bool                   `protobuf:"varint,3,opt,name=front_side,json=frontSide,proto3" json:"front_side,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerOpenSignAction) Reset() {
+	*x = PlayerOpenSignAction{}
+	mi := &file_actions_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerOpenSignAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerOpenSignAction) ProtoMessage() {}
+
+func (x *PlayerOpenSignAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerOpenSignAction.ProtoReflect.Descriptor instead.
+func (*PlayerOpenSignAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *PlayerOpenSignAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerOpenSignAction) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlayerOpenSignAction) GetFrontSide() bool {
+	if x != nil {
+		return x.FrontSide
+	}
+	return false
+}
+
+type PlayerEditSignAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	FrontText     string                 `protobuf:"bytes,3,opt,name=front_text,json=frontText,proto3" json:"front_text,omitempty"`
+	BackText      string                 `protobuf:"bytes,4,opt,name=back_text,json=backText,proto3" json:"back_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerEditSignAction) Reset() {
+	*x = PlayerEditSignAction{}
+	mi := &file_actions_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerEditSignAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerEditSignAction) ProtoMessage() {}
+
+func (x *PlayerEditSignAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerEditSignAction.ProtoReflect.Descriptor instead.
+func (*PlayerEditSignAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *PlayerEditSignAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerEditSignAction) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlayerEditSignAction) GetFrontText() string {
+	if x != nil {
+		return x.FrontText
+	}
+	return ""
+}
+
+func (x *PlayerEditSignAction) GetBackText() string {
+	if x != nil {
+		return x.BackText
+	}
+	return ""
+}
+
+type PlayerTurnLecternPageAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerTurnLecternPageAction) Reset() {
+	*x = PlayerTurnLecternPageAction{}
+	mi := &file_actions_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerTurnLecternPageAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerTurnLecternPageAction) ProtoMessage() {}
+
+func (x *PlayerTurnLecternPageAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerTurnLecternPageAction.ProtoReflect.Descriptor instead.
+func (*PlayerTurnLecternPageAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *PlayerTurnLecternPageAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerTurnLecternPageAction) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlayerTurnLecternPageAction) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+// Player: Entity visibility (player-targeted)
+type PlayerHidePlayerAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerHidePlayerAction) Reset() {
+	*x = PlayerHidePlayerAction{}
+	mi := &file_actions_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerHidePlayerAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerHidePlayerAction) ProtoMessage() {}
+
+func (x *PlayerHidePlayerAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerHidePlayerAction.ProtoReflect.Descriptor instead.
+func (*PlayerHidePlayerAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *PlayerHidePlayerAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerHidePlayerAction) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+type PlayerShowPlayerAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerShowPlayerAction) Reset() {
+	*x = PlayerShowPlayerAction{}
+	mi := &file_actions_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerShowPlayerAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerShowPlayerAction) ProtoMessage() {}
+
+func (x *PlayerShowPlayerAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerShowPlayerAction.ProtoReflect.Descriptor instead.
+func (*PlayerShowPlayerAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *PlayerShowPlayerAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerShowPlayerAction) GetTargetUuid() string {
+	if x != nil {
+		return x.TargetUuid
+	}
+	return ""
+}
+
+// Player: Debug shapes
+type PlayerRemoveAllDebugShapesAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerRemoveAllDebugShapesAction) Reset() {
+	*x = PlayerRemoveAllDebugShapesAction{}
+	mi := &file_actions_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerRemoveAllDebugShapesAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerRemoveAllDebugShapesAction) ProtoMessage() {}
+
+func (x *PlayerRemoveAllDebugShapesAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerRemoveAllDebugShapesAction.ProtoReflect.Descriptor instead.
+func (*PlayerRemoveAllDebugShapesAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PlayerRemoveAllDebugShapesAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+// Player: Interaction extras
+type PlayerOpenBlockContainerAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerOpenBlockContainerAction) Reset() {
+	*x = PlayerOpenBlockContainerAction{}
+	mi := &file_actions_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerOpenBlockContainerAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerOpenBlockContainerAction) ProtoMessage() {}
+
+func (x *PlayerOpenBlockContainerAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerOpenBlockContainerAction.ProtoReflect.Descriptor instead.
+func (*PlayerOpenBlockContainerAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PlayerOpenBlockContainerAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerOpenBlockContainerAction) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type PlayerDropItemAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Item          *ItemStack             `protobuf:"bytes,2,opt,name=item,proto3,oneof" json:"item,omitempty"` // if unset, drops currently held main-hand item
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerDropItemAction) Reset() {
+	*x = PlayerDropItemAction{}
+	mi := &file_actions_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDropItemAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDropItemAction) ProtoMessage() {}
+
+func (x *PlayerDropItemAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDropItemAction.ProtoReflect.Descriptor instead.
+func (*PlayerDropItemAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PlayerDropItemAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerDropItemAction) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PlayerSetItemCooldownAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Item          *ItemStack             `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSetItemCooldownAction) Reset() {
+	*x = PlayerSetItemCooldownAction{}
+	mi := &file_actions_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSetItemCooldownAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSetItemCooldownAction) ProtoMessage() {}
+
+func (x *PlayerSetItemCooldownAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSetItemCooldownAction.ProtoReflect.Descriptor instead.
+func (*PlayerSetItemCooldownAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *PlayerSetItemCooldownAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerSetItemCooldownAction) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *PlayerSetItemCooldownAction) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// Inventories
+type InventoryQueryAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *InventoryTarget       `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryQueryAction) Reset() {
+	*x = InventoryQueryAction{}
+	mi := &file_actions_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryQueryAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryQueryAction) ProtoMessage() {}
+
+func (x *InventoryQueryAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryQueryAction.ProtoReflect.Descriptor instead.
+func (*InventoryQueryAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *InventoryQueryAction) GetTarget() *InventoryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type InventorySetSlotAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *InventoryTarget       `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Slot          int32                  `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	Item          *ItemStack             `protobuf:"bytes,3,opt,name=item,proto3,oneof" json:"item,omitempty"` // if unset, clears the slot
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventorySetSlotAction) Reset() {
+	*x = InventorySetSlotAction{}
+	mi := &file_actions_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySetSlotAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySetSlotAction) ProtoMessage() {}
+
+func (x *InventorySetSlotAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySetSlotAction.ProtoReflect.Descriptor instead.
+func (*InventorySetSlotAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *InventorySetSlotAction) GetTarget() *InventoryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *InventorySetSlotAction) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *InventorySetSlotAction) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// InventoryRemoveItemAction removes up to item.count items comparable to item (same item, NBT, enchantments, name and
+// lore), starting at the first slot.
+type InventoryRemoveItemAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *InventoryTarget       `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Item          *ItemStack             `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryRemoveItemAction) Reset() {
+	*x = InventoryRemoveItemAction{}
+	mi := &file_actions_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryRemoveItemAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryRemoveItemAction) ProtoMessage() {}
+
+func (x *InventoryRemoveItemAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryRemoveItemAction.ProtoReflect.Descriptor instead.
+func (*InventoryRemoveItemAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *InventoryRemoveItemAction) GetTarget() *InventoryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *InventoryRemoveItemAction) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type InventorySwapSlotsAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *InventoryTarget       `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	SlotA         int32                  `protobuf:"varint,2,opt,name=slot_a,json=slotA,proto3" json:"slot_a,omitempty"`
+	SlotB         int32                  `protobuf:"varint,3,opt,name=slot_b,json=slotB,proto3" json:"slot_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventorySwapSlotsAction) Reset() {
+	*x = InventorySwapSlotsAction{}
+	mi := &file_actions_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySwapSlotsAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySwapSlotsAction) ProtoMessage() {}
+
+func (x *InventorySwapSlotsAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySwapSlotsAction.ProtoReflect.Descriptor instead.
+func (*InventorySwapSlotsAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *InventorySwapSlotsAction) GetTarget() *InventoryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *InventorySwapSlotsAction) GetSlotA() int32 {
+	if x != nil {
+		return x.SlotA
+	}
+	return 0
+}
+
+func (x *InventorySwapSlotsAction) GetSlotB() int32 {
+	if x != nil {
+		return x.SlotB
+	}
+	return 0
+}
+
+// InventoryClearRangeAction clears the slots from from_slot up to and including to_slot.
+type InventoryClearRangeAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Target        *InventoryTarget       `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	FromSlot      int32                  `protobuf:"varint,2,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	ToSlot        int32                  `protobuf:"varint,3,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryClearRangeAction) Reset() {
+	*x = InventoryClearRangeAction{}
+	mi := &file_actions_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryClearRangeAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryClearRangeAction) ProtoMessage() {}
+
+func (x *InventoryClearRangeAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryClearRangeAction.ProtoReflect.Descriptor instead.
+func (*InventoryClearRangeAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *InventoryClearRangeAction) GetTarget() *InventoryTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *InventoryClearRangeAction) GetFromSlot() int32 {
+	if x != nil {
+		return x.FromSlot
+	}
+	return 0
+}
+
+func (x *InventoryClearRangeAction) GetToSlot() int32 {
+	if x != nil {
+		return x.ToSlot
+	}
+	return 0
+}
+
+// PlayerOpenVirtualInventoryAction opens a container window whose contents are held by the host. The container block is
+// only sent to the player and restored when the window closes; nothing is written to the world. An open virtual
+// inventory of the player is replaced. Its slots are locked: clicks are only reported to the plugin.
+type PlayerOpenVirtualInventoryAction struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid  string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	InventoryId string                 `protobuf:"bytes,2,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"` // chosen by the plugin, echoed in click and close events
+	Type        VirtualInventoryType   `protobuf:"varint,3,opt,name=type,proto3,enum=df.plugin.VirtualInventoryType" json:"type,omitempty"`
+	Title       string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Slots       []*InventorySlot       `protobuf:"bytes,5,rep,name=slots,proto3" json:"slots,omitempty"`
+	// allow_take lets players take items out: a take is awaited and moves the items into the player's inventory
+	// unless the plugin cancels it or does not answer.
+	AllowTake     bool `protobuf:"varint,6,opt,name=allow_take,json=allowTake,proto3" json:"allow_take,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerOpenVirtualInventoryAction) Reset() {
+	*x = PlayerOpenVirtualInventoryAction{}
+	mi := &file_actions_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerOpenVirtualInventoryAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerOpenVirtualInventoryAction) ProtoMessage() {}
+
+func (x *PlayerOpenVirtualInventoryAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerOpenVirtualInventoryAction.ProtoReflect.Descriptor instead.
+func (*PlayerOpenVirtualInventoryAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PlayerOpenVirtualInventoryAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerOpenVirtualInventoryAction) GetInventoryId() string {
+	if x != nil {
+		return x.InventoryId
+	}
+	return ""
+}
+
+func (x *PlayerOpenVirtualInventoryAction) GetType() VirtualInventoryType {
+	if x != nil {
+		return x.Type
+	}
+	return VirtualInventoryType_VIRTUAL_INVENTORY_TYPE_CHEST
+}
+
+func (x *PlayerOpenVirtualInventoryAction) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PlayerOpenVirtualInventoryAction) GetSlots() []*InventorySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *PlayerOpenVirtualInventoryAction) GetAllowTake() bool {
+	if x != nil {
+		return x.AllowTake
+	}
+	return false
+}
+
+// PlayerSetVirtualInventorySlotsAction updates slots of the open virtual inventory. Slots without an item are cleared.
+type PlayerSetVirtualInventorySlotsAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	InventoryId   string                 `protobuf:"bytes,2,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
+	Slots         []*InventorySlot       `protobuf:"bytes,3,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSetVirtualInventorySlotsAction) Reset() {
+	*x = PlayerSetVirtualInventorySlotsAction{}
+	mi := &file_actions_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSetVirtualInventorySlotsAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSetVirtualInventorySlotsAction) ProtoMessage() {}
+
+func (x *PlayerSetVirtualInventorySlotsAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSetVirtualInventorySlotsAction.ProtoReflect.Descriptor instead.
+func (*PlayerSetVirtualInventorySlotsAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *PlayerSetVirtualInventorySlotsAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerSetVirtualInventorySlotsAction) GetInventoryId() string {
+	if x != nil {
+		return x.InventoryId
+	}
+	return ""
+}
+
+func (x *PlayerSetVirtualInventorySlotsAction) GetSlots() []*InventorySlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// PlayerCloseVirtualInventoryAction closes the open virtual inventory if the plugin opened it with inventory_id.
+type PlayerCloseVirtualInventoryAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	InventoryId   string                 `protobuf:"bytes,2,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerCloseVirtualInventoryAction) Reset() {
+	*x = PlayerCloseVirtualInventoryAction{}
+	mi := &file_actions_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerCloseVirtualInventoryAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerCloseVirtualInventoryAction) ProtoMessage() {}
+
+func (x *PlayerCloseVirtualInventoryAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerCloseVirtualInventoryAction.ProtoReflect.Descriptor instead.
+func (*PlayerCloseVirtualInventoryAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *PlayerCloseVirtualInventoryAction) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerCloseVirtualInventoryAction) GetInventoryId() string {
+	if x != nil {
+		return x.InventoryId
+	}
+	return ""
+}
+
+// WorldSpawnEntityAction spawns a non-player entity. The ActionResult carries the UUID of the new entity.
+type WorldSpawnEntityAction struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	World    *WorldRef              `protobuf:"bytes,1,opt,name=world,proto3" json:"world,omitempty"`
+	Position *Vec3                  `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	Rotation *Rotation              `protobuf:"bytes,3,opt,name=rotation,proto3,oneof" json:"rotation,omitempty"`
+	Velocity *Vec3                  `protobuf:"bytes,4,opt,name=velocity,proto3,oneof" json:"velocity,omitempty"` // blocks per tick
+	NameTag  *string                `protobuf:"bytes,5,opt,name=name_tag,json=nameTag,proto3,oneof" json:"name_tag,omitempty"`
+	// Types that are valid to be assigned to Entity:
+	//
+	//	*WorldSpawnEntityAction_Item
+	//	*WorldSpawnEntityAction_FallingBlock
+	//	*WorldSpawnEntityAction_Tnt
+	//	*WorldSpawnEntityAction_Arrow
+	//	*WorldSpawnEntityAction_ExperienceOrb
+	//	*WorldSpawnEntityAction_Lightning
+	//	*WorldSpawnEntityAction_Text
+	Entity        isWorldSpawnEntityAction_Entity `protobuf_oneof:"entity"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldSpawnEntityAction) Reset() {
+	*x = WorldSpawnEntityAction{}
+	mi := &file_actions_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldSpawnEntityAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldSpawnEntityAction) ProtoMessage() {}
+
+func (x *WorldSpawnEntityAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldSpawnEntityAction.ProtoReflect.Descriptor instead.
+func (*WorldSpawnEntityAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *WorldSpawnEntityAction) GetWorld() *WorldRef {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetPosition() *Vec3 {
 	if x != nil {
 		return x.Position
 	}
 	return nil
 }
 
-func (x *PlayerOpenSignAction) GetFrontSide() bool {
+func (x *WorldSpawnEntityAction) GetRotation() *Rotation {
 	if x != nil {
-		return x.FrontSide
+		return x.Rotation
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetVelocity() *Vec3 {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetNameTag() string {
+	if x != nil && x.NameTag != nil {
+		return *x.NameTag
+	}
+	return ""
+}
+
+func (x *WorldSpawnEntityAction) GetEntity() isWorldSpawnEntityAction_Entity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetItem() *SpawnItemEntity {
+	if x != nil {
+		if x, ok := x.Entity.(*WorldSpawnEntityAction_Item); ok {
+			return x.Item
+		}
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetFallingBlock() *SpawnFallingBlockEntity {
+	if x != nil {
+		if x, ok := x.Entity.(*WorldSpawnEntityAction_FallingBlock); ok {
+			return x.FallingBlock
+		}
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetTnt() *SpawnTNTEntity {
+	if x != nil {
+		if x, ok := x.Entity.(*WorldSpawnEntityAction_Tnt); ok {
+			return x.Tnt
+		}
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetArrow() *SpawnArrowEntity {
+	if x != nil {
+		if x, ok := x.Entity.(*WorldSpawnEntityAction_Arrow); ok {
+			return x.Arrow
+		}
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetExperienceOrb() *SpawnExperienceOrbEntity {
+	if x != nil {
+		if x, ok := x.Entity.(*WorldSpawnEntityAction_ExperienceOrb); ok {
+			return x.ExperienceOrb
+		}
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetLightning() *SpawnLightningEntity {
+	if x != nil {
+		if x, ok := x.Entity.(*WorldSpawnEntityAction_Lightning); ok {
+			return x.Lightning
+		}
+	}
+	return nil
+}
+
+func (x *WorldSpawnEntityAction) GetText() *SpawnTextEntity {
+	if x != nil {
+		if x, ok := x.Entity.(*WorldSpawnEntityAction_Text); ok {
+			return x.Text
+		}
+	}
+	return nil
+}
+
+type isWorldSpawnEntityAction_Entity interface {
+	isWorldSpawnEntityAction_Entity()
+}
+
+type WorldSpawnEntityAction_Item struct {
+	Item *SpawnItemEntity `protobuf:"bytes,10,opt,name=item,proto3,oneof"`
+}
+
+type WorldSpawnEntityAction_FallingBlock struct {
+	FallingBlock *SpawnFallingBlockEntity `protobuf:"bytes,11,opt,name=falling_block,json=fallingBlock,proto3,oneof"`
+}
+
+type WorldSpawnEntityAction_Tnt struct {
+	Tnt *SpawnTNTEntity `protobuf:"bytes,12,opt,name=tnt,proto3,oneof"`
+}
+
+type WorldSpawnEntityAction_Arrow struct {
+	Arrow *SpawnArrowEntity `protobuf:"bytes,13,opt,name=arrow,proto3,oneof"`
+}
+
+type WorldSpawnEntityAction_ExperienceOrb struct {
+	ExperienceOrb *SpawnExperienceOrbEntity `protobuf:"bytes,14,opt,name=experience_orb,json=experienceOrb,proto3,oneof"`
+}
+
+type WorldSpawnEntityAction_Lightning struct {
+	Lightning *SpawnLightningEntity `protobuf:"bytes,15,opt,name=lightning,proto3,oneof"`
+}
+
+type WorldSpawnEntityAction_Text struct {
+	Text *SpawnTextEntity `protobuf:"bytes,16,opt,name=text,proto3,oneof"`
+}
+
+func (*WorldSpawnEntityAction_Item) isWorldSpawnEntityAction_Entity() {}
+
+func (*WorldSpawnEntityAction_FallingBlock) isWorldSpawnEntityAction_Entity() {}
+
+func (*WorldSpawnEntityAction_Tnt) isWorldSpawnEntityAction_Entity() {}
+
+func (*WorldSpawnEntityAction_Arrow) isWorldSpawnEntityAction_Entity() {}
+
+func (*WorldSpawnEntityAction_ExperienceOrb) isWorldSpawnEntityAction_Entity() {}
+
+func (*WorldSpawnEntityAction_Lightning) isWorldSpawnEntityAction_Entity() {}
+
+func (*WorldSpawnEntityAction_Text) isWorldSpawnEntityAction_Entity() {}
+
+type SpawnItemEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Item          *ItemStack             `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	PickupDelayMs *int64                 `protobuf:"varint,2,opt,name=pickup_delay_ms,json=pickupDelayMs,proto3,oneof" json:"pickup_delay_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpawnItemEntity) Reset() {
+	*x = SpawnItemEntity{}
+	mi := &file_actions_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpawnItemEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnItemEntity) ProtoMessage() {}
+
+func (x *SpawnItemEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnItemEntity.ProtoReflect.Descriptor instead.
+func (*SpawnItemEntity) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SpawnItemEntity) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SpawnItemEntity) GetPickupDelayMs() int64 {
+	if x != nil && x.PickupDelayMs != nil {
+		return *x.PickupDelayMs
+	}
+	return 0
+}
+
+type SpawnFallingBlockEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *BlockState            `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpawnFallingBlockEntity) Reset() {
+	*x = SpawnFallingBlockEntity{}
+	mi := &file_actions_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpawnFallingBlockEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnFallingBlockEntity) ProtoMessage() {}
+
+func (x *SpawnFallingBlockEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpawnFallingBlockEntity.ProtoReflect.Descriptor instead.
+func (*SpawnFallingBlockEntity) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SpawnFallingBlockEntity) GetBlock() *BlockState {
+	if x != nil {
+		return x.Block
 	}
-	return false
+	return nil
 }
 
-type PlayerEditSignAction struct {
+type SpawnTNTEntity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	FrontText     string                 `protobuf:"bytes,3,opt,name=front_text,json=frontText,proto3" json:"front_text,omitempty"`
-	BackText      string                 `protobuf:"bytes,4,opt,name=back_text,json=backText,proto3" json:"back_text,omitempty"`
+	FuseMs        *int64                 `protobuf:"varint,1,opt,name=fuse_ms,json=fuseMs,proto3,oneof" json:"fuse_ms,omitempty"` // defaults to 4 seconds
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerEditSignAction) Reset() {
-	*x = PlayerEditSignAction{}
-	mi := &file_actions_proto_msgTypes[102]
+func (x *SpawnTNTEntity) Reset() {
+	*x = SpawnTNTEntity{}
+	mi := &file_actions_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerEditSignAction) String() string {
+func (x *SpawnTNTEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerEditSignAction) ProtoMessage() {}
+func (*SpawnTNTEntity) ProtoMessage() {}
 
-func (x *PlayerEditSignAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[102]
+func (x *SpawnTNTEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7507,63 +9691,92 @@ func (x *PlayerEditSignAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerEditSignAction.ProtoReflect.Descriptor instead.
-func (*PlayerEditSignAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use SpawnTNTEntity.ProtoReflect.Descriptor instead.
+func (*SpawnTNTEntity) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *PlayerEditSignAction) GetPlayerUuid() string {
-	if x != nil {
-		return x.PlayerUuid
+func (x *SpawnTNTEntity) GetFuseMs() int64 {
+	if x != nil && x.FuseMs != nil {
+		return *x.FuseMs
 	}
-	return ""
+	return 0
 }
 
-func (x *PlayerEditSignAction) GetPosition() *BlockPos {
+type SpawnArrowEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Damage        *float64               `protobuf:"fixed64,1,opt,name=damage,proto3,oneof" json:"damage,omitempty"`                      // defaults to 2
+	OwnerUuid     *string                `protobuf:"bytes,2,opt,name=owner_uuid,json=ownerUuid,proto3,oneof" json:"owner_uuid,omitempty"` // player credited with hits
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpawnArrowEntity) Reset() {
+	*x = SpawnArrowEntity{}
+	mi := &file_actions_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpawnArrowEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpawnArrowEntity) ProtoMessage() {}
+
+func (x *SpawnArrowEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[131]
 	if x != nil {
-		return x.Position
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *PlayerEditSignAction) GetFrontText() string {
-	if x != nil {
-		return x.FrontText
+// Deprecated: Use SpawnArrowEntity.ProtoReflect.Descriptor instead.
+func (*SpawnArrowEntity) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SpawnArrowEntity) GetDamage() float64 {
+	if x != nil && x.Damage != nil {
+		return *x.Damage
 	}
-	return ""
+	return 0
 }
 
-func (x *PlayerEditSignAction) GetBackText() string {
-	if x != nil {
-		return x.BackText
+func (x *SpawnArrowEntity) GetOwnerUuid() string {
+	if x != nil && x.OwnerUuid != nil {
+		return *x.OwnerUuid
 	}
 	return ""
 }
 
-type PlayerTurnLecternPageAction struct {
+type SpawnExperienceOrbEntity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Experience    int32                  `protobuf:"varint,1,opt,name=experience,proto3" json:"experience,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerTurnLecternPageAction) Reset() {
-	*x = PlayerTurnLecternPageAction{}
-	mi := &file_actions_proto_msgTypes[103]
+func (x *SpawnExperienceOrbEntity) Reset() {
+	*x = SpawnExperienceOrbEntity{}
+	mi := &file_actions_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerTurnLecternPageAction) String() string {
+func (x *SpawnExperienceOrbEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerTurnLecternPageAction) ProtoMessage() {}
+func (*SpawnExperienceOrbEntity) ProtoMessage() {}
 
-func (x *PlayerTurnLecternPageAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[103]
+func (x *SpawnExperienceOrbEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7574,56 +9787,39 @@ func (x *PlayerTurnLecternPageAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerTurnLecternPageAction.ProtoReflect.Descriptor instead.
-func (*PlayerTurnLecternPageAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{103}
-}
-
-func (x *PlayerTurnLecternPageAction) GetPlayerUuid() string {
-	if x != nil {
-		return x.PlayerUuid
-	}
-	return ""
-}
-
-func (x *PlayerTurnLecternPageAction) GetPosition() *BlockPos {
-	if x != nil {
-		return x.Position
-	}
-	return nil
+// Deprecated: Use SpawnExperienceOrbEntity.ProtoReflect.Descriptor instead.
+func (*SpawnExperienceOrbEntity) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *PlayerTurnLecternPageAction) GetPage() int32 {
+func (x *SpawnExperienceOrbEntity) GetExperience() int32 {
 	if x != nil {
-		return x.Page
+		return x.Experience
 	}
 	return 0
 }
 
-// Player: Entity visibility (player-targeted)
-type PlayerHidePlayerAction struct {
+type SpawnLightningEntity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerHidePlayerAction) Reset() {
-	*x = PlayerHidePlayerAction{}
-	mi := &file_actions_proto_msgTypes[104]
+func (x *SpawnLightningEntity) Reset() {
+	*x = SpawnLightningEntity{}
+	mi := &file_actions_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerHidePlayerAction) String() string {
+func (x *SpawnLightningEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerHidePlayerAction) ProtoMessage() {}
+func (*SpawnLightningEntity) ProtoMessage() {}
 
-func (x *PlayerHidePlayerAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[104]
+func (x *SpawnLightningEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7634,48 +9830,34 @@ func (x *PlayerHidePlayerAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerHidePlayerAction.ProtoReflect.Descriptor instead.
-func (*PlayerHidePlayerAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *PlayerHidePlayerAction) GetPlayerUuid() string {
-	if x != nil {
-		return x.PlayerUuid
-	}
-	return ""
-}
-
-func (x *PlayerHidePlayerAction) GetTargetUuid() string {
-	if x != nil {
-		return x.TargetUuid
-	}
-	return ""
+// Deprecated: Use SpawnLightningEntity.ProtoReflect.Descriptor instead.
+func (*SpawnLightningEntity) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{133}
 }
 
-type PlayerShowPlayerAction struct {
+// SpawnTextEntity spawns a floating text. The text is the name tag of the entity.
+type SpawnTextEntity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	TargetUuid    string                 `protobuf:"bytes,2,opt,name=target_uuid,json=targetUuid,proto3" json:"target_uuid,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerShowPlayerAction) Reset() {
-	*x = PlayerShowPlayerAction{}
-	mi := &file_actions_proto_msgTypes[105]
+func (x *SpawnTextEntity) Reset() {
+	*x = SpawnTextEntity{}
+	mi := &file_actions_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerShowPlayerAction) String() string {
+func (x *SpawnTextEntity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerShowPlayerAction) ProtoMessage() {}
+func (*SpawnTextEntity) ProtoMessage() {}
 
-func (x *PlayerShowPlayerAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[105]
+func (x *SpawnTextEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7686,48 +9868,42 @@ func (x *PlayerShowPlayerAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerShowPlayerAction.ProtoReflect.Descriptor instead.
-func (*PlayerShowPlayerAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{105}
-}
-
-func (x *PlayerShowPlayerAction) GetPlayerUuid() string {
-	if x != nil {
-		return x.PlayerUuid
-	}
-	return ""
+// Deprecated: Use SpawnTextEntity.ProtoReflect.Descriptor instead.
+func (*SpawnTextEntity) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *PlayerShowPlayerAction) GetTargetUuid() string {
+func (x *SpawnTextEntity) GetText() string {
 	if x != nil {
-		return x.TargetUuid
+		return x.Text
 	}
 	return ""
 }
 
-// Player: Debug shapes
-type PlayerRemoveAllDebugShapesAction struct {
+// Entity actions target non-player entities. If world is unset, every world is searched; atomic batches require it.
+type EntityRemoveAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	EntityUuid    string                 `protobuf:"bytes,1,opt,name=entity_uuid,json=entityUuid,proto3" json:"entity_uuid,omitempty"`
+	World         *WorldRef              `protobuf:"bytes,2,opt,name=world,proto3,oneof" json:"world,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerRemoveAllDebugShapesAction) Reset() {
-	*x = PlayerRemoveAllDebugShapesAction{}
-	mi := &file_actions_proto_msgTypes[106]
+func (x *EntityRemoveAction) Reset() {
+	*x = EntityRemoveAction{}
+	mi := &file_actions_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerRemoveAllDebugShapesAction) String() string {
+func (x *EntityRemoveAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerRemoveAllDebugShapesAction) ProtoMessage() {}
+func (*EntityRemoveAction) ProtoMessage() {}
 
-func (x *PlayerRemoveAllDebugShapesAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[106]
+func (x *EntityRemoveAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7738,42 +9914,52 @@ func (x *PlayerRemoveAllDebugShapesAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerRemoveAllDebugShapesAction.ProtoReflect.Descriptor instead.
-func (*PlayerRemoveAllDebugShapesAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use EntityRemoveAction.ProtoReflect.Descriptor instead.
+func (*EntityRemoveAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *PlayerRemoveAllDebugShapesAction) GetPlayerUuid() string {
+func (x *EntityRemoveAction) GetEntityUuid() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.EntityUuid
 	}
 	return ""
 }
 
-// Player: Interaction extras
-type PlayerOpenBlockContainerAction struct {
+func (x *EntityRemoveAction) GetWorld() *WorldRef {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+// EntityTeleportAction respawns the entity at the position with the same UUID, type and state. Its age restarts.
+// Projectiles are refused, as they would lose their owner.
+type EntityTeleportAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Position      *BlockPos              `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	EntityUuid    string                 `protobuf:"bytes,1,opt,name=entity_uuid,json=entityUuid,proto3" json:"entity_uuid,omitempty"`
+	World         *WorldRef              `protobuf:"bytes,2,opt,name=world,proto3,oneof" json:"world,omitempty"`
+	Position      *Vec3                  `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Rotation      *Rotation              `protobuf:"bytes,4,opt,name=rotation,proto3,oneof" json:"rotation,omitempty"` // keeps the current rotation if unset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerOpenBlockContainerAction) Reset() {
-	*x = PlayerOpenBlockContainerAction{}
-	mi := &file_actions_proto_msgTypes[107]
+func (x *EntityTeleportAction) Reset() {
+	*x = EntityTeleportAction{}
+	mi := &file_actions_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerOpenBlockContainerAction) String() string {
+func (x *EntityTeleportAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerOpenBlockContainerAction) ProtoMessage() {}
+func (*EntityTeleportAction) ProtoMessage() {}
 
-func (x *PlayerOpenBlockContainerAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[107]
+func (x *EntityTeleportAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7784,48 +9970,63 @@ func (x *PlayerOpenBlockContainerAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerOpenBlockContainerAction.ProtoReflect.Descriptor instead.
-func (*PlayerOpenBlockContainerAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use EntityTeleportAction.ProtoReflect.Descriptor instead.
+func (*EntityTeleportAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *PlayerOpenBlockContainerAction) GetPlayerUuid() string {
+func (x *EntityTeleportAction) GetEntityUuid() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.EntityUuid
 	}
 	return ""
 }
 
-func (x *PlayerOpenBlockContainerAction) GetPosition() *BlockPos {
+func (x *EntityTeleportAction) GetWorld() *WorldRef {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *EntityTeleportAction) GetPosition() *Vec3 {
 	if x != nil {
 		return x.Position
 	}
 	return nil
 }
 
-type PlayerDropItemAction struct {
+func (x *EntityTeleportAction) GetRotation() *Rotation {
+	if x != nil {
+		return x.Rotation
+	}
+	return nil
+}
+
+type EntitySetVelocityAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Item          *ItemStack             `protobuf:"bytes,2,opt,name=item,proto3,oneof" json:"item,omitempty"` // if unset, drops currently held main-hand item
+	EntityUuid    string                 `protobuf:"bytes,1,opt,name=entity_uuid,json=entityUuid,proto3" json:"entity_uuid,omitempty"`
+	World         *WorldRef              `protobuf:"bytes,2,opt,name=world,proto3,oneof" json:"world,omitempty"`
+	Velocity      *Vec3                  `protobuf:"bytes,3,opt,name=velocity,proto3" json:"velocity,omitempty"` // blocks per tick
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerDropItemAction) Reset() {
-	*x = PlayerDropItemAction{}
-	mi := &file_actions_proto_msgTypes[108]
+func (x *EntitySetVelocityAction) Reset() {
+	*x = EntitySetVelocityAction{}
+	mi := &file_actions_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerDropItemAction) String() string {
+func (x *EntitySetVelocityAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerDropItemAction) ProtoMessage() {}
+func (*EntitySetVelocityAction) ProtoMessage() {}
 
-func (x *PlayerDropItemAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[108]
+func (x *EntitySetVelocityAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7836,49 +10037,56 @@ func (x *PlayerDropItemAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerDropItemAction.ProtoReflect.Descriptor instead.
-func (*PlayerDropItemAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use EntitySetVelocityAction.ProtoReflect.Descriptor instead.
+func (*EntitySetVelocityAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *PlayerDropItemAction) GetPlayerUuid() string {
+func (x *EntitySetVelocityAction) GetEntityUuid() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.EntityUuid
 	}
 	return ""
 }
 
-func (x *PlayerDropItemAction) GetItem() *ItemStack {
+func (x *EntitySetVelocityAction) GetWorld() *WorldRef {
 	if x != nil {
-		return x.Item
+		return x.World
 	}
 	return nil
 }
 
-type PlayerSetItemCooldownAction struct {
+func (x *EntitySetVelocityAction) GetVelocity() *Vec3 {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+type EntitySetNameTagAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Item          *ItemStack             `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	DurationMs    int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	EntityUuid    string                 `protobuf:"bytes,1,opt,name=entity_uuid,json=entityUuid,proto3" json:"entity_uuid,omitempty"`
+	World         *WorldRef              `protobuf:"bytes,2,opt,name=world,proto3,oneof" json:"world,omitempty"`
+	NameTag       string                 `protobuf:"bytes,3,opt,name=name_tag,json=nameTag,proto3" json:"name_tag,omitempty"` // empty removes the name tag
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerSetItemCooldownAction) Reset() {
-	*x = PlayerSetItemCooldownAction{}
-	mi := &file_actions_proto_msgTypes[109]
+func (x *EntitySetNameTagAction) Reset() {
+	*x = EntitySetNameTagAction{}
+	mi := &file_actions_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerSetItemCooldownAction) String() string {
+func (x *EntitySetNameTagAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerSetItemCooldownAction) ProtoMessage() {}
+func (*EntitySetNameTagAction) ProtoMessage() {}
 
-func (x *PlayerSetItemCooldownAction) ProtoReflect() protoreflect.Message {
-	mi := &file_actions_proto_msgTypes[109]
+func (x *EntitySetNameTagAction) ProtoReflect() protoreflect.Message {
+	mi := &file_actions_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7889,39 +10097,42 @@ func (x *PlayerSetItemCooldownAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerSetItemCooldownAction.ProtoReflect.Descriptor instead.
-func (*PlayerSetItemCooldownAction) Descriptor() ([]byte, []int) {
-	return file_actions_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use EntitySetNameTagAction.ProtoReflect.Descriptor instead.
+func (*EntitySetNameTagAction) Descriptor() ([]byte, []int) {
+	return file_actions_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *PlayerSetItemCooldownAction) GetPlayerUuid() string {
+func (x *EntitySetNameTagAction) GetEntityUuid() string {
 	if x != nil {
-		return x.PlayerUuid
+		return x.EntityUuid
 	}
 	return ""
 }
 
-func (x *PlayerSetItemCooldownAction) GetItem() *ItemStack {
+func (x *EntitySetNameTagAction) GetWorld() *WorldRef {
 	if x != nil {
-		return x.Item
+		return x.World
 	}
 	return nil
 }
 
-func (x *PlayerSetItemCooldownAction) GetDurationMs() int64 {
+func (x *EntitySetNameTagAction) GetNameTag() string {
 	if x != nil {
-		return x.DurationMs
+		return x.NameTag
 	}
-	return 0
+	return ""
 }
 
 var File_actions_proto protoreflect.FileDescriptor
 
 const file_actions_proto_rawDesc = "" +
 	"\n" +
-	"\ractions.proto\x12\tdf.plugin\x1a\fcommon.proto\":\n" +
+	"\ractions.proto\x12\tdf.plugin\x1a\fcommon.proto\"\x91\x01\n" +
 	"\vActionBatch\x12+\n" +
-	"\aactions\x18\x01 \x03(\v2\x11.df.plugin.ActionR\aactions\"\xf8F\n" +
+	"\aactions\x18\x01 \x03(\v2\x11.df.plugin.ActionR\aactions\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\x12*\n" +
+	"\x0ecorrelation_id\x18\x03 \x01(\tH\x00R\rcorrelationId\x88\x01\x01B\x11\n" +
+	"\x0f_correlation_id\"\x8bQ\n" +
 	"\x06Action\x12*\n" +
 	"\x0ecorrelation_id\x18\x01 \x01(\tH\x01R\rcorrelationId\x88\x01\x01\x128\n" +
 	"\tsend_chat\x18\n" +
@@ -7935,7 +10146,15 @@ const file_actions_proto_rawDesc = "" +
 	"\x11player_set_armour\x18\x84\x01 \x01(\v2 .df.plugin.PlayerSetArmourActionH\x00R\x0fplayerSetArmour\x12k\n" +
 	"\x1bplayer_open_block_container\x18\x93\x01 \x01(\v2).df.plugin.PlayerOpenBlockContainerActionH\x00R\x18playerOpenBlockContainer\x12L\n" +
 	"\x10player_drop_item\x18\x94\x01 \x01(\v2\x1f.df.plugin.PlayerDropItemActionH\x00R\x0eplayerDropItem\x12b\n" +
-	"\x18player_set_item_cooldown\x18\x95\x01 \x01(\v2&.df.plugin.PlayerSetItemCooldownActionH\x00R\x15playerSetItemCooldown\x12;\n" +
+	"\x18player_set_item_cooldown\x18\x95\x01 \x01(\v2&.df.plugin.PlayerSetItemCooldownActionH\x00R\x15playerSetItemCooldown\x12K\n" +
+	"\x0finventory_query\x18\xa0\x01 \x01(\v2\x1f.df.plugin.InventoryQueryActionH\x00R\x0einventoryQuery\x12R\n" +
+	"\x12inventory_set_slot\x18\xa1\x01 \x01(\v2!.df.plugin.InventorySetSlotActionH\x00R\x10inventorySetSlot\x12[\n" +
+	"\x15inventory_remove_item\x18\xa2\x01 \x01(\v2$.df.plugin.InventoryRemoveItemActionH\x00R\x13inventoryRemoveItem\x12X\n" +
+	"\x14inventory_swap_slots\x18\xa3\x01 \x01(\v2#.df.plugin.InventorySwapSlotsActionH\x00R\x12inventorySwapSlots\x12[\n" +
+	"\x15inventory_clear_range\x18\xa4\x01 \x01(\v2$.df.plugin.InventoryClearRangeActionH\x00R\x13inventoryClearRange\x12q\n" +
+	"\x1dplayer_open_virtual_inventory\x18\xa5\x01 \x01(\v2+.df.plugin.PlayerOpenVirtualInventoryActionH\x00R\x1aplayerOpenVirtualInventory\x12~\n" +
+	"\"player_set_virtual_inventory_slots\x18\xa6\x01 \x01(\v2/.df.plugin.PlayerSetVirtualInventorySlotsActionH\x00R\x1eplayerSetVirtualInventorySlots\x12t\n" +
+	"\x1eplayer_close_virtual_inventory\x18\xa7\x01 \x01(\v2,.df.plugin.PlayerCloseVirtualInventoryActionH\x00R\x1bplayerCloseVirtualInventory\x12;\n" +
 	"\n" +
 	"set_health\x18\x14 \x01(\v2\x1a.df.plugin.SetHealthActionH\x00R\tsetHealth\x125\n" +
 	"\bset_food\x18\x15 \x01(\v2\x18.df.plugin.SetFoodActionH\x00R\asetFood\x12G\n" +
@@ -7964,7 +10183,8 @@ const file_actions_proto_rawDesc = "" +
 	"\x18player_remove_scoreboard\x18\x86\x01 \x01(\v2'.df.plugin.PlayerRemoveScoreboardActionH\x00R\x16playerRemoveScoreboard\x12Y\n" +
 	"\x15player_send_menu_form\x18\x96\x01 \x01(\v2#.df.plugin.PlayerSendMenuFormActionH\x00R\x12playerSendMenuForm\x12\\\n" +
 	"\x16player_send_modal_form\x18\x97\x01 \x01(\v2$.df.plugin.PlayerSendModalFormActionH\x00R\x13playerSendModalForm\x12X\n" +
-	"\x14player_send_dialogue\x18\x98\x01 \x01(\v2#.df.plugin.PlayerSendDialogueActionH\x00R\x12playerSendDialogue\x12[\n" +
+	"\x14player_send_dialogue\x18\x98\x01 \x01(\v2#.df.plugin.PlayerSendDialogueActionH\x00R\x12playerSendDialogue\x12_\n" +
+	"\x17player_send_custom_form\x18\x99\x01 \x01(\v2%.df.plugin.PlayerSendCustomFormActionH\x00R\x14playerSendCustomForm\x12[\n" +
 	"\x15player_close_dialogue\x18\x8b\x01 \x01(\v2$.df.plugin.PlayerCloseDialogueActionH\x00R\x13playerCloseDialogue\x12O\n" +
 	"\x11player_close_form\x18\x8c\x01 \x01(\v2 .df.plugin.PlayerCloseFormActionH\x00R\x0fplayerCloseForm\x12J\n" +
 	"\x0fexecute_command\x182 \x01(\v2\x1f.df.plugin.ExecuteCommandActionH\x00R\x0eexecuteCommand\x12]\n" +
@@ -8020,7 +10240,12 @@ const file_actions_proto_rawDesc = "" +
 	"\x0fworld_set_biome\x18Z \x01(\v2\x1e.df.plugin.WorldSetBiomeActionH\x00R\rworldSetBiome\x12K\n" +
 	"\x10world_set_liquid\x18[ \x01(\v2\x1f.df.plugin.WorldSetLiquidActionH\x00R\x0eworldSetLiquid\x12j\n" +
 	"\x1bworld_schedule_block_update\x18\\ \x01(\v2).df.plugin.WorldScheduleBlockUpdateActionH\x00R\x18worldScheduleBlockUpdate\x12Z\n" +
-	"\x15world_build_structure\x18] \x01(\v2$.df.plugin.WorldBuildStructureActionH\x00R\x13worldBuildStructure\x12W\n" +
+	"\x15world_build_structure\x18] \x01(\v2$.df.plugin.WorldBuildStructureActionH\x00R\x13worldBuildStructure\x12R\n" +
+	"\x12world_spawn_entity\x18\xa8\x01 \x01(\v2!.df.plugin.WorldSpawnEntityActionH\x00R\x10worldSpawnEntity\x12E\n" +
+	"\rentity_remove\x18\xa9\x01 \x01(\v2\x1d.df.plugin.EntityRemoveActionH\x00R\fentityRemove\x12K\n" +
+	"\x0fentity_teleport\x18\xaa\x01 \x01(\v2\x1f.df.plugin.EntityTeleportActionH\x00R\x0eentityTeleport\x12U\n" +
+	"\x13entity_set_velocity\x18\xab\x01 \x01(\v2\".df.plugin.EntitySetVelocityActionH\x00R\x11entitySetVelocity\x12S\n" +
+	"\x13entity_set_name_tag\x18\xac\x01 \x01(\v2!.df.plugin.EntitySetNameTagActionH\x00R\x10entitySetNameTag\x12W\n" +
 	"\x14world_query_entities\x18F \x01(\v2#.df.plugin.WorldQueryEntitiesActionH\x00R\x12worldQueryEntities\x12T\n" +
 	"\x13world_query_players\x18G \x01(\v2\".df.plugin.WorldQueryPlayersActionH\x00R\x11worldQueryPlayers\x12j\n" +
 	"\x1bworld_query_entities_within\x18H \x01(\v2).df.plugin.WorldQueryEntitiesWithinActionH\x00R\x18worldQueryEntitiesWithin\x12a\n" +
@@ -8425,29 +10650,88 @@ const file_actions_proto_rawDesc = "" +
 	"\v_descending\"?\n" +
 	"\x1cPlayerRemoveScoreboardAction\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
-	"playerUuid\"\x8d\x01\n" +
+	"playerUuid\"\xf5\x01\n" +
 	"\x18PlayerSendMenuFormAction\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
-	"\x04body\x18\x03 \x01(\tH\x00R\x04body\x88\x01\x01\x12\x18\n" +
-	"\abuttons\x18\x04 \x03(\tR\abuttonsB\a\n" +
-	"\x05_body\"\x9a\x01\n" +
+	"\x04body\x18\x03 \x01(\tH\x00R\x04body\x88\x01\x01\x12\x1c\n" +
+	"\abuttons\x18\x04 \x03(\tB\x02\x18\x01R\abuttons\x12\x1c\n" +
+	"\aform_id\x18\x05 \x01(\tH\x01R\x06formId\x88\x01\x01\x128\n" +
+	"\fform_buttons\x18\x06 \x03(\v2\x15.df.plugin.FormButtonR\vformButtonsB\a\n" +
+	"\x05_bodyB\n" +
+	"\n" +
+	"\b_form_id\"a\n" +
+	"\n" +
+	"FormButton\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x19\n" +
+	"\x05image\x18\x02 \x01(\tH\x00R\x05image\x88\x01\x01\x12\x13\n" +
+	"\x02id\x18\x03 \x01(\tH\x01R\x02id\x88\x01\x01B\b\n" +
+	"\x06_imageB\x05\n" +
+	"\x03_id\"\xc4\x01\n" +
 	"\x19PlayerSendModalFormAction\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
 	"\x04body\x18\x03 \x01(\tR\x04body\x12\x19\n" +
 	"\byes_text\x18\x04 \x01(\tR\ayesText\x12\x17\n" +
-	"\ano_text\x18\x05 \x01(\tR\x06noText\"\xbb\x01\n" +
+	"\ano_text\x18\x05 \x01(\tR\x06noText\x12\x1c\n" +
+	"\aform_id\x18\x06 \x01(\tH\x00R\x06formId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_form_id\"\xb1\x01\n" +
+	"\x1aPlayerSendCustomFormAction\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x122\n" +
+	"\belements\x18\x03 \x03(\v2\x16.df.plugin.FormElementR\belements\x12\x1c\n" +
+	"\aform_id\x18\x04 \x01(\tH\x00R\x06formId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_form_id\"\xcb\x02\n" +
+	"\vFormElement\x12,\n" +
+	"\x05label\x18\x01 \x01(\v2\x14.df.plugin.FormLabelH\x00R\x05label\x12,\n" +
+	"\x05input\x18\x02 \x01(\v2\x14.df.plugin.FormInputH\x00R\x05input\x12/\n" +
+	"\x06toggle\x18\x03 \x01(\v2\x15.df.plugin.FormToggleH\x00R\x06toggle\x12/\n" +
+	"\x06slider\x18\x04 \x01(\v2\x15.df.plugin.FormSliderH\x00R\x06slider\x125\n" +
+	"\bdropdown\x18\x05 \x01(\v2\x17.df.plugin.FormDropdownH\x00R\bdropdown\x12<\n" +
+	"\vstep_slider\x18\x06 \x01(\v2\x19.df.plugin.FormStepSliderH\x00R\n" +
+	"stepSliderB\t\n" +
+	"\aelement\"\x1f\n" +
+	"\tFormLabel\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"f\n" +
+	"\tFormInput\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12#\n" +
+	"\rdefault_value\x18\x02 \x01(\tR\fdefaultValue\x12 \n" +
+	"\vplaceholder\x18\x03 \x01(\tR\vplaceholder\"E\n" +
+	"\n" +
+	"FormToggle\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12#\n" +
+	"\rdefault_value\x18\x02 \x01(\bR\fdefaultValue\"\x86\x01\n" +
+	"\n" +
+	"FormSlider\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x10\n" +
+	"\x03min\x18\x02 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x01R\x03max\x12\x1b\n" +
+	"\tstep_size\x18\x04 \x01(\x01R\bstepSize\x12#\n" +
+	"\rdefault_value\x18\x05 \x01(\x01R\fdefaultValue\"a\n" +
+	"\fFormDropdown\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\aoptions\x18\x02 \x03(\tR\aoptions\x12#\n" +
+	"\rdefault_index\x18\x03 \x01(\x05R\fdefaultIndex\"c\n" +
+	"\x0eFormStepSlider\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x18\n" +
+	"\aoptions\x18\x02 \x03(\tR\aoptions\x12#\n" +
+	"\rdefault_index\x18\x03 \x01(\x05R\fdefaultIndex\"\xe5\x01\n" +
 	"\x18PlayerSendDialogueAction\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x17\n" +
 	"\x04body\x18\x03 \x01(\tH\x00R\x04body\x88\x01\x01\x12\x18\n" +
 	"\abuttons\x18\x04 \x03(\tR\abuttons\x12,\n" +
-	"\x06entity\x18\x05 \x01(\v2\x14.df.plugin.EntityRefR\x06entityB\a\n" +
-	"\x05_body\"\xd8\x01\n" +
+	"\x06entity\x18\x05 \x01(\v2\x14.df.plugin.EntityRefR\x06entity\x12\x1c\n" +
+	"\aform_id\x18\x06 \x01(\tH\x01R\x06formId\x88\x01\x01B\a\n" +
+	"\x05_bodyB\n" +
+	"\n" +
+	"\b_form_id\"\xd8\x01\n" +
 	"\x17PlayerSendBossBarAction\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x12\n" +
@@ -8518,7 +10802,109 @@ const file_actions_proto_rawDesc = "" +
 	"playerUuid\x12(\n" +
 	"\x04item\x18\x02 \x01(\v2\x14.df.plugin.ItemStackR\x04item\x12\x1f\n" +
 	"\vduration_ms\x18\x03 \x01(\x03R\n" +
-	"durationMs*\xeb\x03\n" +
+	"durationMs\"J\n" +
+	"\x14InventoryQueryAction\x122\n" +
+	"\x06target\x18\x01 \x01(\v2\x1a.df.plugin.InventoryTargetR\x06target\"\x98\x01\n" +
+	"\x16InventorySetSlotAction\x122\n" +
+	"\x06target\x18\x01 \x01(\v2\x1a.df.plugin.InventoryTargetR\x06target\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\x05R\x04slot\x12-\n" +
+	"\x04item\x18\x03 \x01(\v2\x14.df.plugin.ItemStackH\x00R\x04item\x88\x01\x01B\a\n" +
+	"\x05_item\"y\n" +
+	"\x19InventoryRemoveItemAction\x122\n" +
+	"\x06target\x18\x01 \x01(\v2\x1a.df.plugin.InventoryTargetR\x06target\x12(\n" +
+	"\x04item\x18\x02 \x01(\v2\x14.df.plugin.ItemStackR\x04item\"|\n" +
+	"\x18InventorySwapSlotsAction\x122\n" +
+	"\x06target\x18\x01 \x01(\v2\x1a.df.plugin.InventoryTargetR\x06target\x12\x15\n" +
+	"\x06slot_a\x18\x02 \x01(\x05R\x05slotA\x12\x15\n" +
+	"\x06slot_b\x18\x03 \x01(\x05R\x05slotB\"\x85\x01\n" +
+	"\x19InventoryClearRangeAction\x122\n" +
+	"\x06target\x18\x01 \x01(\v2\x1a.df.plugin.InventoryTargetR\x06target\x12\x1b\n" +
+	"\tfrom_slot\x18\x02 \x01(\x05R\bfromSlot\x12\x17\n" +
+	"\ato_slot\x18\x03 \x01(\x05R\x06toSlot\"\x80\x02\n" +
+	" PlayerOpenVirtualInventoryAction\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12!\n" +
+	"\finventory_id\x18\x02 \x01(\tR\vinventoryId\x123\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1f.df.plugin.VirtualInventoryTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12.\n" +
+	"\x05slots\x18\x05 \x03(\v2\x18.df.plugin.InventorySlotR\x05slots\x12\x1d\n" +
+	"\n" +
+	"allow_take\x18\x06 \x01(\bR\tallowTake\"\x9a\x01\n" +
+	"$PlayerSetVirtualInventorySlotsAction\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12!\n" +
+	"\finventory_id\x18\x02 \x01(\tR\vinventoryId\x12.\n" +
+	"\x05slots\x18\x03 \x03(\v2\x18.df.plugin.InventorySlotR\x05slots\"g\n" +
+	"!PlayerCloseVirtualInventoryAction\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12!\n" +
+	"\finventory_id\x18\x02 \x01(\tR\vinventoryId\"\xcb\x05\n" +
+	"\x16WorldSpawnEntityAction\x12)\n" +
+	"\x05world\x18\x01 \x01(\v2\x13.df.plugin.WorldRefR\x05world\x12+\n" +
+	"\bposition\x18\x02 \x01(\v2\x0f.df.plugin.Vec3R\bposition\x124\n" +
+	"\brotation\x18\x03 \x01(\v2\x13.df.plugin.RotationH\x01R\brotation\x88\x01\x01\x120\n" +
+	"\bvelocity\x18\x04 \x01(\v2\x0f.df.plugin.Vec3H\x02R\bvelocity\x88\x01\x01\x12\x1e\n" +
+	"\bname_tag\x18\x05 \x01(\tH\x03R\anameTag\x88\x01\x01\x120\n" +
+	"\x04item\x18\n" +
+	" \x01(\v2\x1a.df.plugin.SpawnItemEntityH\x00R\x04item\x12I\n" +
+	"\rfalling_block\x18\v \x01(\v2\".df.plugin.SpawnFallingBlockEntityH\x00R\ffallingBlock\x12-\n" +
+	"\x03tnt\x18\f \x01(\v2\x19.df.plugin.SpawnTNTEntityH\x00R\x03tnt\x123\n" +
+	"\x05arrow\x18\r \x01(\v2\x1b.df.plugin.SpawnArrowEntityH\x00R\x05arrow\x12L\n" +
+	"\x0eexperience_orb\x18\x0e \x01(\v2#.df.plugin.SpawnExperienceOrbEntityH\x00R\rexperienceOrb\x12?\n" +
+	"\tlightning\x18\x0f \x01(\v2\x1f.df.plugin.SpawnLightningEntityH\x00R\tlightning\x120\n" +
+	"\x04text\x18\x10 \x01(\v2\x1a.df.plugin.SpawnTextEntityH\x00R\x04textB\b\n" +
+	"\x06entityB\v\n" +
+	"\t_rotationB\v\n" +
+	"\t_velocityB\v\n" +
+	"\t_name_tag\"|\n" +
+	"\x0fSpawnItemEntity\x12(\n" +
+	"\x04item\x18\x01 \x01(\v2\x14.df.plugin.ItemStackR\x04item\x12+\n" +
+	"\x0fpickup_delay_ms\x18\x02 \x01(\x03H\x00R\rpickupDelayMs\x88\x01\x01B\x12\n" +
+	"\x10_pickup_delay_ms\"F\n" +
+	"\x17SpawnFallingBlockEntity\x12+\n" +
+	"\x05block\x18\x01 \x01(\v2\x15.df.plugin.BlockStateR\x05block\":\n" +
+	"\x0eSpawnTNTEntity\x12\x1c\n" +
+	"\afuse_ms\x18\x01 \x01(\x03H\x00R\x06fuseMs\x88\x01\x01B\n" +
+	"\n" +
+	"\b_fuse_ms\"m\n" +
+	"\x10SpawnArrowEntity\x12\x1b\n" +
+	"\x06damage\x18\x01 \x01(\x01H\x00R\x06damage\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"owner_uuid\x18\x02 \x01(\tH\x01R\townerUuid\x88\x01\x01B\t\n" +
+	"\a_damageB\r\n" +
+	"\v_owner_uuid\":\n" +
+	"\x18SpawnExperienceOrbEntity\x12\x1e\n" +
+	"\n" +
+	"experience\x18\x01 \x01(\x05R\n" +
+	"experience\"\x16\n" +
+	"\x14SpawnLightningEntity\"%\n" +
+	"\x0fSpawnTextEntity\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"o\n" +
+	"\x12EntityRemoveAction\x12\x1f\n" +
+	"\ventity_uuid\x18\x01 \x01(\tR\n" +
+	"entityUuid\x12.\n" +
+	"\x05world\x18\x02 \x01(\v2\x13.df.plugin.WorldRefH\x00R\x05world\x88\x01\x01B\b\n" +
+	"\x06_world\"\xe1\x01\n" +
+	"\x14EntityTeleportAction\x12\x1f\n" +
+	"\ventity_uuid\x18\x01 \x01(\tR\n" +
+	"entityUuid\x12.\n" +
+	"\x05world\x18\x02 \x01(\v2\x13.df.plugin.WorldRefH\x00R\x05world\x88\x01\x01\x12+\n" +
+	"\bposition\x18\x03 \x01(\v2\x0f.df.plugin.Vec3R\bposition\x124\n" +
+	"\brotation\x18\x04 \x01(\v2\x13.df.plugin.RotationH\x01R\brotation\x88\x01\x01B\b\n" +
+	"\x06_worldB\v\n" +
+	"\t_rotation\"\xa1\x01\n" +
+	"\x17EntitySetVelocityAction\x12\x1f\n" +
+	"\ventity_uuid\x18\x01 \x01(\tR\n" +
+	"entityUuid\x12.\n" +
+	"\x05world\x18\x02 \x01(\v2\x13.df.plugin.WorldRefH\x00R\x05world\x88\x01\x01\x12+\n" +
+	"\bvelocity\x18\x03 \x01(\v2\x0f.df.plugin.Vec3R\bvelocityB\b\n" +
+	"\x06_world\"\x8e\x01\n" +
+	"\x16EntitySetNameTagAction\x12\x1f\n" +
+	"\ventity_uuid\x18\x01 \x01(\tR\n" +
+	"entityUuid\x12.\n" +
+	"\x05world\x18\x02 \x01(\v2\x13.df.plugin.WorldRefH\x00R\x05world\x88\x01\x01\x12\x19\n" +
+	"\bname_tag\x18\x03 \x01(\tR\anameTagB\b\n" +
+	"\x06_world*\xeb\x03\n" +
 	"\fParticleType\x12\x1d\n" +
 	"\x19PARTICLE_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17PARTICLE_HUGE_EXPLOSION\x10\x01\x12\x1e\n" +
@@ -8563,7 +10949,11 @@ const file_actions_proto_rawDesc = "" +
 	"\x18HUD_ELEMENT_HORSE_HEALTH\x10\n" +
 	"\x12\x1e\n" +
 	"\x1aHUD_ELEMENT_STATUS_EFFECTS\x10\v\x12\x19\n" +
-	"\x15HUD_ELEMENT_ITEM_TEXT\x10\fB\x8b\x01\n" +
+	"\x15HUD_ELEMENT_ITEM_TEXT\x10\f*\x84\x01\n" +
+	"\x14VirtualInventoryType\x12 \n" +
+	"\x1cVIRTUAL_INVENTORY_TYPE_CHEST\x10\x00\x12'\n" +
+	"#VIRTUAL_INVENTORY_TYPE_DOUBLE_CHEST\x10\x01\x12!\n" +
+	"\x1dVIRTUAL_INVENTORY_TYPE_HOPPER\x10\x02B\x8b\x01\n" +
 	"\rcom.df.pluginB\fActionsProtoP\x01Z'github.com/secmc/plugin/proto/generated\xa2\x02\x03DPX\xaa\x02\tDf.Plugin\xca\x02\tDf\\Plugin\xe2\x02\x15Df\\Plugin\\GPBMetadata\xea\x02\n" +
 	"Df::Pluginb\x06proto3"
 
@@ -8579,338 +10969,423 @@ func file_actions_proto_rawDescGZIP() []byte {
 	return file_actions_proto_rawDescData
 }
 
-var file_actions_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_actions_proto_msgTypes = make([]protoimpl.MessageInfo, 110)
+var file_actions_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_actions_proto_msgTypes = make([]protoimpl.MessageInfo, 139)
 var file_actions_proto_goTypes = []any{
-	(ParticleType)(0),                          // 0: df.plugin.ParticleType
-	(BossBarColour)(0),                         // 1: df.plugin.BossBarColour
-	(HudElement)(0),                            // 2: df.plugin.HudElement
-	(*ActionBatch)(nil),                        // 3: df.plugin.ActionBatch
-	(*Action)(nil),                             // 4: df.plugin.Action
-	(*SendChatAction)(nil),                     // 5: df.plugin.SendChatAction
-	(*TeleportAction)(nil),                     // 6: df.plugin.TeleportAction
-	(*KickAction)(nil),                         // 7: df.plugin.KickAction
-	(*SetGameModeAction)(nil),                  // 8: df.plugin.SetGameModeAction
-	(*GiveItemAction)(nil),                     // 9: df.plugin.GiveItemAction
-	(*ClearInventoryAction)(nil),               // 10: df.plugin.ClearInventoryAction
-	(*SetHeldItemAction)(nil),                  // 11: df.plugin.SetHeldItemAction
-	(*SetHealthAction)(nil),                    // 12: df.plugin.SetHealthAction
-	(*SetFoodAction)(nil),                      // 13: df.plugin.SetFoodAction
-	(*SetExperienceAction)(nil),                // 14: df.plugin.SetExperienceAction
-	(*SetVelocityAction)(nil),                  // 15: df.plugin.SetVelocityAction
-	(*AddEffectAction)(nil),                    // 16: df.plugin.AddEffectAction
-	(*RemoveEffectAction)(nil),                 // 17: df.plugin.RemoveEffectAction
-	(*SendTitleAction)(nil),                    // 18: df.plugin.SendTitleAction
-	(*SendPopupAction)(nil),                    // 19: df.plugin.SendPopupAction
-	(*SendTipAction)(nil),                      // 20: df.plugin.SendTipAction
-	(*PlaySoundAction)(nil),                    // 21: df.plugin.PlaySoundAction
-	(*ExecuteCommandAction)(nil),               // 22: df.plugin.ExecuteCommandAction
-	(*WorldSetDefaultGameModeAction)(nil),      // 23: df.plugin.WorldSetDefaultGameModeAction
-	(*WorldSetDifficultyAction)(nil),           // 24: df.plugin.WorldSetDifficultyAction
-	(*WorldSetTickRangeAction)(nil),            // 25: df.plugin.WorldSetTickRangeAction
-	(*WorldSetBlockAction)(nil),                // 26: df.plugin.WorldSetBlockAction
-	(*WorldPlaySoundAction)(nil),               // 27: df.plugin.WorldPlaySoundAction
-	(*WorldAddParticleAction)(nil),             // 28: df.plugin.WorldAddParticleAction
-	(*WorldSetTimeAction)(nil),                 // 29: df.plugin.WorldSetTimeAction
-	(*WorldStopTimeAction)(nil),                // 30: df.plugin.WorldStopTimeAction
-	(*WorldStartTimeAction)(nil),               // 31: df.plugin.WorldStartTimeAction
-	(*WorldSetSpawnAction)(nil),                // 32: df.plugin.WorldSetSpawnAction
-	(*WorldQueryDefaultGameModeAction)(nil),    // 33: df.plugin.WorldQueryDefaultGameModeAction
-	(*WorldQueryPlayerSpawnAction)(nil),        // 34: df.plugin.WorldQueryPlayerSpawnAction
-	(*WorldQueryEntitiesAction)(nil),           // 35: df.plugin.WorldQueryEntitiesAction
-	(*WorldQueryPlayersAction)(nil),            // 36: df.plugin.WorldQueryPlayersAction
-	(*WorldQueryEntitiesWithinAction)(nil),     // 37: df.plugin.WorldQueryEntitiesWithinAction
-	(*WorldQueryBlockAction)(nil),              // 38: df.plugin.WorldQueryBlockAction
-	(*WorldQueryBiomeAction)(nil),              // 39: df.plugin.WorldQueryBiomeAction
-	(*WorldQueryLightAction)(nil),              // 40: df.plugin.WorldQueryLightAction
-	(*WorldQuerySkyLightAction)(nil),           // 41: df.plugin.WorldQuerySkyLightAction
-	(*WorldQueryTemperatureAction)(nil),        // 42: df.plugin.WorldQueryTemperatureAction
-	(*WorldQueryHighestBlockAction)(nil),       // 43: df.plugin.WorldQueryHighestBlockAction
-	(*WorldQueryRainingAtAction)(nil),          // 44: df.plugin.WorldQueryRainingAtAction
-	(*WorldQuerySnowingAtAction)(nil),          // 45: df.plugin.WorldQuerySnowingAtAction
-	(*WorldQueryThunderingAtAction)(nil),       // 46: df.plugin.WorldQueryThunderingAtAction
-	(*WorldQueryLiquidAction)(nil),             // 47: df.plugin.WorldQueryLiquidAction
-	(*WorldSetBiomeAction)(nil),                // 48: df.plugin.WorldSetBiomeAction
-	(*WorldSetLiquidAction)(nil),               // 49: df.plugin.WorldSetLiquidAction
-	(*WorldScheduleBlockUpdateAction)(nil),     // 50: df.plugin.WorldScheduleBlockUpdateAction
-	(*StructureVoxel)(nil),                     // 51: df.plugin.StructureVoxel
-	(*StructureDef)(nil),                       // 52: df.plugin.StructureDef
-	(*WorldBuildStructureAction)(nil),          // 53: df.plugin.WorldBuildStructureAction
-	(*PlayerStartSprintingAction)(nil),         // 54: df.plugin.PlayerStartSprintingAction
-	(*PlayerStopSprintingAction)(nil),          // 55: df.plugin.PlayerStopSprintingAction
-	(*PlayerStartSneakingAction)(nil),          // 56: df.plugin.PlayerStartSneakingAction
-	(*PlayerStopSneakingAction)(nil),           // 57: df.plugin.PlayerStopSneakingAction
-	(*PlayerStartSwimmingAction)(nil),          // 58: df.plugin.PlayerStartSwimmingAction
-	(*PlayerStopSwimmingAction)(nil),           // 59: df.plugin.PlayerStopSwimmingAction
-	(*PlayerStartCrawlingAction)(nil),          // 60: df.plugin.PlayerStartCrawlingAction
-	(*PlayerStopCrawlingAction)(nil),           // 61: df.plugin.PlayerStopCrawlingAction
-	(*PlayerStartGlidingAction)(nil),           // 62: df.plugin.PlayerStartGlidingAction
-	(*PlayerStopGlidingAction)(nil),            // 63: df.plugin.PlayerStopGlidingAction
-	(*PlayerStartFlyingAction)(nil),            // 64: df.plugin.PlayerStartFlyingAction
-	(*PlayerStopFlyingAction)(nil),             // 65: df.plugin.PlayerStopFlyingAction
-	(*PlayerSetImmobileAction)(nil),            // 66: df.plugin.PlayerSetImmobileAction
-	(*PlayerSetMobileAction)(nil),              // 67: df.plugin.PlayerSetMobileAction
-	(*PlayerSetSpeedAction)(nil),               // 68: df.plugin.PlayerSetSpeedAction
-	(*PlayerSetFlightSpeedAction)(nil),         // 69: df.plugin.PlayerSetFlightSpeedAction
-	(*PlayerSetVerticalFlightSpeedAction)(nil), // 70: df.plugin.PlayerSetVerticalFlightSpeedAction
-	(*PlayerSetAbsorptionAction)(nil),          // 71: df.plugin.PlayerSetAbsorptionAction
-	(*PlayerSetOnFireAction)(nil),              // 72: df.plugin.PlayerSetOnFireAction
-	(*PlayerExtinguishAction)(nil),             // 73: df.plugin.PlayerExtinguishAction
-	(*PlayerSetInvisibleAction)(nil),           // 74: df.plugin.PlayerSetInvisibleAction
-	(*PlayerSetVisibleAction)(nil),             // 75: df.plugin.PlayerSetVisibleAction
-	(*PlayerSetScaleAction)(nil),               // 76: df.plugin.PlayerSetScaleAction
-	(*PlayerSetHeldSlotAction)(nil),            // 77: df.plugin.PlayerSetHeldSlotAction
-	(*PlayerSendToastAction)(nil),              // 78: df.plugin.PlayerSendToastAction
-	(*PlayerSendJukeboxPopupAction)(nil),       // 79: df.plugin.PlayerSendJukeboxPopupAction
-	(*PlayerShowCoordinatesAction)(nil),        // 80: df.plugin.PlayerShowCoordinatesAction
-	(*PlayerHideCoordinatesAction)(nil),        // 81: df.plugin.PlayerHideCoordinatesAction
-	(*PlayerEnableInstantRespawnAction)(nil),   // 82: df.plugin.PlayerEnableInstantRespawnAction
-	(*PlayerDisableInstantRespawnAction)(nil),  // 83: df.plugin.PlayerDisableInstantRespawnAction
-	(*PlayerSetNameTagAction)(nil),             // 84: df.plugin.PlayerSetNameTagAction
-	(*PlayerSetScoreTagAction)(nil),            // 85: df.plugin.PlayerSetScoreTagAction
-	(*PlayerShowParticleAction)(nil),           // 86: df.plugin.PlayerShowParticleAction
-	(*PlayerRespawnAction)(nil),                // 87: df.plugin.PlayerRespawnAction
-	(*PlayerTransferAction)(nil),               // 88: df.plugin.PlayerTransferAction
-	(*PlayerKnockBackAction)(nil),              // 89: df.plugin.PlayerKnockBackAction
-	(*PlayerSwingArmAction)(nil),               // 90: df.plugin.PlayerSwingArmAction
-	(*PlayerPunchAirAction)(nil),               // 91: df.plugin.PlayerPunchAirAction
-	(*PlayerSetArmourAction)(nil),              // 92: df.plugin.PlayerSetArmourAction
-	(*PlayerSendScoreboardAction)(nil),         // 93: df.plugin.PlayerSendScoreboardAction
-	(*PlayerRemoveScoreboardAction)(nil),       // 94: df.plugin.PlayerRemoveScoreboardAction
-	(*PlayerSendMenuFormAction)(nil),           // 95: df.plugin.PlayerSendMenuFormAction
-	(*PlayerSendModalFormAction)(nil),          // 96: df.plugin.PlayerSendModalFormAction
-	(*PlayerSendDialogueAction)(nil),           // 97: df.plugin.PlayerSendDialogueAction
-	(*PlayerSendBossBarAction)(nil),            // 98: df.plugin.PlayerSendBossBarAction
-	(*PlayerRemoveBossBarAction)(nil),          // 99: df.plugin.PlayerRemoveBossBarAction
-	(*PlayerShowHudElementAction)(nil),         // 100: df.plugin.PlayerShowHudElementAction
-	(*PlayerHideHudElementAction)(nil),         // 101: df.plugin.PlayerHideHudElementAction
-	(*PlayerCloseDialogueAction)(nil),          // 102: df.plugin.PlayerCloseDialogueAction
-	(*PlayerCloseFormAction)(nil),              // 103: df.plugin.PlayerCloseFormAction
-	(*PlayerOpenSignAction)(nil),               // 104: df.plugin.PlayerOpenSignAction
-	(*PlayerEditSignAction)(nil),               // 105: df.plugin.PlayerEditSignAction
-	(*PlayerTurnLecternPageAction)(nil),        // 106: df.plugin.PlayerTurnLecternPageAction
-	(*PlayerHidePlayerAction)(nil),             // 107: df.plugin.PlayerHidePlayerAction
-	(*PlayerShowPlayerAction)(nil),             // 108: df.plugin.PlayerShowPlayerAction
-	(*PlayerRemoveAllDebugShapesAction)(nil),   // 109: df.plugin.PlayerRemoveAllDebugShapesAction
-	(*PlayerOpenBlockContainerAction)(nil),     // 110: df.plugin.PlayerOpenBlockContainerAction
-	(*PlayerDropItemAction)(nil),               // 111: df.plugin.PlayerDropItemAction
-	(*PlayerSetItemCooldownAction)(nil),        // 112: df.plugin.PlayerSetItemCooldownAction
-	(*Vec3)(nil),                               // 113: df.plugin.Vec3
-	(GameMode)(0),                              // 114: df.plugin.GameMode
-	(*ItemStack)(nil),                          // 115: df.plugin.ItemStack
-	(EffectType)(0),                            // 116: df.plugin.EffectType
-	(Sound)(0),                                 // 117: df.plugin.Sound
-	(*WorldRef)(nil),                           // 118: df.plugin.WorldRef
-	(Difficulty)(0),                            // 119: df.plugin.Difficulty
-	(*BlockPos)(nil),                           // 120: df.plugin.BlockPos
-	(*BlockState)(nil),                         // 121: df.plugin.BlockState
-	(*BBox)(nil),                               // 122: df.plugin.BBox
-	(*LiquidState)(nil),                        // 123: df.plugin.LiquidState
-	(*Address)(nil),                            // 124: df.plugin.Address
-	(*EntityRef)(nil),                          // 125: df.plugin.EntityRef
+	(ParticleType)(0),                            // 0: df.plugin.ParticleType
+	(BossBarColour)(0),                           // 1: df.plugin.BossBarColour
+	(HudElement)(0),                              // 2: df.plugin.HudElement
+	(VirtualInventoryType)(0),                    // 3: df.plugin.VirtualInventoryType
+	(*ActionBatch)(nil),                          // 4: df.plugin.ActionBatch
+	(*Action)(nil),                               // 5: df.plugin.Action
+	(*SendChatAction)(nil),                       // 6: df.plugin.SendChatAction
+	(*TeleportAction)(nil),                       // 7: df.plugin.TeleportAction
+	(*KickAction)(nil),                           // 8: df.plugin.KickAction
+	(*SetGameModeAction)(nil),                    // 9: df.plugin.SetGameModeAction
+	(*GiveItemAction)(nil),                       // 10: df.plugin.GiveItemAction
+	(*ClearInventoryAction)(nil),                 // 11: df.plugin.ClearInventoryAction
+	(*SetHeldItemAction)(nil),                    // 12: df.plugin.SetHeldItemAction
+	(*SetHealthAction)(nil),                      // 13: df.plugin.SetHealthAction
+	(*SetFoodAction)(nil),                        // 14: df.plugin.SetFoodAction
+	(*SetExperienceAction)(nil),                  // 15: df.plugin.SetExperienceAction
+	(*SetVelocityAction)(nil),                    // 16: df.plugin.SetVelocityAction
+	(*AddEffectAction)(nil),                      // 17: df.plugin.AddEffectAction
+	(*RemoveEffectAction)(nil),                   // 18: df.plugin.RemoveEffectAction
+	(*SendTitleAction)(nil),                      // 19: df.plugin.SendTitleAction
+	(*SendPopupAction)(nil),                      // 20: df.plugin.SendPopupAction
+	(*SendTipAction)(nil),                        // 21: df.plugin.SendTipAction
+	(*PlaySoundAction)(nil),                      // 22: df.plugin.PlaySoundAction
+	(*ExecuteCommandAction)(nil),                 // 23: df.plugin.ExecuteCommandAction
+	(*WorldSetDefaultGameModeAction)(nil),        // 24: df.plugin.WorldSetDefaultGameModeAction
+	(*WorldSetDifficultyAction)(nil),             // 25: df.plugin.WorldSetDifficultyAction
+	(*WorldSetTickRangeAction)(nil),              // 26: df.plugin.WorldSetTickRangeAction
+	(*WorldSetBlockAction)(nil),                  // 27: df.plugin.WorldSetBlockAction
+	(*WorldPlaySoundAction)(nil),                 // 28: df.plugin.WorldPlaySoundAction
+	(*WorldAddParticleAction)(nil),               // 29: df.plugin.WorldAddParticleAction
+	(*WorldSetTimeAction)(nil),                   // 30: df.plugin.WorldSetTimeAction
+	(*WorldStopTimeAction)(nil),                  // 31: df.plugin.WorldStopTimeAction
+	(*WorldStartTimeAction)(nil),                 // 32: df.plugin.WorldStartTimeAction
+	(*WorldSetSpawnAction)(nil),                  // 33: df.plugin.WorldSetSpawnAction
+	(*WorldQueryDefaultGameModeAction)(nil),      // 34: df.plugin.WorldQueryDefaultGameModeAction
+	(*WorldQueryPlayerSpawnAction)(nil),          // 35: df.plugin.WorldQueryPlayerSpawnAction
+	(*WorldQueryEntitiesAction)(nil),             // 36: df.plugin.WorldQueryEntitiesAction
+	(*WorldQueryPlayersAction)(nil),              // 37: df.plugin.WorldQueryPlayersAction
+	(*WorldQueryEntitiesWithinAction)(nil),       // 38: df.plugin.WorldQueryEntitiesWithinAction
+	(*WorldQueryBlockAction)(nil),                // 39: df.plugin.WorldQueryBlockAction
+	(*WorldQueryBiomeAction)(nil),                // 40: df.plugin.WorldQueryBiomeAction
+	(*WorldQueryLightAction)(nil),                // 41: df.plugin.WorldQueryLightAction
+	(*WorldQuerySkyLightAction)(nil),             // 42: df.plugin.WorldQuerySkyLightAction
+	(*WorldQueryTemperatureAction)(nil),          // 43: df.plugin.WorldQueryTemperatureAction
+	(*WorldQueryHighestBlockAction)(nil),         // 44: df.plugin.WorldQueryHighestBlockAction
+	(*WorldQueryRainingAtAction)(nil),            // 45: df.plugin.WorldQueryRainingAtAction
+	(*WorldQuerySnowingAtAction)(nil),            // 46: df.plugin.WorldQuerySnowingAtAction
+	(*WorldQueryThunderingAtAction)(nil),         // 47: df.plugin.WorldQueryThunderingAtAction
+	(*WorldQueryLiquidAction)(nil),               // 48: df.plugin.WorldQueryLiquidAction
+	(*WorldSetBiomeAction)(nil),                  // 49: df.plugin.WorldSetBiomeAction
+	(*WorldSetLiquidAction)(nil),                 // 50: df.plugin.WorldSetLiquidAction
+	(*WorldScheduleBlockUpdateAction)(nil),       // 51: df.plugin.WorldScheduleBlockUpdateAction
+	(*StructureVoxel)(nil),                       // 52: df.plugin.StructureVoxel
+	(*StructureDef)(nil),                         // 53: df.plugin.StructureDef
+	(*WorldBuildStructureAction)(nil),            // 54: df.plugin.WorldBuildStructureAction
+	(*PlayerStartSprintingAction)(nil),           // 55: df.plugin.PlayerStartSprintingAction
+	(*PlayerStopSprintingAction)(nil),            // 56: df.plugin.PlayerStopSprintingAction
+	(*PlayerStartSneakingAction)(nil),            // 57: df.plugin.PlayerStartSneakingAction
+	(*PlayerStopSneakingAction)(nil),             // 58: df.plugin.PlayerStopSneakingAction
+	(*PlayerStartSwimmingAction)(nil),            // 59: df.plugin.PlayerStartSwimmingAction
+	(*PlayerStopSwimmingAction)(nil),             // 60: df.plugin.PlayerStopSwimmingAction
+	(*PlayerStartCrawlingAction)(nil),            // 61: df.plugin.PlayerStartCrawlingAction
+	(*PlayerStopCrawlingAction)(nil),             // 62: df.plugin.PlayerStopCrawlingAction
+	(*PlayerStartGlidingAction)(nil),             // 63: df.plugin.PlayerStartGlidingAction
+	(*PlayerStopGlidingAction)(nil),              // 64: df.plugin.PlayerStopGlidingAction
+	(*PlayerStartFlyingAction)(nil),              // 65: df.plugin.PlayerStartFlyingAction
+	(*PlayerStopFlyingAction)(nil),               // 66: df.plugin.PlayerStopFlyingAction
+	(*PlayerSetImmobileAction)(nil),              // 67: df.plugin.PlayerSetImmobileAction
+	(*PlayerSetMobileAction)(nil),                // 68: df.plugin.PlayerSetMobileAction
+	(*PlayerSetSpeedAction)(nil),                 // 69: df.plugin.PlayerSetSpeedAction
+	(*PlayerSetFlightSpeedAction)(nil),           // 70: df.plugin.PlayerSetFlightSpeedAction
+	(*PlayerSetVerticalFlightSpeedAction)(nil),   // 71: df.plugin.PlayerSetVerticalFlightSpeedAction
+	(*PlayerSetAbsorptionAction)(nil),            // 72: df.plugin.PlayerSetAbsorptionAction
+	(*PlayerSetOnFireAction)(nil),                // 73: df.plugin.PlayerSetOnFireAction
+	(*PlayerExtinguishAction)(nil),               // 74: df.plugin.PlayerExtinguishAction
+	(*PlayerSetInvisibleAction)(nil),             // 75: df.plugin.PlayerSetInvisibleAction
+	(*PlayerSetVisibleAction)(nil),               // 76: df.plugin.PlayerSetVisibleAction
+	(*PlayerSetScaleAction)(nil),                 // 77: df.plugin.PlayerSetScaleAction
+	(*PlayerSetHeldSlotAction)(nil),              // 78: df.plugin.PlayerSetHeldSlotAction
+	(*PlayerSendToastAction)(nil),                // 79: df.plugin.PlayerSendToastAction
+	(*PlayerSendJukeboxPopupAction)(nil),         // 80: df.plugin.PlayerSendJukeboxPopupAction
+	(*PlayerShowCoordinatesAction)(nil),          // 81: df.plugin.PlayerShowCoordinatesAction
+	(*PlayerHideCoordinatesAction)(nil),          // 82: df.plugin.PlayerHideCoordinatesAction
+	(*PlayerEnableInstantRespawnAction)(nil),     // 83: df.plugin.PlayerEnableInstantRespawnAction
+	(*PlayerDisableInstantRespawnAction)(nil),    // 84: df.plugin.PlayerDisableInstantRespawnAction
+	(*PlayerSetNameTagAction)(nil),               // 85: df.plugin.PlayerSetNameTagAction
+	(*PlayerSetScoreTagAction)(nil),              // 86: df.plugin.PlayerSetScoreTagAction
+	(*PlayerShowParticleAction)(nil),             // 87: df.plugin.PlayerShowParticleAction
+	(*PlayerRespawnAction)(nil),                  // 88: df.plugin.PlayerRespawnAction
+	(*PlayerTransferAction)(nil),                 // 89: df.plugin.PlayerTransferAction
+	(*PlayerKnockBackAction)(nil),                // 90: df.plugin.PlayerKnockBackAction
+	(*PlayerSwingArmAction)(nil),                 // 91: df.plugin.PlayerSwingArmAction
+	(*PlayerPunchAirAction)(nil),                 // 92: df.plugin.PlayerPunchAirAction
+	(*PlayerSetArmourAction)(nil),                // 93: df.plugin.PlayerSetArmourAction
+	(*PlayerSendScoreboardAction)(nil),           // 94: df.plugin.PlayerSendScoreboardAction
+	(*PlayerRemoveScoreboardAction)(nil),         // 95: df.plugin.PlayerRemoveScoreboardAction
+	(*PlayerSendMenuFormAction)(nil),             // 96: df.plugin.PlayerSendMenuFormAction
+	(*FormButton)(nil),                           // 97: df.plugin.FormButton
+	(*PlayerSendModalFormAction)(nil),            // 98: df.plugin.PlayerSendModalFormAction
+	(*PlayerSendCustomFormAction)(nil),           // 99: df.plugin.PlayerSendCustomFormAction
+	(*FormElement)(nil),                          // 100: df.plugin.FormElement
+	(*FormLabel)(nil),                            // 101: df.plugin.FormLabel
+	(*FormInput)(nil),                            // 102: df.plugin.FormInput
+	(*FormToggle)(nil),                           // 103: df.plugin.FormToggle
+	(*FormSlider)(nil),                           // 104: df.plugin.FormSlider
+	(*FormDropdown)(nil),                         // 105: df.plugin.FormDropdown
+	(*FormStepSlider)(nil),                       // 106: df.plugin.FormStepSlider
+	(*PlayerSendDialogueAction)(nil),             // 107: df.plugin.PlayerSendDialogueAction
+	(*PlayerSendBossBarAction)(nil),              // 108: df.plugin.PlayerSendBossBarAction
+	(*PlayerRemoveBossBarAction)(nil),            // 109: df.plugin.PlayerRemoveBossBarAction
+	(*PlayerShowHudElementAction)(nil),           // 110: df.plugin.PlayerShowHudElementAction
+	(*PlayerHideHudElementAction)(nil),           // 111: df.plugin.PlayerHideHudElementAction
+	(*PlayerCloseDialogueAction)(nil),            // 112: df.plugin.PlayerCloseDialogueAction
+	(*PlayerCloseFormAction)(nil),                // 113: df.plugin.PlayerCloseFormAction
+	(*PlayerOpenSignAction)(nil),                 // 114: df.plugin.PlayerOpenSignAction
+	(*PlayerEditSignAction)(nil),                 // 115: df.plugin.PlayerEditSignAction
+	(*PlayerTurnLecternPageAction)(nil),          // 116: df.plugin.PlayerTurnLecternPageAction
+	(*PlayerHidePlayerAction)(nil),               // 117: df.plugin.PlayerHidePlayerAction
+	(*PlayerShowPlayerAction)(nil),               // 118: df.plugin.PlayerShowPlayerAction
+	(*PlayerRemoveAllDebugShapesAction)(nil),     // 119: df.plugin.PlayerRemoveAllDebugShapesAction
+	(*PlayerOpenBlockContainerAction)(nil),       // 120: df.plugin.PlayerOpenBlockContainerAction
+	(*PlayerDropItemAction)(nil),                 // 121: df.plugin.PlayerDropItemAction
+	(*PlayerSetItemCooldownAction)(nil),          // 122: df.plugin.PlayerSetItemCooldownAction
+	(*InventoryQueryAction)(nil),                 // 123: df.plugin.InventoryQueryAction
+	(*InventorySetSlotAction)(nil),               // 124: df.plugin.InventorySetSlotAction
+	(*InventoryRemoveItemAction)(nil),            // 125: df.plugin.InventoryRemoveItemAction
+	(*InventorySwapSlotsAction)(nil),             // 126: df.plugin.InventorySwapSlotsAction
+	(*InventoryClearRangeAction)(nil),            // 127: df.plugin.InventoryClearRangeAction
+	(*PlayerOpenVirtualInventoryAction)(nil),     // 128: df.plugin.PlayerOpenVirtualInventoryAction
+	(*PlayerSetVirtualInventorySlotsAction)(nil), // 129: df.plugin.PlayerSetVirtualInventorySlotsAction
+	(*PlayerCloseVirtualInventoryAction)(nil),    // 130: df.plugin.PlayerCloseVirtualInventoryAction
+	(*WorldSpawnEntityAction)(nil),               // 131: df.plugin.WorldSpawnEntityAction
+	(*SpawnItemEntity)(nil),                      // 132: df.plugin.SpawnItemEntity
+	(*SpawnFallingBlockEntity)(nil),              // 133: df.plugin.SpawnFallingBlockEntity
+	(*SpawnTNTEntity)(nil),                       // 134: df.plugin.SpawnTNTEntity
+	(*SpawnArrowEntity)(nil),                     // 135: df.plugin.SpawnArrowEntity
+	(*SpawnExperienceOrbEntity)(nil),             // 136: df.plugin.SpawnExperienceOrbEntity
+	(*SpawnLightningEntity)(nil),                 // 137: df.plugin.SpawnLightningEntity
+	(*SpawnTextEntity)(nil),                      // 138: df.plugin.SpawnTextEntity
+	(*EntityRemoveAction)(nil),                   // 139: df.plugin.EntityRemoveAction
+	(*EntityTeleportAction)(nil),                 // 140: df.plugin.EntityTeleportAction
+	(*EntitySetVelocityAction)(nil),              // 141: df.plugin.EntitySetVelocityAction
+	(*EntitySetNameTagAction)(nil),               // 142: df.plugin.EntitySetNameTagAction
+	(*Vec3)(nil),                                 // 143: df.plugin.Vec3
+	(GameMode)(0),                                // 144: df.plugin.GameMode
+	(*ItemStack)(nil),                            // 145: df.plugin.ItemStack
+	(EffectType)(0),                              // 146: df.plugin.EffectType
+	(Sound)(0),                                   // 147: df.plugin.Sound
+	(*WorldRef)(nil),                             // 148: df.plugin.WorldRef
+	(Difficulty)(0),                              // 149: df.plugin.Difficulty
+	(*BlockPos)(nil),                             // 150: df.plugin.BlockPos
+	(*BlockState)(nil),                           // 151: df.plugin.BlockState
+	(*BBox)(nil),                                 // 152: df.plugin.BBox
+	(*LiquidState)(nil),                          // 153: df.plugin.LiquidState
+	(*Address)(nil),                              // 154: df.plugin.Address
+	(*EntityRef)(nil),                            // 155: df.plugin.EntityRef
+	(*InventoryTarget)(nil),                      // 156: df.plugin.InventoryTarget
+	(*InventorySlot)(nil),                        // 157: df.plugin.InventorySlot
+	(*Rotation)(nil),                             // 158: df.plugin.Rotation
 }
 var file_actions_proto_depIdxs = []int32{
-	4,   // 0: df.plugin.ActionBatch.actions:type_name -> df.plugin.Action
-	5,   // 1: df.plugin.Action.send_chat:type_name -> df.plugin.SendChatAction
-	6,   // 2: df.plugin.Action.teleport:type_name -> df.plugin.TeleportAction
-	7,   // 3: df.plugin.Action.kick:type_name -> df.plugin.KickAction
-	8,   // 4: df.plugin.Action.set_game_mode:type_name -> df.plugin.SetGameModeAction
-	9,   // 5: df.plugin.Action.give_item:type_name -> df.plugin.GiveItemAction
-	10,  // 6: df.plugin.Action.clear_inventory:type_name -> df.plugin.ClearInventoryAction
-	11,  // 7: df.plugin.Action.set_held_item:type_name -> df.plugin.SetHeldItemAction
-	92,  // 8: df.plugin.Action.player_set_armour:type_name -> df.plugin.PlayerSetArmourAction
-	110, // 9: df.plugin.Action.player_open_block_container:type_name -> df.plugin.PlayerOpenBlockContainerAction
-	111, // 10: df.plugin.Action.player_drop_item:type_name -> df.plugin.PlayerDropItemAction
-	112, // 11: df.plugin.Action.player_set_item_cooldown:type_name -> df.plugin.PlayerSetItemCooldownAction
-	12,  // 12: df.plugin.Action.set_health:type_name -> df.plugin.SetHealthAction
-	13,  // 13: df.plugin.Action.set_food:type_name -> df.plugin.SetFoodAction
-	14,  // 14: df.plugin.Action.set_experience:type_name -> df.plugin.SetExperienceAction
-	15,  // 15: df.plugin.Action.set_velocity:type_name -> df.plugin.SetVelocityAction
-	16,  // 16: df.plugin.Action.add_effect:type_name -> df.plugin.AddEffectAction
-	17,  // 17: df.plugin.Action.remove_effect:type_name -> df.plugin.RemoveEffectAction
-	18,  // 18: df.plugin.Action.send_title:type_name -> df.plugin.SendTitleAction
-	19,  // 19: df.plugin.Action.send_popup:type_name -> df.plugin.SendPopupAction
-	20,  // 20: df.plugin.Action.send_tip:type_name -> df.plugin.SendTipAction
-	78,  // 21: df.plugin.Action.player_send_toast:type_name -> df.plugin.PlayerSendToastAction
-	79,  // 22: df.plugin.Action.player_send_jukebox_popup:type_name -> df.plugin.PlayerSendJukeboxPopupAction
-	80,  // 23: df.plugin.Action.player_show_coordinates:type_name -> df.plugin.PlayerShowCoordinatesAction
-	81,  // 24: df.plugin.Action.player_hide_coordinates:type_name -> df.plugin.PlayerHideCoordinatesAction
-	82,  // 25: df.plugin.Action.player_enable_instant_respawn:type_name -> df.plugin.PlayerEnableInstantRespawnAction
-	83,  // 26: df.plugin.Action.player_disable_instant_respawn:type_name -> df.plugin.PlayerDisableInstantRespawnAction
-	84,  // 27: df.plugin.Action.player_set_name_tag:type_name -> df.plugin.PlayerSetNameTagAction
-	85,  // 28: df.plugin.Action.player_set_score_tag:type_name -> df.plugin.PlayerSetScoreTagAction
-	21,  // 29: df.plugin.Action.play_sound:type_name -> df.plugin.PlaySoundAction
-	86,  // 30: df.plugin.Action.player_show_particle:type_name -> df.plugin.PlayerShowParticleAction
-	93,  // 31: df.plugin.Action.player_send_scoreboard:type_name -> df.plugin.PlayerSendScoreboardAction
-	94,  // 32: df.plugin.Action.player_remove_scoreboard:type_name -> df.plugin.PlayerRemoveScoreboardAction
-	95,  // 33: df.plugin.Action.player_send_menu_form:type_name -> df.plugin.PlayerSendMenuFormAction
-	96,  // 34: df.plugin.Action.player_send_modal_form:type_name -> df.plugin.PlayerSendModalFormAction
-	97,  // 35: df.plugin.Action.player_send_dialogue:type_name -> df.plugin.PlayerSendDialogueAction
-	102, // 36: df.plugin.Action.player_close_dialogue:type_name -> df.plugin.PlayerCloseDialogueAction
-	103, // 37: df.plugin.Action.player_close_form:type_name -> df.plugin.PlayerCloseFormAction
-	22,  // 38: df.plugin.Action.execute_command:type_name -> df.plugin.ExecuteCommandAction
-	54,  // 39: df.plugin.Action.player_start_sprinting:type_name -> df.plugin.PlayerStartSprintingAction
-	55,  // 40: df.plugin.Action.player_stop_sprinting:type_name -> df.plugin.PlayerStopSprintingAction
-	56,  // 41: df.plugin.Action.player_start_sneaking:type_name -> df.plugin.PlayerStartSneakingAction
-	57,  // 42: df.plugin.Action.player_stop_sneaking:type_name -> df.plugin.PlayerStopSneakingAction
-	58,  // 43: df.plugin.Action.player_start_swimming:type_name -> df.plugin.PlayerStartSwimmingAction
-	59,  // 44: df.plugin.Action.player_stop_swimming:type_name -> df.plugin.PlayerStopSwimmingAction
-	60,  // 45: df.plugin.Action.player_start_crawling:type_name -> df.plugin.PlayerStartCrawlingAction
-	61,  // 46: df.plugin.Action.player_stop_crawling:type_name -> df.plugin.PlayerStopCrawlingAction
-	62,  // 47: df.plugin.Action.player_start_gliding:type_name -> df.plugin.PlayerStartGlidingAction
-	63,  // 48: df.plugin.Action.player_stop_gliding:type_name -> df.plugin.PlayerStopGlidingAction
-	64,  // 49: df.plugin.Action.player_start_flying:type_name -> df.plugin.PlayerStartFlyingAction
-	65,  // 50: df.plugin.Action.player_stop_flying:type_name -> df.plugin.PlayerStopFlyingAction
-	66,  // 51: df.plugin.Action.player_set_immobile:type_name -> df.plugin.PlayerSetImmobileAction
-	67,  // 52: df.plugin.Action.player_set_mobile:type_name -> df.plugin.PlayerSetMobileAction
-	68,  // 53: df.plugin.Action.player_set_speed:type_name -> df.plugin.PlayerSetSpeedAction
-	69,  // 54: df.plugin.Action.player_set_flight_speed:type_name -> df.plugin.PlayerSetFlightSpeedAction
-	70,  // 55: df.plugin.Action.player_set_vertical_flight_speed:type_name -> df.plugin.PlayerSetVerticalFlightSpeedAction
-	71,  // 56: df.plugin.Action.player_set_absorption:type_name -> df.plugin.PlayerSetAbsorptionAction
-	72,  // 57: df.plugin.Action.player_set_on_fire:type_name -> df.plugin.PlayerSetOnFireAction
-	73,  // 58: df.plugin.Action.player_extinguish:type_name -> df.plugin.PlayerExtinguishAction
-	74,  // 59: df.plugin.Action.player_set_invisible:type_name -> df.plugin.PlayerSetInvisibleAction
-	75,  // 60: df.plugin.Action.player_set_visible:type_name -> df.plugin.PlayerSetVisibleAction
-	76,  // 61: df.plugin.Action.player_set_scale:type_name -> df.plugin.PlayerSetScaleAction
-	77,  // 62: df.plugin.Action.player_set_held_slot:type_name -> df.plugin.PlayerSetHeldSlotAction
-	87,  // 63: df.plugin.Action.player_respawn:type_name -> df.plugin.PlayerRespawnAction
-	88,  // 64: df.plugin.Action.player_transfer:type_name -> df.plugin.PlayerTransferAction
-	89,  // 65: df.plugin.Action.player_knock_back:type_name -> df.plugin.PlayerKnockBackAction
-	90,  // 66: df.plugin.Action.player_swing_arm:type_name -> df.plugin.PlayerSwingArmAction
-	91,  // 67: df.plugin.Action.player_punch_air:type_name -> df.plugin.PlayerPunchAirAction
-	98,  // 68: df.plugin.Action.player_send_boss_bar:type_name -> df.plugin.PlayerSendBossBarAction
-	99,  // 69: df.plugin.Action.player_remove_boss_bar:type_name -> df.plugin.PlayerRemoveBossBarAction
-	100, // 70: df.plugin.Action.player_show_hud_element:type_name -> df.plugin.PlayerShowHudElementAction
-	101, // 71: df.plugin.Action.player_hide_hud_element:type_name -> df.plugin.PlayerHideHudElementAction
-	104, // 72: df.plugin.Action.player_open_sign:type_name -> df.plugin.PlayerOpenSignAction
-	105, // 73: df.plugin.Action.player_edit_sign:type_name -> df.plugin.PlayerEditSignAction
-	106, // 74: df.plugin.Action.player_turn_lectern_page:type_name -> df.plugin.PlayerTurnLecternPageAction
-	107, // 75: df.plugin.Action.player_hide_player:type_name -> df.plugin.PlayerHidePlayerAction
-	108, // 76: df.plugin.Action.player_show_player:type_name -> df.plugin.PlayerShowPlayerAction
-	109, // 77: df.plugin.Action.player_remove_all_debug_shapes:type_name -> df.plugin.PlayerRemoveAllDebugShapesAction
-	23,  // 78: df.plugin.Action.world_set_default_game_mode:type_name -> df.plugin.WorldSetDefaultGameModeAction
-	24,  // 79: df.plugin.Action.world_set_difficulty:type_name -> df.plugin.WorldSetDifficultyAction
-	25,  // 80: df.plugin.Action.world_set_tick_range:type_name -> df.plugin.WorldSetTickRangeAction
-	26,  // 81: df.plugin.Action.world_set_block:type_name -> df.plugin.WorldSetBlockAction
-	27,  // 82: df.plugin.Action.world_play_sound:type_name -> df.plugin.WorldPlaySoundAction
-	28,  // 83: df.plugin.Action.world_add_particle:type_name -> df.plugin.WorldAddParticleAction
-	29,  // 84: df.plugin.Action.world_set_time:type_name -> df.plugin.WorldSetTimeAction
-	30,  // 85: df.plugin.Action.world_stop_time:type_name -> df.plugin.WorldStopTimeAction
-	31,  // 86: df.plugin.Action.world_start_time:type_name -> df.plugin.WorldStartTimeAction
-	32,  // 87: df.plugin.Action.world_set_spawn:type_name -> df.plugin.WorldSetSpawnAction
-	48,  // 88: df.plugin.Action.world_set_biome:type_name -> df.plugin.WorldSetBiomeAction
-	49,  // 89: df.plugin.Action.world_set_liquid:type_name -> df.plugin.WorldSetLiquidAction
-	50,  // 90: df.plugin.Action.world_schedule_block_update:type_name -> df.plugin.WorldScheduleBlockUpdateAction
-	53,  // 91: df.plugin.Action.world_build_structure:type_name -> df.plugin.WorldBuildStructureAction
-	35,  // 92: df.plugin.Action.world_query_entities:type_name -> df.plugin.WorldQueryEntitiesAction
-	36,  // 93: df.plugin.Action.world_query_players:type_name -> df.plugin.WorldQueryPlayersAction
-	37,  // 94: df.plugin.Action.world_query_entities_within:type_name -> df.plugin.WorldQueryEntitiesWithinAction
-	34,  // 95: df.plugin.Action.world_query_player_spawn:type_name -> df.plugin.WorldQueryPlayerSpawnAction
-	38,  // 96: df.plugin.Action.world_query_block:type_name -> df.plugin.WorldQueryBlockAction
-	39,  // 97: df.plugin.Action.world_query_biome:type_name -> df.plugin.WorldQueryBiomeAction
-	40,  // 98: df.plugin.Action.world_query_light:type_name -> df.plugin.WorldQueryLightAction
-	41,  // 99: df.plugin.Action.world_query_sky_light:type_name -> df.plugin.WorldQuerySkyLightAction
-	42,  // 100: df.plugin.Action.world_query_temperature:type_name -> df.plugin.WorldQueryTemperatureAction
-	43,  // 101: df.plugin.Action.world_query_highest_block:type_name -> df.plugin.WorldQueryHighestBlockAction
-	44,  // 102: df.plugin.Action.world_query_raining_at:type_name -> df.plugin.WorldQueryRainingAtAction
-	45,  // 103: df.plugin.Action.world_query_snowing_at:type_name -> df.plugin.WorldQuerySnowingAtAction
-	46,  // 104: df.plugin.Action.world_query_thundering_at:type_name -> df.plugin.WorldQueryThunderingAtAction
-	47,  // 105: df.plugin.Action.world_query_liquid:type_name -> df.plugin.WorldQueryLiquidAction
-	33,  // 106: df.plugin.Action.world_query_default_game_mode:type_name -> df.plugin.WorldQueryDefaultGameModeAction
-	113, // 107: df.plugin.TeleportAction.position:type_name -> df.plugin.Vec3
-	113, // 108: df.plugin.TeleportAction.rotation:type_name -> df.plugin.Vec3
-	114, // 109: df.plugin.SetGameModeAction.game_mode:type_name -> df.plugin.GameMode
-	115, // 110: df.plugin.GiveItemAction.item:type_name -> df.plugin.ItemStack
-	115, // 111: df.plugin.SetHeldItemAction.main:type_name -> df.plugin.ItemStack
-	115, // 112: df.plugin.SetHeldItemAction.offhand:type_name -> df.plugin.ItemStack
-	113, // 113: df.plugin.SetVelocityAction.velocity:type_name -> df.plugin.Vec3
-	116, // 114: df.plugin.AddEffectAction.effect_type:type_name -> df.plugin.EffectType
-	116, // 115: df.plugin.RemoveEffectAction.effect_type:type_name -> df.plugin.EffectType
-	117, // 116: df.plugin.PlaySoundAction.sound:type_name -> df.plugin.Sound
-	113, // 117: df.plugin.PlaySoundAction.position:type_name -> df.plugin.Vec3
-	118, // 118: df.plugin.WorldSetDefaultGameModeAction.world:type_name -> df.plugin.WorldRef
-	114, // 119: df.plugin.WorldSetDefaultGameModeAction.game_mode:type_name -> df.plugin.GameMode
-	118, // 120: df.plugin.WorldSetDifficultyAction.world:type_name -> df.plugin.WorldRef
-	119, // 121: df.plugin.WorldSetDifficultyAction.difficulty:type_name -> df.plugin.Difficulty
-	118, // 122: df.plugin.WorldSetTickRangeAction.world:type_name -> df.plugin.WorldRef
-	118, // 123: df.plugin.WorldSetBlockAction.world:type_name -> df.plugin.WorldRef
-	120, // 124: df.plugin.WorldSetBlockAction.position:type_name -> df.plugin.BlockPos
-	121, // 125: df.plugin.WorldSetBlockAction.block:type_name -> df.plugin.BlockState
-	118, // 126: df.plugin.WorldPlaySoundAction.world:type_name -> df.plugin.WorldRef
-	117, // 127: df.plugin.WorldPlaySoundAction.sound:type_name -> df.plugin.Sound
-	113, // 128: df.plugin.WorldPlaySoundAction.position:type_name -> df.plugin.Vec3
-	118, // 129: df.plugin.WorldAddParticleAction.world:type_name -> df.plugin.WorldRef
-	113, // 130: df.plugin.WorldAddParticleAction.position:type_name -> df.plugin.Vec3
-	0,   // 131: df.plugin.WorldAddParticleAction.particle:type_name -> df.plugin.ParticleType
-	121, // 132: df.plugin.WorldAddParticleAction.block:type_name -> df.plugin.BlockState
-	118, // 133: df.plugin.WorldSetTimeAction.world:type_name -> df.plugin.WorldRef
-	118, // 134: df.plugin.WorldStopTimeAction.world:type_name -> df.plugin.WorldRef
-	118, // 135: df.plugin.WorldStartTimeAction.world:type_name -> df.plugin.WorldRef
-	118, // 136: df.plugin.WorldSetSpawnAction.world:type_name -> df.plugin.WorldRef
-	120, // 137: df.plugin.WorldSetSpawnAction.spawn:type_name -> df.plugin.BlockPos
-	118, // 138: df.plugin.WorldQueryDefaultGameModeAction.world:type_name -> df.plugin.WorldRef
-	118, // 139: df.plugin.WorldQueryPlayerSpawnAction.world:type_name -> df.plugin.WorldRef
-	118, // 140: df.plugin.WorldQueryEntitiesAction.world:type_name -> df.plugin.WorldRef
-	118, // 141: df.plugin.WorldQueryPlayersAction.world:type_name -> df.plugin.WorldRef
-	118, // 142: df.plugin.WorldQueryEntitiesWithinAction.world:type_name -> df.plugin.WorldRef
-	122, // 143: df.plugin.WorldQueryEntitiesWithinAction.box:type_name -> df.plugin.BBox
-	118, // 144: df.plugin.WorldQueryBlockAction.world:type_name -> df.plugin.WorldRef
-	120, // 145: df.plugin.WorldQueryBlockAction.position:type_name -> df.plugin.BlockPos
-	118, // 146: df.plugin.WorldQueryBiomeAction.world:type_name -> df.plugin.WorldRef
-	120, // 147: df.plugin.WorldQueryBiomeAction.position:type_name -> df.plugin.BlockPos
-	118, // 148: df.plugin.WorldQueryLightAction.world:type_name -> df.plugin.WorldRef
-	120, // 149: df.plugin.WorldQueryLightAction.position:type_name -> df.plugin.BlockPos
-	118, // 150: df.plugin.WorldQuerySkyLightAction.world:type_name -> df.plugin.WorldRef
-	120, // 151: df.plugin.WorldQuerySkyLightAction.position:type_name -> df.plugin.BlockPos
-	118, // 152: df.plugin.WorldQueryTemperatureAction.world:type_name -> df.plugin.WorldRef
-	120, // 153: df.plugin.WorldQueryTemperatureAction.position:type_name -> df.plugin.BlockPos
-	118, // 154: df.plugin.WorldQueryHighestBlockAction.world:type_name -> df.plugin.WorldRef
-	118, // 155: df.plugin.WorldQueryRainingAtAction.world:type_name -> df.plugin.WorldRef
-	120, // 156: df.plugin.WorldQueryRainingAtAction.position:type_name -> df.plugin.BlockPos
-	118, // 157: df.plugin.WorldQuerySnowingAtAction.world:type_name -> df.plugin.WorldRef
-	120, // 158: df.plugin.WorldQuerySnowingAtAction.position:type_name -> df.plugin.BlockPos
-	118, // 159: df.plugin.WorldQueryThunderingAtAction.world:type_name -> df.plugin.WorldRef
-	120, // 160: df.plugin.WorldQueryThunderingAtAction.position:type_name -> df.plugin.BlockPos
-	118, // 161: df.plugin.WorldQueryLiquidAction.world:type_name -> df.plugin.WorldRef
-	120, // 162: df.plugin.WorldQueryLiquidAction.position:type_name -> df.plugin.BlockPos
-	118, // 163: df.plugin.WorldSetBiomeAction.world:type_name -> df.plugin.WorldRef
-	120, // 164: df.plugin.WorldSetBiomeAction.position:type_name -> df.plugin.BlockPos
-	118, // 165: df.plugin.WorldSetLiquidAction.world:type_name -> df.plugin.WorldRef
-	120, // 166: df.plugin.WorldSetLiquidAction.position:type_name -> df.plugin.BlockPos
-	123, // 167: df.plugin.WorldSetLiquidAction.liquid:type_name -> df.plugin.LiquidState
-	118, // 168: df.plugin.WorldScheduleBlockUpdateAction.world:type_name -> df.plugin.WorldRef
-	120, // 169: df.plugin.WorldScheduleBlockUpdateAction.position:type_name -> df.plugin.BlockPos
-	121, // 170: df.plugin.WorldScheduleBlockUpdateAction.block:type_name -> df.plugin.BlockState
-	121, // 171: df.plugin.StructureVoxel.block:type_name -> df.plugin.BlockState
-	123, // 172: df.plugin.StructureVoxel.liquid:type_name -> df.plugin.LiquidState
-	51,  // 173: df.plugin.StructureDef.voxels:type_name -> df.plugin.StructureVoxel
-	118, // 174: df.plugin.WorldBuildStructureAction.world:type_name -> df.plugin.WorldRef
-	120, // 175: df.plugin.WorldBuildStructureAction.origin:type_name -> df.plugin.BlockPos
-	52,  // 176: df.plugin.WorldBuildStructureAction.structure:type_name -> df.plugin.StructureDef
-	113, // 177: df.plugin.PlayerShowParticleAction.position:type_name -> df.plugin.Vec3
-	0,   // 178: df.plugin.PlayerShowParticleAction.particle:type_name -> df.plugin.ParticleType
-	121, // 179: df.plugin.PlayerShowParticleAction.block:type_name -> df.plugin.BlockState
-	124, // 180: df.plugin.PlayerTransferAction.address:type_name -> df.plugin.Address
-	113, // 181: df.plugin.PlayerKnockBackAction.source:type_name -> df.plugin.Vec3
-	115, // 182: df.plugin.PlayerSetArmourAction.helmet:type_name -> df.plugin.ItemStack
-	115, // 183: df.plugin.PlayerSetArmourAction.chestplate:type_name -> df.plugin.ItemStack
-	115, // 184: df.plugin.PlayerSetArmourAction.leggings:type_name -> df.plugin.ItemStack
-	115, // 185: df.plugin.PlayerSetArmourAction.boots:type_name -> df.plugin.ItemStack
-	125, // 186: df.plugin.PlayerSendDialogueAction.entity:type_name -> df.plugin.EntityRef
-	1,   // 187: df.plugin.PlayerSendBossBarAction.colour:type_name -> df.plugin.BossBarColour
-	2,   // 188: df.plugin.PlayerShowHudElementAction.element:type_name -> df.plugin.HudElement
-	2,   // 189: df.plugin.PlayerHideHudElementAction.element:type_name -> df.plugin.HudElement
-	120, // 190: df.plugin.PlayerOpenSignAction.position:type_name -> df.plugin.BlockPos
-	120, // 191: df.plugin.PlayerEditSignAction.position:type_name -> df.plugin.BlockPos
-	120, // 192: df.plugin.PlayerTurnLecternPageAction.position:type_name -> df.plugin.BlockPos
-	120, // 193: df.plugin.PlayerOpenBlockContainerAction.position:type_name -> df.plugin.BlockPos
-	115, // 194: df.plugin.PlayerDropItemAction.item:type_name -> df.plugin.ItemStack
-	115, // 195: df.plugin.PlayerSetItemCooldownAction.item:type_name -> df.plugin.ItemStack
-	196, // [196:196] is the sub-list for method output_type
-	196, // [196:196] is the sub-list for method input_type
-	196, // [196:196] is the sub-list for extension type_name
-	196, // [196:196] is the sub-list for extension extendee
-	0,   // [0:196] is the sub-list for field type_name
+	5,   // 0: df.plugin.ActionBatch.actions:type_name -> df.plugin.Action
+	6,   // 1: df.plugin.Action.send_chat:type_name -> df.plugin.SendChatAction
+	7,   // 2: df.plugin.Action.teleport:type_name -> df.plugin.TeleportAction
+	8,   // 3: df.plugin.Action.kick:type_name -> df.plugin.KickAction
+	9,   // 4: df.plugin.Action.set_game_mode:type_name -> df.plugin.SetGameModeAction
+	10,  // 5: df.plugin.Action.give_item:type_name -> df.plugin.GiveItemAction
+	11,  // 6: df.plugin.Action.clear_inventory:type_name -> df.plugin.ClearInventoryAction
+	12,  // 7: df.plugin.Action.set_held_item:type_name -> df.plugin.SetHeldItemAction
+	93,  // 8: df.plugin.Action.player_set_armour:type_name -> df.plugin.PlayerSetArmourAction
+	120, // 9: df.plugin.Action.player_open_block_container:type_name -> df.plugin.PlayerOpenBlockContainerAction
+	121, // 10: df.plugin.Action.player_drop_item:type_name -> df.plugin.PlayerDropItemAction
+	122, // 11: df.plugin.Action.player_set_item_cooldown:type_name -> df.plugin.PlayerSetItemCooldownAction
+	123, // 12: df.plugin.Action.inventory_query:type_name -> df.plugin.InventoryQueryAction
+	124, // 13: df.plugin.Action.inventory_set_slot:type_name -> df.plugin.InventorySetSlotAction
+	125, // 14: df.plugin.Action.inventory_remove_item:type_name -> df.plugin.InventoryRemoveItemAction
+	126, // 15: df.plugin.Action.inventory_swap_slots:type_name -> df.plugin.InventorySwapSlotsAction
+	127, // 16: df.plugin.Action.inventory_clear_range:type_name -> df.plugin.InventoryClearRangeAction
+	128, // 17: df.plugin.Action.player_open_virtual_inventory:type_name -> df.plugin.PlayerOpenVirtualInventoryAction
+	129, // 18: df.plugin.Action.player_set_virtual_inventory_slots:type_name -> df.plugin.PlayerSetVirtualInventorySlotsAction
+	130, // 19: df.plugin.Action.player_close_virtual_inventory:type_name -> df.plugin.PlayerCloseVirtualInventoryAction
+	13,  // 20: df.plugin.Action.set_health:type_name -> df.plugin.SetHealthAction
+	14,  // 21: df.plugin.Action.set_food:type_name -> df.plugin.SetFoodAction
+	15,  // 22: df.plugin.Action.set_experience:type_name -> df.plugin.SetExperienceAction
+	16,  // 23: df.plugin.Action.set_velocity:type_name -> df.plugin.SetVelocityAction
+	17,  // 24: df.plugin.Action.add_effect:type_name -> df.plugin.AddEffectAction
+	18,  // 25: df.plugin.Action.remove_effect:type_name -> df.plugin.RemoveEffectAction
+	19,  // 26: df.plugin.Action.send_title:type_name -> df.plugin.SendTitleAction
+	20,  // 27: df.plugin.Action.send_popup:type_name -> df.plugin.SendPopupAction
+	21,  // 28: df.plugin.Action.send_tip:type_name -> df.plugin.SendTipAction
+	79,  // 29: df.plugin.Action.player_send_toast:type_name -> df.plugin.PlayerSendToastAction
+	80,  // 30: df.plugin.Action.player_send_jukebox_popup:type_name -> df.plugin.PlayerSendJukeboxPopupAction
+	81,  // 31: df.plugin.Action.player_show_coordinates:type_name -> df.plugin.PlayerShowCoordinatesAction
+	82,  // 32: df.plugin.Action.player_hide_coordinates:type_name -> df.plugin.PlayerHideCoordinatesAction
+	83,  // 33: df.plugin.Action.player_enable_instant_respawn:type_name -> df.plugin.PlayerEnableInstantRespawnAction
+	84,  // 34: df.plugin.Action.player_disable_instant_respawn:type_name -> df.plugin.PlayerDisableInstantRespawnAction
+	85,  // 35: df.plugin.Action.player_set_name_tag:type_name -> df.plugin.PlayerSetNameTagAction
+	86,  // 36: df.plugin.Action.player_set_score_tag:type_name -> df.plugin.PlayerSetScoreTagAction
+	22,  // 37: df.plugin.Action.play_sound:type_name -> df.plugin.PlaySoundAction
+	87,  // 38: df.plugin.Action.player_show_particle:type_name -> df.plugin.PlayerShowParticleAction
+	94,  // 39: df.plugin.Action.player_send_scoreboard:type_name -> df.plugin.PlayerSendScoreboardAction
+	95,  // 40: df.plugin.Action.player_remove_scoreboard:type_name -> df.plugin.PlayerRemoveScoreboardAction
+	96,  // 41: df.plugin.Action.player_send_menu_form:type_name -> df.plugin.PlayerSendMenuFormAction
+	98,  // 42: df.plugin.Action.player_send_modal_form:type_name -> df.plugin.PlayerSendModalFormAction
+	107, // 43: df.plugin.Action.player_send_dialogue:type_name -> df.plugin.PlayerSendDialogueAction
+	99,  // 44: df.plugin.Action.player_send_custom_form:type_name -> df.plugin.PlayerSendCustomFormAction
+	112, // 45: df.plugin.Action.player_close_dialogue:type_name -> df.plugin.PlayerCloseDialogueAction
+	113, // 46: df.plugin.Action.player_close_form:type_name -> df.plugin.PlayerCloseFormAction
+	23,  // 47: df.plugin.Action.execute_command:type_name -> df.plugin.ExecuteCommandAction
+	55,  // 48: df.plugin.Action.player_start_sprinting:type_name -> df.plugin.PlayerStartSprintingAction
+	56,  // 49: df.plugin.Action.player_stop_sprinting:type_name -> df.plugin.PlayerStopSprintingAction
+	57,  // 50: df.plugin.Action.player_start_sneaking:type_name -> df.plugin.PlayerStartSneakingAction
+	58,  // 51: df.plugin.Action.player_stop_sneaking:type_name -> df.plugin.PlayerStopSneakingAction
+	59,  // 52: df.plugin.Action.player_start_swimming:type_name -> df.plugin.PlayerStartSwimmingAction
+	60,  // 53: df.plugin.Action.player_stop_swimming:type_name -> df.plugin.PlayerStopSwimmingAction
+	61,  // 54: df.plugin.Action.player_start_crawling:type_name -> df.plugin.PlayerStartCrawlingAction
+	62,  // 55: df.plugin.Action.player_stop_crawling:type_name -> df.plugin.PlayerStopCrawlingAction
+	63,  // 56: df.plugin.Action.player_start_gliding:type_name -> df.plugin.PlayerStartGlidingAction
+	64,  // 57: df.plugin.Action.player_stop_gliding:type_name -> df.plugin.PlayerStopGlidingAction
+	65,  // 58: df.plugin.Action.player_start_flying:type_name -> df.plugin.PlayerStartFlyingAction
+	66,  // 59: df.plugin.Action.player_stop_flying:type_name -> df.plugin.PlayerStopFlyingAction
+	67,  // 60: df.plugin.Action.player_set_immobile:type_name -> df.plugin.PlayerSetImmobileAction
+	68,  // 61: df.plugin.Action.player_set_mobile:type_name -> df.plugin.PlayerSetMobileAction
+	69,  // 62: df.plugin.Action.player_set_speed:type_name -> df.plugin.PlayerSetSpeedAction
+	70,  // 63: df.plugin.Action.player_set_flight_speed:type_name -> df.plugin.PlayerSetFlightSpeedAction
+	71,  // 64: df.plugin.Action.player_set_vertical_flight_speed:type_name -> df.plugin.PlayerSetVerticalFlightSpeedAction
+	72,  // 65: df.plugin.Action.player_set_absorption:type_name -> df.plugin.PlayerSetAbsorptionAction
+	73,  // 66: df.plugin.Action.player_set_on_fire:type_name -> df.plugin.PlayerSetOnFireAction
+	74,  // 67: df.plugin.Action.player_extinguish:type_name -> df.plugin.PlayerExtinguishAction
+	75,  // 68: df.plugin.Action.player_set_invisible:type_name -> df.plugin.PlayerSetInvisibleAction
+	76,  // 69: df.plugin.Action.player_set_visible:type_name -> df.plugin.PlayerSetVisibleAction
+	77,  // 70: df.plugin.Action.player_set_scale:type_name -> df.plugin.PlayerSetScaleAction
+	78,  // 71: df.plugin.Action.player_set_held_slot:type_name -> df.plugin.PlayerSetHeldSlotAction
+	88,  // 72: df.plugin.Action.player_respawn:type_name -> df.plugin.PlayerRespawnAction
+	89,  // 73: df.plugin.Action.player_transfer:type_name -> df.plugin.PlayerTransferAction
+	90,  // 74: df.plugin.Action.player_knock_back:type_name -> df.plugin.PlayerKnockBackAction
+	91,  // 75: df.plugin.Action.player_swing_arm:type_name -> df.plugin.PlayerSwingArmAction
+	92,  // 76: df.plugin.Action.player_punch_air:type_name -> df.plugin.PlayerPunchAirAction
+	108, // 77: df.plugin.Action.player_send_boss_bar:type_name -> df.plugin.PlayerSendBossBarAction
+	109, // 78: df.plugin.Action.player_remove_boss_bar:type_name -> df.plugin.PlayerRemoveBossBarAction
+	110, // 79: df.plugin.Action.player_show_hud_element:type_name -> df.plugin.PlayerShowHudElementAction
+	111, // 80: df.plugin.Action.player_hide_hud_element:type_name -> df.plugin.PlayerHideHudElementAction
+	114, // 81: df.plugin.Action.player_open_sign:type_name -> df.plugin.PlayerOpenSignAction
+	115, // 82: df.plugin.Action.player_edit_sign:type_name -> df.plugin.PlayerEditSignAction
+	116, // 83: df.plugin.Action.player_turn_lectern_page:type_name -> df.plugin.PlayerTurnLecternPageAction
+	117, // 84: df.plugin.Action.player_hide_player:type_name -> df.plugin.PlayerHidePlayerAction
+	118, // 85: df.plugin.Action.player_show_player:type_name -> df.plugin.PlayerShowPlayerAction
+	119, // 86: df.plugin.Action.player_remove_all_debug_shapes:type_name -> df.plugin.PlayerRemoveAllDebugShapesAction
+	24,  // 87: df.plugin.Action.world_set_default_game_mode:type_name -> df.plugin.WorldSetDefaultGameModeAction
+	25,  // 88: df.plugin.Action.world_set_difficulty:type_name -> df.plugin.WorldSetDifficultyAction
+	26,  // 89: df.plugin.Action.world_set_tick_range:type_name -> df.plugin.WorldSetTickRangeAction
+	27,  // 90: df.plugin.Action.world_set_block:type_name -> df.plugin.WorldSetBlockAction
+	28,  // 91: df.plugin.Action.world_play_sound:type_name -> df.plugin.WorldPlaySoundAction
+	29,  // 92: df.plugin.Action.world_add_particle:type_name -> df.plugin.WorldAddParticleAction
+	30,  // 93: df.plugin.Action.world_set_time:type_name -> df.plugin.WorldSetTimeAction
+	31,  // 94: df.plugin.Action.world_stop_time:type_name -> df.plugin.WorldStopTimeAction
+	32,  // 95: df.plugin.Action.world_start_time:type_name -> df.plugin.WorldStartTimeAction
+	33,  // 96: df.plugin.Action.world_set_spawn:type_name -> df.plugin.WorldSetSpawnAction
+	49,  // 97: df.plugin.Action.world_set_biome:type_name -> df.plugin.WorldSetBiomeAction
+	50,  // 98: df.plugin.Action.world_set_liquid:type_name -> df.plugin.WorldSetLiquidAction
+	51,  // 99: df.plugin.Action.world_schedule_block_update:type_name -> df.plugin.WorldScheduleBlockUpdateAction
+	54,  // 100: df.plugin.Action.world_build_structure:type_name -> df.plugin.WorldBuildStructureAction
+	131, // 101: df.plugin.Action.world_spawn_entity:type_name -> df.plugin.WorldSpawnEntityAction
+	139, // 102: df.plugin.Action.entity_remove:type_name -> df.plugin.EntityRemoveAction
+	140, // 103: df.plugin.Action.entity_teleport:type_name -> df.plugin.EntityTeleportAction
+	141, // 104: df.plugin.Action.entity_set_velocity:type_name -> df.plugin.EntitySetVelocityAction
+	142, // 105: df.plugin.Action.entity_set_name_tag:type_name -> df.plugin.EntitySetNameTagAction
+	36,  // 106: df.plugin.Action.world_query_entities:type_name -> df.plugin.WorldQueryEntitiesAction
+	37,  // 107: df.plugin.Action.world_query_players:type_name -> df.plugin.WorldQueryPlayersAction
+	38,  // 108: df.plugin.Action.world_query_entities_within:type_name -> df.plugin.WorldQueryEntitiesWithinAction
+	35,  // 109: df.plugin.Action.world_query_player_spawn:type_name -> df.plugin.WorldQueryPlayerSpawnAction
+	39,  // 110: df.plugin.Action.world_query_block:type_name -> df.plugin.WorldQueryBlockAction
+	40,  // 111: df.plugin.Action.world_query_biome:type_name -> df.plugin.WorldQueryBiomeAction
+	41,  // 112: df.plugin.Action.world_query_light:type_name -> df.plugin.WorldQueryLightAction
+	42,  // 113: df.plugin.Action.world_query_sky_light:type_name -> df.plugin.WorldQuerySkyLightAction
+	43,  // 114: df.plugin.Action.world_query_temperature:type_name -> df.plugin.WorldQueryTemperatureAction
+	44,  // 115: df.plugin.Action.world_query_highest_block:type_name -> df.plugin.WorldQueryHighestBlockAction
+	45,  // 116: df.plugin.Action.world_query_raining_at:type_name -> df.plugin.WorldQueryRainingAtAction
+	46,  // 117: df.plugin.Action.world_query_snowing_at:type_name -> df.plugin.WorldQuerySnowingAtAction
+	47,  // 118: df.plugin.Action.world_query_thundering_at:type_name -> df.plugin.WorldQueryThunderingAtAction
+	48,  // 119: df.plugin.Action.world_query_liquid:type_name -> df.plugin.WorldQueryLiquidAction
+	34,  // 120: df.plugin.Action.world_query_default_game_mode:type_name -> df.plugin.WorldQueryDefaultGameModeAction
+	143, // 121: df.plugin.TeleportAction.position:type_name -> df.plugin.Vec3
+	143, // 122: df.plugin.TeleportAction.rotation:type_name -> df.plugin.Vec3
+	144, // 123: df.plugin.SetGameModeAction.game_mode:type_name -> df.plugin.GameMode
+	145, // 124: df.plugin.GiveItemAction.item:type_name -> df.plugin.ItemStack
+	145, // 125: df.plugin.SetHeldItemAction.main:type_name -> df.plugin.ItemStack
+	145, // 126: df.plugin.SetHeldItemAction.offhand:type_name -> df.plugin.ItemStack
+	143, // 127: df.plugin.SetVelocityAction.velocity:type_name -> df.plugin.Vec3
+	146, // 128: df.plugin.AddEffectAction.effect_type:type_name -> df.plugin.EffectType
+	146, // 129: df.plugin.RemoveEffectAction.effect_type:type_name -> df.plugin.EffectType
+	147, // 130: df.plugin.PlaySoundAction.sound:type_name -> df.plugin.Sound
+	143, // 131: df.plugin.PlaySoundAction.position:type_name -> df.plugin.Vec3
+	148, // 132: df.plugin.WorldSetDefaultGameModeAction.world:type_name -> df.plugin.WorldRef
+	144, // 133: df.plugin.WorldSetDefaultGameModeAction.game_mode:type_name -> df.plugin.GameMode
+	148, // 134: df.plugin.WorldSetDifficultyAction.world:type_name -> df.plugin.WorldRef
+	149, // 135: df.plugin.WorldSetDifficultyAction.difficulty:type_name -> df.plugin.Difficulty
+	148, // 136: df.plugin.WorldSetTickRangeAction.world:type_name -> df.plugin.WorldRef
+	148, // 137: df.plugin.WorldSetBlockAction.world:type_name -> df.plugin.WorldRef
+	150, // 138: df.plugin.WorldSetBlockAction.position:type_name -> df.plugin.BlockPos
+	151, // 139: df.plugin.WorldSetBlockAction.block:type_name -> df.plugin.BlockState
+	148, // 140: df.plugin.WorldPlaySoundAction.world:type_name -> df.plugin.WorldRef
+	147, // 141: df.plugin.WorldPlaySoundAction.sound:type_name -> df.plugin.Sound
+	143, // 142: df.plugin.WorldPlaySoundAction.position:type_name -> df.plugin.Vec3
+	148, // 143: df.plugin.WorldAddParticleAction.world:type_name -> df.plugin.WorldRef
+	143, // 144: df.plugin.WorldAddParticleAction.position:type_name -> df.plugin.Vec3
+	0,   // 145: df.plugin.WorldAddParticleAction.particle:type_name -> df.plugin.ParticleType
+	151, // 146: df.plugin.WorldAddParticleAction.block:type_name -> df.plugin.BlockState
+	148, // 147: df.plugin.WorldSetTimeAction.world:type_name -> df.plugin.WorldRef
+	148, // 148: df.plugin.WorldStopTimeAction.world:type_name -> df.plugin.WorldRef
+	148, // 149: df.plugin.WorldStartTimeAction.world:type_name -> df.plugin.WorldRef
+	148, // 150: df.plugin.WorldSetSpawnAction.world:type_name -> df.plugin.WorldRef
+	150, // 151: df.plugin.WorldSetSpawnAction.spawn:type_name -> df.plugin.BlockPos
+	148, // 152: df.plugin.WorldQueryDefaultGameModeAction.world:type_name -> df.plugin.WorldRef
+	148, // 153: df.plugin.WorldQueryPlayerSpawnAction.world:type_name -> df.plugin.WorldRef
+	148, // 154: df.plugin.WorldQueryEntitiesAction.world:type_name -> df.plugin.WorldRef
+	148, // 155: df.plugin.WorldQueryPlayersAction.world:type_name -> df.plugin.WorldRef
+	148, // 156: df.plugin.WorldQueryEntitiesWithinAction.world:type_name -> df.plugin.WorldRef
+	152, // 157: df.plugin.WorldQueryEntitiesWithinAction.box:type_name -> df.plugin.BBox
+	148, // 158: df.plugin.WorldQueryBlockAction.world:type_name -> df.plugin.WorldRef
+	150, // 159: df.plugin.WorldQueryBlockAction.position:type_name -> df.plugin.BlockPos
+	148, // 160: df.plugin.WorldQueryBiomeAction.world:type_name -> df.plugin.WorldRef
+	150, // 161: df.plugin.WorldQueryBiomeAction.position:type_name -> df.plugin.BlockPos
+	148, // 162: df.plugin.WorldQueryLightAction.world:type_name -> df.plugin.WorldRef
+	150, // 163: df.plugin.WorldQueryLightAction.position:type_name -> df.plugin.BlockPos
+	148, // 164: df.plugin.WorldQuerySkyLightAction.world:type_name -> df.plugin.WorldRef
+	150, // 165: df.plugin.WorldQuerySkyLightAction.position:type_name -> df.plugin.BlockPos
+	148, // 166: df.plugin.WorldQueryTemperatureAction.world:type_name -> df.plugin.WorldRef
+	150, // 167: df.plugin.WorldQueryTemperatureAction.position:type_name -> df.plugin.BlockPos
+	148, // 168: df.plugin.WorldQueryHighestBlockAction.world:type_name -> df.plugin.WorldRef
+	148, // 169: df.plugin.WorldQueryRainingAtAction.world:type_name -> df.plugin.WorldRef
+	150, // 170: df.plugin.WorldQueryRainingAtAction.position:type_name -> df.plugin.BlockPos
+	148, // 171: df.plugin.WorldQuerySnowingAtAction.world:type_name -> df.plugin.WorldRef
+	150, // 172: df.plugin.WorldQuerySnowingAtAction.position:type_name -> df.plugin.BlockPos
+	148, // 173: df.plugin.WorldQueryThunderingAtAction.world:type_name -> df.plugin.WorldRef
+	150, // 174: df.plugin.WorldQueryThunderingAtAction.position:type_name -> df.plugin.BlockPos
+	148, // 175: df.plugin.WorldQueryLiquidAction.world:type_name -> df.plugin.WorldRef
+	150, // 176: df.plugin.WorldQueryLiquidAction.position:type_name -> df.plugin.BlockPos
+	148, // 177: df.plugin.WorldSetBiomeAction.world:type_name -> df.plugin.WorldRef
+	150, // 178: df.plugin.WorldSetBiomeAction.position:type_name -> df.plugin.BlockPos
+	148, // 179: df.plugin.WorldSetLiquidAction.world:type_name -> df.plugin.WorldRef
+	150, // 180: df.plugin.WorldSetLiquidAction.position:type_name -> df.plugin.BlockPos
+	153, // 181: df.plugin.WorldSetLiquidAction.liquid:type_name -> df.plugin.LiquidState
+	148, // 182: df.plugin.WorldScheduleBlockUpdateAction.world:type_name -> df.plugin.WorldRef
+	150, // 183: df.plugin.WorldScheduleBlockUpdateAction.position:type_name -> df.plugin.BlockPos
+	151, // 184: df.plugin.WorldScheduleBlockUpdateAction.block:type_name -> df.plugin.BlockState
+	151, // 185: df.plugin.StructureVoxel.block:type_name -> df.plugin.BlockState
+	153, // 186: df.plugin.StructureVoxel.liquid:type_name -> df.plugin.LiquidState
+	52,  // 187: df.plugin.StructureDef.voxels:type_name -> df.plugin.StructureVoxel
+	148, // 188: df.plugin.WorldBuildStructureAction.world:type_name -> df.plugin.WorldRef
+	150, // 189: df.plugin.WorldBuildStructureAction.origin:type_name -> df.plugin.BlockPos
+	53,  // 190: df.plugin.WorldBuildStructureAction.structure:type_name -> df.plugin.StructureDef
+	143, // 191: df.plugin.PlayerShowParticleAction.position:type_name -> df.plugin.Vec3
+	0,   // 192: df.plugin.PlayerShowParticleAction.particle:type_name -> df.plugin.ParticleType
+	151, // 193: df.plugin.PlayerShowParticleAction.block:type_name -> df.plugin.BlockState
+	154, // 194: df.plugin.PlayerTransferAction.address:type_name -> df.plugin.Address
+	143, // 195: df.plugin.PlayerKnockBackAction.source:type_name -> df.plugin.Vec3
+	145, // 196: df.plugin.PlayerSetArmourAction.helmet:type_name -> df.plugin.ItemStack
+	145, // 197: df.plugin.PlayerSetArmourAction.chestplate:type_name -> df.plugin.ItemStack
+	145, // 198: df.plugin.PlayerSetArmourAction.leggings:type_name -> df.plugin.ItemStack
+	145, // 199: df.plugin.PlayerSetArmourAction.boots:type_name -> df.plugin.ItemStack
+	97,  // 200: df.plugin.PlayerSendMenuFormAction.form_buttons:type_name -> df.plugin.FormButton
+	100, // 201: df.plugin.PlayerSendCustomFormAction.elements:type_name -> df.plugin.FormElement
+	101, // 202: df.plugin.FormElement.label:type_name -> df.plugin.FormLabel
+	102, // 203: df.plugin.FormElement.input:type_name -> df.plugin.FormInput
+	103, // 204: df.plugin.FormElement.toggle:type_name -> df.plugin.FormToggle
+	104, // 205: df.plugin.FormElement.slider:type_name -> df.plugin.FormSlider
+	105, // 206: df.plugin.FormElement.dropdown:type_name -> df.plugin.FormDropdown
+	106, // 207: df.plugin.FormElement.step_slider:type_name -> df.plugin.FormStepSlider
+	155, // 208: df.plugin.PlayerSendDialogueAction.entity:type_name -> df.plugin.EntityRef
+	1,   // 209: df.plugin.PlayerSendBossBarAction.colour:type_name -> df.plugin.BossBarColour
+	2,   // 210: df.plugin.PlayerShowHudElementAction.element:type_name -> df.plugin.HudElement
+	2,   // 211: df.plugin.PlayerHideHudElementAction.element:type_name -> df.plugin.HudElement
+	150, // 212: df.plugin.PlayerOpenSignAction.position:type_name -> df.plugin.BlockPos
+	150, // 213: df.plugin.PlayerEditSignAction.position:type_name -> df.plugin.BlockPos
+	150, // 214: df.plugin.PlayerTurnLecternPageAction.position:type_name -> df.plugin.BlockPos
+	150, // 215: df.plugin.PlayerOpenBlockContainerAction.position:type_name -> df.plugin.BlockPos
+	145, // 216: df.plugin.PlayerDropItemAction.item:type_name -> df.plugin.ItemStack
+	145, // 217: df.plugin.PlayerSetItemCooldownAction.item:type_name -> df.plugin.ItemStack
+	156, // 218: df.plugin.InventoryQueryAction.target:type_name -> df.plugin.InventoryTarget
+	156, // 219: df.plugin.InventorySetSlotAction.target:type_name -> df.plugin.InventoryTarget
+	145, // 220: df.plugin.InventorySetSlotAction.item:type_name -> df.plugin.ItemStack
+	156, // 221: df.plugin.InventoryRemoveItemAction.target:type_name -> df.plugin.InventoryTarget
+	145, // 222: df.plugin.InventoryRemoveItemAction.item:type_name -> df.plugin.ItemStack
+	156, // 223: df.plugin.InventorySwapSlotsAction.target:type_name -> df.plugin.InventoryTarget
+	156, // 224: df.plugin.InventoryClearRangeAction.target:type_name -> df.plugin.InventoryTarget
+	3,   // 225: df.plugin.PlayerOpenVirtualInventoryAction.type:type_name -> df.plugin.VirtualInventoryType
+	157, // 226: df.plugin.PlayerOpenVirtualInventoryAction.slots:type_name -> df.plugin.InventorySlot
+	157, // 227: df.plugin.PlayerSetVirtualInventorySlotsAction.slots:type_name -> df.plugin.InventorySlot
+	148, // 228: df.plugin.WorldSpawnEntityAction.world:type_name -> df.plugin.WorldRef
+	143, // 229: df.plugin.WorldSpawnEntityAction.position:type_name -> df.plugin.Vec3
+	158, // 230: df.plugin.WorldSpawnEntityAction.rotation:type_name -> df.plugin.Rotation
+	143, // 231: df.plugin.WorldSpawnEntityAction.velocity:type_name -> df.plugin.Vec3
+	132, // 232: df.plugin.WorldSpawnEntityAction.item:type_name -> df.plugin.SpawnItemEntity
+	133, // 233: df.plugin.WorldSpawnEntityAction.falling_block:type_name -> df.plugin.SpawnFallingBlockEntity
+	134, // 234: df.plugin.WorldSpawnEntityAction.tnt:type_name -> df.plugin.SpawnTNTEntity
+	135, // 235: df.plugin.WorldSpawnEntityAction.arrow:type_name -> df.plugin.SpawnArrowEntity
+	136, // 236: df.plugin.WorldSpawnEntityAction.experience_orb:type_name -> df.plugin.SpawnExperienceOrbEntity
+	137, // 237: df.plugin.WorldSpawnEntityAction.lightning:type_name -> df.plugin.SpawnLightningEntity
+	138, // 238: df.plugin.WorldSpawnEntityAction.text:type_name -> df.plugin.SpawnTextEntity
+	145, // 239: df.plugin.SpawnItemEntity.item:type_name -> df.plugin.ItemStack
+	151, // 240: df.plugin.SpawnFallingBlockEntity.block:type_name -> df.plugin.BlockState
+	148, // 241: df.plugin.EntityRemoveAction.world:type_name -> df.plugin.WorldRef
+	148, // 242: df.plugin.EntityTeleportAction.world:type_name -> df.plugin.WorldRef
+	143, // 243: df.plugin.EntityTeleportAction.position:type_name -> df.plugin.Vec3
+	158, // 244: df.plugin.EntityTeleportAction.rotation:type_name -> df.plugin.Rotation
+	148, // 245: df.plugin.EntitySetVelocityAction.world:type_name -> df.plugin.WorldRef
+	143, // 246: df.plugin.EntitySetVelocityAction.velocity:type_name -> df.plugin.Vec3
+	148, // 247: df.plugin.EntitySetNameTagAction.world:type_name -> df.plugin.WorldRef
+	248, // [248:248] is the sub-list for method output_type
+	248, // [248:248] is the sub-list for method input_type
+	248, // [248:248] is the sub-list for extension type_name
+	248, // [248:248] is the sub-list for extension extendee
+	0,   // [0:248] is the sub-list for field type_name
 }
 
 func init() { file_actions_proto_init() }
@@ -8919,6 +11394,7 @@ func file_actions_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_actions_proto_msgTypes[0].OneofWrappers = []any{}
 	file_actions_proto_msgTypes[1].OneofWrappers = []any{
 		(*Action_SendChat)(nil),
 		(*Action_Teleport)(nil),
@@ -8931,6 +11407,14 @@ func file_actions_proto_init() {
 		(*Action_PlayerOpenBlockContainer)(nil),
 		(*Action_PlayerDropItem)(nil),
 		(*Action_PlayerSetItemCooldown)(nil),
+		(*Action_InventoryQuery)(nil),
+		(*Action_InventorySetSlot)(nil),
+		(*Action_InventoryRemoveItem)(nil),
+		(*Action_InventorySwapSlots)(nil),
+		(*Action_InventoryClearRange)(nil),
+		(*Action_PlayerOpenVirtualInventory)(nil),
+		(*Action_PlayerSetVirtualInventorySlots)(nil),
+		(*Action_PlayerCloseVirtualInventory)(nil),
 		(*Action_SetHealth)(nil),
 		(*Action_SetFood)(nil),
 		(*Action_SetExperience)(nil),
@@ -8955,6 +11439,7 @@ func file_actions_proto_init() {
 		(*Action_PlayerSendMenuForm)(nil),
 		(*Action_PlayerSendModalForm)(nil),
 		(*Action_PlayerSendDialogue)(nil),
+		(*Action_PlayerSendCustomForm)(nil),
 		(*Action_PlayerCloseDialogue)(nil),
 		(*Action_PlayerCloseForm)(nil),
 		(*Action_ExecuteCommand)(nil),
@@ -9011,6 +11496,11 @@ func file_actions_proto_init() {
 		(*Action_WorldSetLiquid)(nil),
 		(*Action_WorldScheduleBlockUpdate)(nil),
 		(*Action_WorldBuildStructure)(nil),
+		(*Action_WorldSpawnEntity)(nil),
+		(*Action_EntityRemove)(nil),
+		(*Action_EntityTeleport)(nil),
+		(*Action_EntitySetVelocity)(nil),
+		(*Action_EntitySetNameTag)(nil),
 		(*Action_WorldQueryEntities)(nil),
 		(*Action_WorldQueryPlayers)(nil),
 		(*Action_WorldQueryEntitiesWithin)(nil),
@@ -9040,16 +11530,44 @@ func file_actions_proto_init() {
 	file_actions_proto_msgTypes[89].OneofWrappers = []any{}
 	file_actions_proto_msgTypes[90].OneofWrappers = []any{}
 	file_actions_proto_msgTypes[92].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[93].OneofWrappers = []any{}
 	file_actions_proto_msgTypes[94].OneofWrappers = []any{}
 	file_actions_proto_msgTypes[95].OneofWrappers = []any{}
-	file_actions_proto_msgTypes[108].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[96].OneofWrappers = []any{
+		(*FormElement_Label)(nil),
+		(*FormElement_Input)(nil),
+		(*FormElement_Toggle)(nil),
+		(*FormElement_Slider)(nil),
+		(*FormElement_Dropdown)(nil),
+		(*FormElement_StepSlider)(nil),
+	}
+	file_actions_proto_msgTypes[103].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[104].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[117].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[120].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[127].OneofWrappers = []any{
+		(*WorldSpawnEntityAction_Item)(nil),
+		(*WorldSpawnEntityAction_FallingBlock)(nil),
+		(*WorldSpawnEntityAction_Tnt)(nil),
+		(*WorldSpawnEntityAction_Arrow)(nil),
+		(*WorldSpawnEntityAction_ExperienceOrb)(nil),
+		(*WorldSpawnEntityAction_Lightning)(nil),
+		(*WorldSpawnEntityAction_Text)(nil),
+	}
+	file_actions_proto_msgTypes[128].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[130].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[131].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[135].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[136].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[137].OneofWrappers = []any{}
+	file_actions_proto_msgTypes[138].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_actions_proto_rawDesc), len(file_actions_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   110,
+			NumEnums:      4,
+			NumMessages:   139,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
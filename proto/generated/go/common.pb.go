@@ -467,6 +467,61 @@ func (CustomBlockRenderMethod) EnumDescriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{5}
 }
 
+type InventoryType int32
+
+const (
+	InventoryType_INVENTORY_TYPE_PLAYER      InventoryType = 0 // main inventory, hotbar in slots 0-8
+	InventoryType_INVENTORY_TYPE_ARMOUR      InventoryType = 1 // helmet, chestplate, leggings, boots
+	InventoryType_INVENTORY_TYPE_OFFHAND     InventoryType = 2 // a single slot
+	InventoryType_INVENTORY_TYPE_ENDER_CHEST InventoryType = 3
+	InventoryType_INVENTORY_TYPE_CONTAINER   InventoryType = 4 // a container block such as a chest, barrel or hopper
+)
+
+// Enum value maps for InventoryType.
+var (
+	InventoryType_name = map[int32]string{
+		0: "INVENTORY_TYPE_PLAYER",
+		1: "INVENTORY_TYPE_ARMOUR",
+		2: "INVENTORY_TYPE_OFFHAND",
+		3: "INVENTORY_TYPE_ENDER_CHEST",
+		4: "INVENTORY_TYPE_CONTAINER",
+	}
+	InventoryType_value = map[string]int32{
+		"INVENTORY_TYPE_PLAYER":      0,
+		"INVENTORY_TYPE_ARMOUR":      1,
+		"INVENTORY_TYPE_OFFHAND":     2,
+		"INVENTORY_TYPE_ENDER_CHEST": 3,
+		"INVENTORY_TYPE_CONTAINER":   4,
+	}
+)
+
+func (x InventoryType) Enum() *InventoryType {
+	p := new(InventoryType)
+	*p = x
+	return p
+}
+
+func (x InventoryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventoryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[6].Descriptor()
+}
+
+func (InventoryType) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[6]
+}
+
+func (x InventoryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventoryType.Descriptor instead.
+func (InventoryType) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{6}
+}
+
 type Vec3 struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
@@ -692,10 +747,21 @@ func (x *BlockPos) GetZ() int32 {
 }
 
 type ItemStack struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Meta          int32                  `protobuf:"varint,2,opt,name=meta,proto3" json:"meta,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Meta         int32                  `protobuf:"varint,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Count        int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	CustomName   *string                `protobuf:"bytes,4,opt,name=custom_name,json=customName,proto3,oneof" json:"custom_name,omitempty"`
+	Lore         []string               `protobuf:"bytes,5,rep,name=lore,proto3" json:"lore,omitempty"`
+	Damage       int32                  `protobuf:"varint,6,opt,name=damage,proto3" json:"damage,omitempty"` // durability lost, 0 for undamaged or non-durable items
+	Unbreakable  bool                   `protobuf:"varint,7,opt,name=unbreakable,proto3" json:"unbreakable,omitempty"`
+	AnvilCost    int32                  `protobuf:"varint,8,opt,name=anvil_cost,json=anvilCost,proto3" json:"anvil_cost,omitempty"`
+	Enchantments []*ItemEnchantment     `protobuf:"bytes,9,rep,name=enchantments,proto3" json:"enchantments,omitempty"`
+	Trim         *ArmourTrim            `protobuf:"bytes,10,opt,name=trim,proto3,oneof" json:"trim,omitempty"` // overrides the trim in nbt if set
+	// Item specific data such as book pages or leather colour, as a little-endian NBT compound.
+	Nbt []byte `protobuf:"bytes,11,opt,name=nbt,proto3" json:"nbt,omitempty"`
+	// Values attached to the stack with item.Stack.WithValue. Values of other types are not exposed.
+	Values        map[string]*ItemValue `protobuf:"bytes,12,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -751,6 +817,353 @@ func (x *ItemStack) GetCount() int32 {
 	return 0
 }
 
+func (x *ItemStack) GetCustomName() string {
+	if x != nil && x.CustomName != nil {
+		return *x.CustomName
+	}
+	return ""
+}
+
+func (x *ItemStack) GetLore() []string {
+	if x != nil {
+		return x.Lore
+	}
+	return nil
+}
+
+func (x *ItemStack) GetDamage() int32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *ItemStack) GetUnbreakable() bool {
+	if x != nil {
+		return x.Unbreakable
+	}
+	return false
+}
+
+func (x *ItemStack) GetAnvilCost() int32 {
+	if x != nil {
+		return x.AnvilCost
+	}
+	return 0
+}
+
+func (x *ItemStack) GetEnchantments() []*ItemEnchantment {
+	if x != nil {
+		return x.Enchantments
+	}
+	return nil
+}
+
+func (x *ItemStack) GetTrim() *ArmourTrim {
+	if x != nil {
+		return x.Trim
+	}
+	return nil
+}
+
+func (x *ItemStack) GetNbt() []byte {
+	if x != nil {
+		return x.Nbt
+	}
+	return nil
+}
+
+func (x *ItemStack) GetValues() map[string]*ItemValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ItemEnchantment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Bedrock enchantment ID
+	Level         int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemEnchantment) Reset() {
+	*x = ItemEnchantment{}
+	mi := &file_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemEnchantment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemEnchantment) ProtoMessage() {}
+
+func (x *ItemEnchantment) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemEnchantment.ProtoReflect.Descriptor instead.
+func (*ItemEnchantment) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ItemEnchantment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ItemEnchantment) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+type ArmourTrim struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`   // e.g. "sentry"
+	Material      string                 `protobuf:"bytes,2,opt,name=material,proto3" json:"material,omitempty"` // e.g. "diamond"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArmourTrim) Reset() {
+	*x = ArmourTrim{}
+	mi := &file_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArmourTrim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArmourTrim) ProtoMessage() {}
+
+func (x *ArmourTrim) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArmourTrim.ProtoReflect.Descriptor instead.
+func (*ArmourTrim) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ArmourTrim) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ArmourTrim) GetMaterial() string {
+	if x != nil {
+		return x.Material
+	}
+	return ""
+}
+
+// ItemValue is a value attached to an item stack. The variants keep the Go type of the value, so
+// values round-trip unchanged: int_value is a Go int, double_value a float64.
+type ItemValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*ItemValue_StringValue
+	//	*ItemValue_IntValue
+	//	*ItemValue_DoubleValue
+	//	*ItemValue_BoolValue
+	//	*ItemValue_BytesValue
+	//	*ItemValue_Int32Value
+	//	*ItemValue_Int64Value
+	//	*ItemValue_FloatValue
+	Value         isItemValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemValue) Reset() {
+	*x = ItemValue{}
+	mi := &file_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemValue) ProtoMessage() {}
+
+func (x *ItemValue) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemValue.ProtoReflect.Descriptor instead.
+func (*ItemValue) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ItemValue) GetValue() isItemValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *ItemValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *ItemValue) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *ItemValue) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *ItemValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *ItemValue) GetBytesValue() []byte {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_BytesValue); ok {
+			return x.BytesValue
+		}
+	}
+	return nil
+}
+
+func (x *ItemValue) GetInt32Value() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_Int32Value); ok {
+			return x.Int32Value
+		}
+	}
+	return 0
+}
+
+func (x *ItemValue) GetInt64Value() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_Int64Value); ok {
+			return x.Int64Value
+		}
+	}
+	return 0
+}
+
+func (x *ItemValue) GetFloatValue() float32 {
+	if x != nil {
+		if x, ok := x.Value.(*ItemValue_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
+}
+
+type isItemValue_Value interface {
+	isItemValue_Value()
+}
+
+type ItemValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type ItemValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type ItemValue_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,3,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type ItemValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type ItemValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,5,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type ItemValue_Int32Value struct {
+	Int32Value int32 `protobuf:"varint,6,opt,name=int32_value,json=int32Value,proto3,oneof"`
+}
+
+type ItemValue_Int64Value struct {
+	Int64Value int64 `protobuf:"varint,7,opt,name=int64_value,json=int64Value,proto3,oneof"`
+}
+
+type ItemValue_FloatValue struct {
+	FloatValue float32 `protobuf:"fixed32,8,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+func (*ItemValue_StringValue) isItemValue_Value() {}
+
+func (*ItemValue_IntValue) isItemValue_Value() {}
+
+func (*ItemValue_DoubleValue) isItemValue_Value() {}
+
+func (*ItemValue_BoolValue) isItemValue_Value() {}
+
+func (*ItemValue_BytesValue) isItemValue_Value() {}
+
+func (*ItemValue_Int32Value) isItemValue_Value() {}
+
+func (*ItemValue_Int64Value) isItemValue_Value() {}
+
+func (*ItemValue_FloatValue) isItemValue_Value() {}
+
 type BlockState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -761,7 +1174,7 @@ type BlockState struct {
 
 func (x *BlockState) Reset() {
 	*x = BlockState{}
-	mi := &file_common_proto_msgTypes[5]
+	mi := &file_common_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1186,7 @@ func (x *BlockState) String() string {
 func (*BlockState) ProtoMessage() {}
 
 func (x *BlockState) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[5]
+	mi := &file_common_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1199,7 @@ func (x *BlockState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockState.ProtoReflect.Descriptor instead.
 func (*BlockState) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{5}
+	return file_common_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BlockState) GetName() string {
@@ -815,7 +1228,7 @@ type LiquidState struct {
 
 func (x *LiquidState) Reset() {
 	*x = LiquidState{}
-	mi := &file_common_proto_msgTypes[6]
+	mi := &file_common_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +1240,7 @@ func (x *LiquidState) String() string {
 func (*LiquidState) ProtoMessage() {}
 
 func (x *LiquidState) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[6]
+	mi := &file_common_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1253,7 @@ func (x *LiquidState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LiquidState.ProtoReflect.Descriptor instead.
 func (*LiquidState) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{6}
+	return file_common_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LiquidState) GetBlock() *BlockState {
@@ -882,7 +1295,7 @@ type WorldRef struct {
 
 func (x *WorldRef) Reset() {
 	*x = WorldRef{}
-	mi := &file_common_proto_msgTypes[7]
+	mi := &file_common_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -894,7 +1307,7 @@ func (x *WorldRef) String() string {
 func (*WorldRef) ProtoMessage() {}
 
 func (x *WorldRef) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[7]
+	mi := &file_common_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1320,7 @@ func (x *WorldRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorldRef.ProtoReflect.Descriptor instead.
 func (*WorldRef) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{7}
+	return file_common_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WorldRef) GetName() string {
@@ -944,7 +1357,7 @@ type EntityRef struct {
 
 func (x *EntityRef) Reset() {
 	*x = EntityRef{}
-	mi := &file_common_proto_msgTypes[8]
+	mi := &file_common_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -956,7 +1369,7 @@ func (x *EntityRef) String() string {
 func (*EntityRef) ProtoMessage() {}
 
 func (x *EntityRef) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[8]
+	mi := &file_common_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -969,7 +1382,7 @@ func (x *EntityRef) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityRef.ProtoReflect.Descriptor instead.
 func (*EntityRef) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{8}
+	return file_common_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EntityRef) GetUuid() string {
@@ -1017,7 +1430,7 @@ type DamageSource struct {
 
 func (x *DamageSource) Reset() {
 	*x = DamageSource{}
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1029,7 +1442,7 @@ func (x *DamageSource) String() string {
 func (*DamageSource) ProtoMessage() {}
 
 func (x *DamageSource) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +1455,7 @@ func (x *DamageSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DamageSource.ProtoReflect.Descriptor instead.
 func (*DamageSource) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{9}
+	return file_common_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DamageSource) GetType() string {
@@ -1069,7 +1482,7 @@ type HealingSource struct {
 
 func (x *HealingSource) Reset() {
 	*x = HealingSource{}
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1081,7 +1494,7 @@ func (x *HealingSource) String() string {
 func (*HealingSource) ProtoMessage() {}
 
 func (x *HealingSource) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,7 +1507,7 @@ func (x *HealingSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealingSource.ProtoReflect.Descriptor instead.
 func (*HealingSource) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{10}
+	return file_common_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *HealingSource) GetType() string {
@@ -1121,7 +1534,7 @@ type Address struct {
 
 func (x *Address) Reset() {
 	*x = Address{}
-	mi := &file_common_proto_msgTypes[11]
+	mi := &file_common_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1133,7 +1546,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[11]
+	mi := &file_common_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,7 +1559,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{11}
+	return file_common_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Address) GetHost() string {
@@ -1179,7 +1592,7 @@ type CustomItemDefinition struct {
 
 func (x *CustomItemDefinition) Reset() {
 	*x = CustomItemDefinition{}
-	mi := &file_common_proto_msgTypes[12]
+	mi := &file_common_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1604,7 @@ func (x *CustomItemDefinition) String() string {
 func (*CustomItemDefinition) ProtoMessage() {}
 
 func (x *CustomItemDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[12]
+	mi := &file_common_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1617,7 @@ func (x *CustomItemDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomItemDefinition.ProtoReflect.Descriptor instead.
 func (*CustomItemDefinition) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{12}
+	return file_common_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CustomItemDefinition) GetId() string {
@@ -1259,7 +1672,7 @@ type CustomBlockTexture struct {
 
 func (x *CustomBlockTexture) Reset() {
 	*x = CustomBlockTexture{}
-	mi := &file_common_proto_msgTypes[13]
+	mi := &file_common_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1271,7 +1684,7 @@ func (x *CustomBlockTexture) String() string {
 func (*CustomBlockTexture) ProtoMessage() {}
 
 func (x *CustomBlockTexture) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[13]
+	mi := &file_common_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +1697,7 @@ func (x *CustomBlockTexture) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomBlockTexture.ProtoReflect.Descriptor instead.
 func (*CustomBlockTexture) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{13}
+	return file_common_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CustomBlockTexture) GetName() string {
@@ -1314,7 +1727,7 @@ type CustomBlockMaterial struct {
 
 func (x *CustomBlockMaterial) Reset() {
 	*x = CustomBlockMaterial{}
-	mi := &file_common_proto_msgTypes[14]
+	mi := &file_common_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1326,7 +1739,7 @@ func (x *CustomBlockMaterial) String() string {
 func (*CustomBlockMaterial) ProtoMessage() {}
 
 func (x *CustomBlockMaterial) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[14]
+	mi := &file_common_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,7 +1752,7 @@ func (x *CustomBlockMaterial) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomBlockMaterial.ProtoReflect.Descriptor instead.
 func (*CustomBlockMaterial) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{14}
+	return file_common_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CustomBlockMaterial) GetTarget() string {
@@ -1397,7 +1810,7 @@ type CustomBlockProperties struct {
 
 func (x *CustomBlockProperties) Reset() {
 	*x = CustomBlockProperties{}
-	mi := &file_common_proto_msgTypes[15]
+	mi := &file_common_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1409,7 +1822,7 @@ func (x *CustomBlockProperties) String() string {
 func (*CustomBlockProperties) ProtoMessage() {}
 
 func (x *CustomBlockProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[15]
+	mi := &file_common_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1422,7 +1835,7 @@ func (x *CustomBlockProperties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomBlockProperties.ProtoReflect.Descriptor instead.
 func (*CustomBlockProperties) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{15}
+	return file_common_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CustomBlockProperties) GetCollisionBox() *BBox {
@@ -1515,7 +1928,7 @@ type CustomBlockDefinition struct {
 
 func (x *CustomBlockDefinition) Reset() {
 	*x = CustomBlockDefinition{}
-	mi := &file_common_proto_msgTypes[16]
+	mi := &file_common_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1527,7 +1940,7 @@ func (x *CustomBlockDefinition) String() string {
 func (*CustomBlockDefinition) ProtoMessage() {}
 
 func (x *CustomBlockDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[16]
+	mi := &file_common_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,7 +1953,7 @@ func (x *CustomBlockDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomBlockDefinition.ProtoReflect.Descriptor instead.
 func (*CustomBlockDefinition) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{16}
+	return file_common_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CustomBlockDefinition) GetId() string {
@@ -1588,7 +2001,7 @@ type CustomBlockStateValues struct {
 
 func (x *CustomBlockStateValues) Reset() {
 	*x = CustomBlockStateValues{}
-	mi := &file_common_proto_msgTypes[17]
+	mi := &file_common_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1600,7 +2013,7 @@ func (x *CustomBlockStateValues) String() string {
 func (*CustomBlockStateValues) ProtoMessage() {}
 
 func (x *CustomBlockStateValues) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[17]
+	mi := &file_common_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,7 +2026,7 @@ func (x *CustomBlockStateValues) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomBlockStateValues.ProtoReflect.Descriptor instead.
 func (*CustomBlockStateValues) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{17}
+	return file_common_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CustomBlockStateValues) GetValues() []string {
@@ -1634,7 +2047,7 @@ type CustomBlockPermutation struct {
 
 func (x *CustomBlockPermutation) Reset() {
 	*x = CustomBlockPermutation{}
-	mi := &file_common_proto_msgTypes[18]
+	mi := &file_common_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1646,7 +2059,7 @@ func (x *CustomBlockPermutation) String() string {
 func (*CustomBlockPermutation) ProtoMessage() {}
 
 func (x *CustomBlockPermutation) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[18]
+	mi := &file_common_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +2072,7 @@ func (x *CustomBlockPermutation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CustomBlockPermutation.ProtoReflect.Descriptor instead.
 func (*CustomBlockPermutation) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{18}
+	return file_common_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CustomBlockPermutation) GetCondition() string {
@@ -1676,6 +2089,127 @@ func (x *CustomBlockPermutation) GetProperties() *CustomBlockProperties {
 	return nil
 }
 
+// InventoryTarget selects an inventory. Player inventories need player_uuid, containers need world and position.
+type InventoryTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          InventoryType          `protobuf:"varint,1,opt,name=type,proto3,enum=df.plugin.InventoryType" json:"type,omitempty"`
+	PlayerUuid    string                 `protobuf:"bytes,2,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	World         *WorldRef              `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryTarget) Reset() {
+	*x = InventoryTarget{}
+	mi := &file_common_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryTarget) ProtoMessage() {}
+
+func (x *InventoryTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryTarget.ProtoReflect.Descriptor instead.
+func (*InventoryTarget) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InventoryTarget) GetType() InventoryType {
+	if x != nil {
+		return x.Type
+	}
+	return InventoryType_INVENTORY_TYPE_PLAYER
+}
+
+func (x *InventoryTarget) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *InventoryTarget) GetWorld() *WorldRef {
+	if x != nil {
+		return x.World
+	}
+	return nil
+}
+
+func (x *InventoryTarget) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type InventorySlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          int32                  `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Item          *ItemStack             `protobuf:"bytes,2,opt,name=item,proto3,oneof" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventorySlot) Reset() {
+	*x = InventorySlot{}
+	mi := &file_common_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySlot) ProtoMessage() {}
+
+func (x *InventorySlot) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySlot.ProtoReflect.Descriptor instead.
+func (*InventorySlot) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InventorySlot) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *InventorySlot) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
 var File_common_proto protoreflect.FileDescriptor
 
 const file_common_proto_rawDesc = "" +
@@ -1694,11 +2228,50 @@ const file_common_proto_rawDesc = "" +
 	"\bBlockPos\x12\f\n" +
 	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
 	"\x01y\x18\x02 \x01(\x05R\x01y\x12\f\n" +
-	"\x01z\x18\x03 \x01(\x05R\x01z\"I\n" +
+	"\x01z\x18\x03 \x01(\x05R\x01z\"\x82\x04\n" +
 	"\tItemStack\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04meta\x18\x02 \x01(\x05R\x04meta\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\"\xa6\x01\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12$\n" +
+	"\vcustom_name\x18\x04 \x01(\tH\x00R\n" +
+	"customName\x88\x01\x01\x12\x12\n" +
+	"\x04lore\x18\x05 \x03(\tR\x04lore\x12\x16\n" +
+	"\x06damage\x18\x06 \x01(\x05R\x06damage\x12 \n" +
+	"\vunbreakable\x18\a \x01(\bR\vunbreakable\x12\x1d\n" +
+	"\n" +
+	"anvil_cost\x18\b \x01(\x05R\tanvilCost\x12>\n" +
+	"\fenchantments\x18\t \x03(\v2\x1a.df.plugin.ItemEnchantmentR\fenchantments\x12.\n" +
+	"\x04trim\x18\n" +
+	" \x01(\v2\x15.df.plugin.ArmourTrimH\x01R\x04trim\x88\x01\x01\x12\x10\n" +
+	"\x03nbt\x18\v \x01(\fR\x03nbt\x128\n" +
+	"\x06values\x18\f \x03(\v2 .df.plugin.ItemStack.ValuesEntryR\x06values\x1aO\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.df.plugin.ItemValueR\x05value:\x028\x01B\x0e\n" +
+	"\f_custom_nameB\a\n" +
+	"\x05_trim\"7\n" +
+	"\x0fItemEnchantment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\"B\n" +
+	"\n" +
+	"ArmourTrim\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x1a\n" +
+	"\bmaterial\x18\x02 \x01(\tR\bmaterial\"\xaa\x02\n" +
+	"\tItemValue\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12\x1d\n" +
+	"\tint_value\x18\x02 \x01(\x03H\x00R\bintValue\x12#\n" +
+	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x04 \x01(\bH\x00R\tboolValue\x12!\n" +
+	"\vbytes_value\x18\x05 \x01(\fH\x00R\n" +
+	"bytesValue\x12!\n" +
+	"\vint32_value\x18\x06 \x01(\x05H\x00R\n" +
+	"int32Value\x12!\n" +
+	"\vint64_value\x18\a \x01(\x03H\x00R\n" +
+	"int64Value\x12!\n" +
+	"\vfloat_value\x18\b \x01(\x02H\x00R\n" +
+	"floatValueB\a\n" +
+	"\x05value\"\xa6\x01\n" +
 	"\n" +
 	"BlockState\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12E\n" +
@@ -1796,7 +2369,17 @@ const file_common_proto_rawDesc = "" +
 	"\tcondition\x18\x01 \x01(\tR\tcondition\x12@\n" +
 	"\n" +
 	"properties\x18\x02 \x01(\v2 .df.plugin.CustomBlockPropertiesR\n" +
-	"properties*D\n" +
+	"properties\"\xbc\x01\n" +
+	"\x0fInventoryTarget\x12,\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x18.df.plugin.InventoryTypeR\x04type\x12\x1f\n" +
+	"\vplayer_uuid\x18\x02 \x01(\tR\n" +
+	"playerUuid\x12)\n" +
+	"\x05world\x18\x03 \x01(\v2\x13.df.plugin.WorldRefR\x05world\x12/\n" +
+	"\bposition\x18\x04 \x01(\v2\x13.df.plugin.BlockPosR\bposition\"[\n" +
+	"\rInventorySlot\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\x05R\x04slot\x12-\n" +
+	"\x04item\x18\x02 \x01(\v2\x14.df.plugin.ItemStackH\x00R\x04item\x88\x01\x01B\a\n" +
+	"\x05_item*D\n" +
 	"\bGameMode\x12\f\n" +
 	"\bSURVIVAL\x10\x00\x12\f\n" +
 	"\bCREATIVE\x10\x01\x12\r\n" +
@@ -1886,7 +2469,13 @@ const file_common_proto_rawDesc = "" +
 	"!CUSTOM_BLOCK_RENDER_METHOD_OPAQUE\x10\x00\x12)\n" +
 	"%CUSTOM_BLOCK_RENDER_METHOD_ALPHA_TEST\x10\x01\x12$\n" +
 	" CUSTOM_BLOCK_RENDER_METHOD_BLEND\x10\x02\x12+\n" +
-	"'CUSTOM_BLOCK_RENDER_METHOD_DOUBLE_SIDED\x10\x03B\x8a\x01\n" +
+	"'CUSTOM_BLOCK_RENDER_METHOD_DOUBLE_SIDED\x10\x03*\x9f\x01\n" +
+	"\rInventoryType\x12\x19\n" +
+	"\x15INVENTORY_TYPE_PLAYER\x10\x00\x12\x19\n" +
+	"\x15INVENTORY_TYPE_ARMOUR\x10\x01\x12\x1a\n" +
+	"\x16INVENTORY_TYPE_OFFHAND\x10\x02\x12\x1e\n" +
+	"\x1aINVENTORY_TYPE_ENDER_CHEST\x10\x03\x12\x1c\n" +
+	"\x18INVENTORY_TYPE_CONTAINER\x10\x04B\x8a\x01\n" +
 	"\rcom.df.pluginB\vCommonProtoP\x01Z'github.com/secmc/plugin/proto/generated\xa2\x02\x03DPX\xaa\x02\tDf.Plugin\xca\x02\tDf\\Plugin\xe2\x02\x15Df\\Plugin\\GPBMetadata\xea\x02\n" +
 	"Df::Pluginb\x06proto3"
 
@@ -1902,8 +2491,8 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_common_proto_goTypes = []any{
 	(GameMode)(0),                  // 0: df.plugin.GameMode
 	(Difficulty)(0),                // 1: df.plugin.Difficulty
@@ -1911,54 +2500,69 @@ var file_common_proto_goTypes = []any{
 	(Sound)(0),                     // 3: df.plugin.Sound
 	(ItemCategory)(0),              // 4: df.plugin.ItemCategory
 	(CustomBlockRenderMethod)(0),   // 5: df.plugin.CustomBlockRenderMethod
-	(*Vec3)(nil),                   // 6: df.plugin.Vec3
-	(*Rotation)(nil),               // 7: df.plugin.Rotation
-	(*BBox)(nil),                   // 8: df.plugin.BBox
-	(*BlockPos)(nil),               // 9: df.plugin.BlockPos
-	(*ItemStack)(nil),              // 10: df.plugin.ItemStack
-	(*BlockState)(nil),             // 11: df.plugin.BlockState
-	(*LiquidState)(nil),            // 12: df.plugin.LiquidState
-	(*WorldRef)(nil),               // 13: df.plugin.WorldRef
-	(*EntityRef)(nil),              // 14: df.plugin.EntityRef
-	(*DamageSource)(nil),           // 15: df.plugin.DamageSource
-	(*HealingSource)(nil),          // 16: df.plugin.HealingSource
-	(*Address)(nil),                // 17: df.plugin.Address
-	(*CustomItemDefinition)(nil),   // 18: df.plugin.CustomItemDefinition
-	(*CustomBlockTexture)(nil),     // 19: df.plugin.CustomBlockTexture
-	(*CustomBlockMaterial)(nil),    // 20: df.plugin.CustomBlockMaterial
-	(*CustomBlockProperties)(nil),  // 21: df.plugin.CustomBlockProperties
-	(*CustomBlockDefinition)(nil),  // 22: df.plugin.CustomBlockDefinition
-	(*CustomBlockStateValues)(nil), // 23: df.plugin.CustomBlockStateValues
-	(*CustomBlockPermutation)(nil), // 24: df.plugin.CustomBlockPermutation
-	nil,                            // 25: df.plugin.BlockState.PropertiesEntry
-	nil,                            // 26: df.plugin.CustomBlockProperties.StatesEntry
+	(InventoryType)(0),             // 6: df.plugin.InventoryType
+	(*Vec3)(nil),                   // 7: df.plugin.Vec3
+	(*Rotation)(nil),               // 8: df.plugin.Rotation
+	(*BBox)(nil),                   // 9: df.plugin.BBox
+	(*BlockPos)(nil),               // 10: df.plugin.BlockPos
+	(*ItemStack)(nil),              // 11: df.plugin.ItemStack
+	(*ItemEnchantment)(nil),        // 12: df.plugin.ItemEnchantment
+	(*ArmourTrim)(nil),             // 13: df.plugin.ArmourTrim
+	(*ItemValue)(nil),              // 14: df.plugin.ItemValue
+	(*BlockState)(nil),             // 15: df.plugin.BlockState
+	(*LiquidState)(nil),            // 16: df.plugin.LiquidState
+	(*WorldRef)(nil),               // 17: df.plugin.WorldRef
+	(*EntityRef)(nil),              // 18: df.plugin.EntityRef
+	(*DamageSource)(nil),           // 19: df.plugin.DamageSource
+	(*HealingSource)(nil),          // 20: df.plugin.HealingSource
+	(*Address)(nil),                // 21: df.plugin.Address
+	(*CustomItemDefinition)(nil),   // 22: df.plugin.CustomItemDefinition
+	(*CustomBlockTexture)(nil),     // 23: df.plugin.CustomBlockTexture
+	(*CustomBlockMaterial)(nil),    // 24: df.plugin.CustomBlockMaterial
+	(*CustomBlockProperties)(nil),  // 25: df.plugin.CustomBlockProperties
+	(*CustomBlockDefinition)(nil),  // 26: df.plugin.CustomBlockDefinition
+	(*CustomBlockStateValues)(nil), // 27: df.plugin.CustomBlockStateValues
+	(*CustomBlockPermutation)(nil), // 28: df.plugin.CustomBlockPermutation
+	(*InventoryTarget)(nil),        // 29: df.plugin.InventoryTarget
+	(*InventorySlot)(nil),          // 30: df.plugin.InventorySlot
+	nil,                            // 31: df.plugin.ItemStack.ValuesEntry
+	nil,                            // 32: df.plugin.BlockState.PropertiesEntry
+	nil,                            // 33: df.plugin.CustomBlockProperties.StatesEntry
 }
 var file_common_proto_depIdxs = []int32{
-	6,  // 0: df.plugin.BBox.min:type_name -> df.plugin.Vec3
-	6,  // 1: df.plugin.BBox.max:type_name -> df.plugin.Vec3
-	25, // 2: df.plugin.BlockState.properties:type_name -> df.plugin.BlockState.PropertiesEntry
-	11, // 3: df.plugin.LiquidState.block:type_name -> df.plugin.BlockState
-	6,  // 4: df.plugin.EntityRef.position:type_name -> df.plugin.Vec3
-	7,  // 5: df.plugin.EntityRef.rotation:type_name -> df.plugin.Rotation
-	4,  // 6: df.plugin.CustomItemDefinition.category:type_name -> df.plugin.ItemCategory
-	5,  // 7: df.plugin.CustomBlockMaterial.render_method:type_name -> df.plugin.CustomBlockRenderMethod
-	8,  // 8: df.plugin.CustomBlockProperties.collision_box:type_name -> df.plugin.BBox
-	8,  // 9: df.plugin.CustomBlockProperties.selection_box:type_name -> df.plugin.BBox
-	6,  // 10: df.plugin.CustomBlockProperties.rotation:type_name -> df.plugin.Vec3
-	6,  // 11: df.plugin.CustomBlockProperties.translation:type_name -> df.plugin.Vec3
-	6,  // 12: df.plugin.CustomBlockProperties.scale:type_name -> df.plugin.Vec3
-	20, // 13: df.plugin.CustomBlockProperties.materials:type_name -> df.plugin.CustomBlockMaterial
-	26, // 14: df.plugin.CustomBlockProperties.states:type_name -> df.plugin.CustomBlockProperties.StatesEntry
-	24, // 15: df.plugin.CustomBlockProperties.permutations:type_name -> df.plugin.CustomBlockPermutation
-	19, // 16: df.plugin.CustomBlockDefinition.textures:type_name -> df.plugin.CustomBlockTexture
-	21, // 17: df.plugin.CustomBlockDefinition.properties:type_name -> df.plugin.CustomBlockProperties
-	21, // 18: df.plugin.CustomBlockPermutation.properties:type_name -> df.plugin.CustomBlockProperties
-	23, // 19: df.plugin.CustomBlockProperties.StatesEntry.value:type_name -> df.plugin.CustomBlockStateValues
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	7,  // 0: df.plugin.BBox.min:type_name -> df.plugin.Vec3
+	7,  // 1: df.plugin.BBox.max:type_name -> df.plugin.Vec3
+	12, // 2: df.plugin.ItemStack.enchantments:type_name -> df.plugin.ItemEnchantment
+	13, // 3: df.plugin.ItemStack.trim:type_name -> df.plugin.ArmourTrim
+	31, // 4: df.plugin.ItemStack.values:type_name -> df.plugin.ItemStack.ValuesEntry
+	32, // 5: df.plugin.BlockState.properties:type_name -> df.plugin.BlockState.PropertiesEntry
+	15, // 6: df.plugin.LiquidState.block:type_name -> df.plugin.BlockState
+	7,  // 7: df.plugin.EntityRef.position:type_name -> df.plugin.Vec3
+	8,  // 8: df.plugin.EntityRef.rotation:type_name -> df.plugin.Rotation
+	4,  // 9: df.plugin.CustomItemDefinition.category:type_name -> df.plugin.ItemCategory
+	5,  // 10: df.plugin.CustomBlockMaterial.render_method:type_name -> df.plugin.CustomBlockRenderMethod
+	9,  // 11: df.plugin.CustomBlockProperties.collision_box:type_name -> df.plugin.BBox
+	9,  // 12: df.plugin.CustomBlockProperties.selection_box:type_name -> df.plugin.BBox
+	7,  // 13: df.plugin.CustomBlockProperties.rotation:type_name -> df.plugin.Vec3
+	7,  // 14: df.plugin.CustomBlockProperties.translation:type_name -> df.plugin.Vec3
+	7,  // 15: df.plugin.CustomBlockProperties.scale:type_name -> df.plugin.Vec3
+	24, // 16: df.plugin.CustomBlockProperties.materials:type_name -> df.plugin.CustomBlockMaterial
+	33, // 17: df.plugin.CustomBlockProperties.states:type_name -> df.plugin.CustomBlockProperties.StatesEntry
+	28, // 18: df.plugin.CustomBlockProperties.permutations:type_name -> df.plugin.CustomBlockPermutation
+	23, // 19: df.plugin.CustomBlockDefinition.textures:type_name -> df.plugin.CustomBlockTexture
+	25, // 20: df.plugin.CustomBlockDefinition.properties:type_name -> df.plugin.CustomBlockProperties
+	25, // 21: df.plugin.CustomBlockPermutation.properties:type_name -> df.plugin.CustomBlockProperties
+	6,  // 22: df.plugin.InventoryTarget.type:type_name -> df.plugin.InventoryType
+	17, // 23: df.plugin.InventoryTarget.world:type_name -> df.plugin.WorldRef
+	10, // 24: df.plugin.InventoryTarget.position:type_name -> df.plugin.BlockPos
+	11, // 25: df.plugin.InventorySlot.item:type_name -> df.plugin.ItemStack
+	14, // 26: df.plugin.ItemStack.ValuesEntry.value:type_name -> df.plugin.ItemValue
+	27, // 27: df.plugin.CustomBlockProperties.StatesEntry.value:type_name -> df.plugin.CustomBlockStateValues
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -1966,20 +2570,32 @@ func file_common_proto_init() {
 	if File_common_proto != nil {
 		return
 	}
-	file_common_proto_msgTypes[8].OneofWrappers = []any{}
-	file_common_proto_msgTypes[9].OneofWrappers = []any{}
-	file_common_proto_msgTypes[10].OneofWrappers = []any{}
+	file_common_proto_msgTypes[4].OneofWrappers = []any{}
+	file_common_proto_msgTypes[7].OneofWrappers = []any{
+		(*ItemValue_StringValue)(nil),
+		(*ItemValue_IntValue)(nil),
+		(*ItemValue_DoubleValue)(nil),
+		(*ItemValue_BoolValue)(nil),
+		(*ItemValue_BytesValue)(nil),
+		(*ItemValue_Int32Value)(nil),
+		(*ItemValue_Int64Value)(nil),
+		(*ItemValue_FloatValue)(nil),
+	}
+	file_common_proto_msgTypes[11].OneofWrappers = []any{}
 	file_common_proto_msgTypes[12].OneofWrappers = []any{}
-	file_common_proto_msgTypes[14].OneofWrappers = []any{}
+	file_common_proto_msgTypes[13].OneofWrappers = []any{}
 	file_common_proto_msgTypes[15].OneofWrappers = []any{}
-	file_common_proto_msgTypes[16].OneofWrappers = []any{}
+	file_common_proto_msgTypes[17].OneofWrappers = []any{}
+	file_common_proto_msgTypes[18].OneofWrappers = []any{}
+	file_common_proto_msgTypes[19].OneofWrappers = []any{}
+	file_common_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   21,
+			NumEnums:      7,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type InventorySlotAction int32
+
+const (
+	InventorySlotAction_INVENTORY_SLOT_ACTION_TAKE  InventorySlotAction = 0
+	InventorySlotAction_INVENTORY_SLOT_ACTION_PLACE InventorySlotAction = 1
+	InventorySlotAction_INVENTORY_SLOT_ACTION_DROP  InventorySlotAction = 2
+)
+
+// Enum value maps for InventorySlotAction.
+var (
+	InventorySlotAction_name = map[int32]string{
+		0: "INVENTORY_SLOT_ACTION_TAKE",
+		1: "INVENTORY_SLOT_ACTION_PLACE",
+		2: "INVENTORY_SLOT_ACTION_DROP",
+	}
+	InventorySlotAction_value = map[string]int32{
+		"INVENTORY_SLOT_ACTION_TAKE":  0,
+		"INVENTORY_SLOT_ACTION_PLACE": 1,
+		"INVENTORY_SLOT_ACTION_DROP":  2,
+	}
+)
+
+func (x InventorySlotAction) Enum() *InventorySlotAction {
+	p := new(InventorySlotAction)
+	*p = x
+	return p
+}
+
+func (x InventorySlotAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InventorySlotAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_events_proto_enumTypes[0].Descriptor()
+}
+
+func (InventorySlotAction) Type() protoreflect.EnumType {
+	return &file_player_events_proto_enumTypes[0]
+}
+
+func (x InventorySlotAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InventorySlotAction.Descriptor instead.
+func (InventorySlotAction) EnumDescriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{0}
+}
+
+type VirtualInventoryClickType int32
+
+const (
+	VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_TAKE  VirtualInventoryClickType = 0
+	VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_PLACE VirtualInventoryClickType = 1
+	VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_SWAP  VirtualInventoryClickType = 2
+	VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_DROP  VirtualInventoryClickType = 3
+)
+
+// Enum value maps for VirtualInventoryClickType.
+var (
+	VirtualInventoryClickType_name = map[int32]string{
+		0: "VIRTUAL_INVENTORY_CLICK_TYPE_TAKE",
+		1: "VIRTUAL_INVENTORY_CLICK_TYPE_PLACE",
+		2: "VIRTUAL_INVENTORY_CLICK_TYPE_SWAP",
+		3: "VIRTUAL_INVENTORY_CLICK_TYPE_DROP",
+	}
+	VirtualInventoryClickType_value = map[string]int32{
+		"VIRTUAL_INVENTORY_CLICK_TYPE_TAKE":  0,
+		"VIRTUAL_INVENTORY_CLICK_TYPE_PLACE": 1,
+		"VIRTUAL_INVENTORY_CLICK_TYPE_SWAP":  2,
+		"VIRTUAL_INVENTORY_CLICK_TYPE_DROP":  3,
+	}
+)
+
+func (x VirtualInventoryClickType) Enum() *VirtualInventoryClickType {
+	p := new(VirtualInventoryClickType)
+	*p = x
+	return p
+}
+
+func (x VirtualInventoryClickType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualInventoryClickType) Descriptor() protoreflect.EnumDescriptor {
+	return file_player_events_proto_enumTypes[1].Descriptor()
+}
+
+func (VirtualInventoryClickType) Type() protoreflect.EnumType {
+	return &file_player_events_proto_enumTypes[1]
+}
+
+func (x VirtualInventoryClickType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VirtualInventoryClickType.Descriptor instead.
+func (VirtualInventoryClickType) EnumDescriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{1}
+}
+
 type PlayerJoinEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
@@ -140,6 +241,7 @@ type PlayerMoveEvent struct {
 	World         string                 `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
 	Position      *Vec3                  `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
 	Rotation      *Rotation              `protobuf:"bytes,5,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Cancelled     bool                   `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"` // only set in a PlayerMoveBatch: a plugin cancelled the move
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -209,6 +311,59 @@ func (x *PlayerMoveEvent) GetRotation() *Rotation {
 	return nil
 }
 
+func (x *PlayerMoveEvent) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+// PlayerMoveBatch carries the moves of one tick to observe-only PLAYER_MOVE subscribers. Only the
+// latest move of each player within the tick is included, as resolved by the other subscribers.
+type PlayerMoveBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Moves         []*PlayerMoveEvent     `protobuf:"bytes,1,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerMoveBatch) Reset() {
+	*x = PlayerMoveBatch{}
+	mi := &file_player_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerMoveBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerMoveBatch) ProtoMessage() {}
+
+func (x *PlayerMoveBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerMoveBatch.ProtoReflect.Descriptor instead.
+func (*PlayerMoveBatch) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlayerMoveBatch) GetMoves() []*PlayerMoveEvent {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
 type PlayerJumpEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
@@ -221,7 +376,7 @@ type PlayerJumpEvent struct {
 
 func (x *PlayerJumpEvent) Reset() {
 	*x = PlayerJumpEvent{}
-	mi := &file_player_events_proto_msgTypes[3]
+	mi := &file_player_events_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -233,7 +388,7 @@ func (x *PlayerJumpEvent) String() string {
 func (*PlayerJumpEvent) ProtoMessage() {}
 
 func (x *PlayerJumpEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[3]
+	mi := &file_player_events_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +401,7 @@ func (x *PlayerJumpEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerJumpEvent.ProtoReflect.Descriptor instead.
 func (*PlayerJumpEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{3}
+	return file_player_events_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PlayerJumpEvent) GetPlayerUuid() string {
@@ -289,7 +444,7 @@ type PlayerTeleportEvent struct {
 
 func (x *PlayerTeleportEvent) Reset() {
 	*x = PlayerTeleportEvent{}
-	mi := &file_player_events_proto_msgTypes[4]
+	mi := &file_player_events_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -301,7 +456,7 @@ func (x *PlayerTeleportEvent) String() string {
 func (*PlayerTeleportEvent) ProtoMessage() {}
 
 func (x *PlayerTeleportEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[4]
+	mi := &file_player_events_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -314,7 +469,7 @@ func (x *PlayerTeleportEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerTeleportEvent.ProtoReflect.Descriptor instead.
 func (*PlayerTeleportEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{4}
+	return file_player_events_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PlayerTeleportEvent) GetPlayerUuid() string {
@@ -357,7 +512,7 @@ type PlayerChangeWorldEvent struct {
 
 func (x *PlayerChangeWorldEvent) Reset() {
 	*x = PlayerChangeWorldEvent{}
-	mi := &file_player_events_proto_msgTypes[5]
+	mi := &file_player_events_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -369,7 +524,7 @@ func (x *PlayerChangeWorldEvent) String() string {
 func (*PlayerChangeWorldEvent) ProtoMessage() {}
 
 func (x *PlayerChangeWorldEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[5]
+	mi := &file_player_events_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +537,7 @@ func (x *PlayerChangeWorldEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerChangeWorldEvent.ProtoReflect.Descriptor instead.
 func (*PlayerChangeWorldEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{5}
+	return file_player_events_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PlayerChangeWorldEvent) GetPlayerUuid() string {
@@ -424,7 +579,7 @@ type PlayerToggleSprintEvent struct {
 
 func (x *PlayerToggleSprintEvent) Reset() {
 	*x = PlayerToggleSprintEvent{}
-	mi := &file_player_events_proto_msgTypes[6]
+	mi := &file_player_events_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -436,7 +591,7 @@ func (x *PlayerToggleSprintEvent) String() string {
 func (*PlayerToggleSprintEvent) ProtoMessage() {}
 
 func (x *PlayerToggleSprintEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[6]
+	mi := &file_player_events_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +604,7 @@ func (x *PlayerToggleSprintEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerToggleSprintEvent.ProtoReflect.Descriptor instead.
 func (*PlayerToggleSprintEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{6}
+	return file_player_events_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PlayerToggleSprintEvent) GetPlayerUuid() string {
@@ -484,7 +639,7 @@ type PlayerToggleSneakEvent struct {
 
 func (x *PlayerToggleSneakEvent) Reset() {
 	*x = PlayerToggleSneakEvent{}
-	mi := &file_player_events_proto_msgTypes[7]
+	mi := &file_player_events_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +651,7 @@ func (x *PlayerToggleSneakEvent) String() string {
 func (*PlayerToggleSneakEvent) ProtoMessage() {}
 
 func (x *PlayerToggleSneakEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[7]
+	mi := &file_player_events_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +664,7 @@ func (x *PlayerToggleSneakEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerToggleSneakEvent.ProtoReflect.Descriptor instead.
 func (*PlayerToggleSneakEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{7}
+	return file_player_events_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PlayerToggleSneakEvent) GetPlayerUuid() string {
@@ -544,7 +699,7 @@ type ChatEvent struct {
 
 func (x *ChatEvent) Reset() {
 	*x = ChatEvent{}
-	mi := &file_player_events_proto_msgTypes[8]
+	mi := &file_player_events_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -556,7 +711,7 @@ func (x *ChatEvent) String() string {
 func (*ChatEvent) ProtoMessage() {}
 
 func (x *ChatEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[8]
+	mi := &file_player_events_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +724,7 @@ func (x *ChatEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
 func (*ChatEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{8}
+	return file_player_events_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ChatEvent) GetPlayerUuid() string {
@@ -605,7 +760,7 @@ type PlayerFoodLossEvent struct {
 
 func (x *PlayerFoodLossEvent) Reset() {
 	*x = PlayerFoodLossEvent{}
-	mi := &file_player_events_proto_msgTypes[9]
+	mi := &file_player_events_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -617,7 +772,7 @@ func (x *PlayerFoodLossEvent) String() string {
 func (*PlayerFoodLossEvent) ProtoMessage() {}
 
 func (x *PlayerFoodLossEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[9]
+	mi := &file_player_events_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +785,7 @@ func (x *PlayerFoodLossEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerFoodLossEvent.ProtoReflect.Descriptor instead.
 func (*PlayerFoodLossEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{9}
+	return file_player_events_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PlayerFoodLossEvent) GetPlayerUuid() string {
@@ -673,7 +828,7 @@ type PlayerHealEvent struct {
 
 func (x *PlayerHealEvent) Reset() {
 	*x = PlayerHealEvent{}
-	mi := &file_player_events_proto_msgTypes[10]
+	mi := &file_player_events_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +840,7 @@ func (x *PlayerHealEvent) String() string {
 func (*PlayerHealEvent) ProtoMessage() {}
 
 func (x *PlayerHealEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[10]
+	mi := &file_player_events_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +853,7 @@ func (x *PlayerHealEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerHealEvent.ProtoReflect.Descriptor instead.
 func (*PlayerHealEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{10}
+	return file_player_events_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PlayerHealEvent) GetPlayerUuid() string {
@@ -743,7 +898,7 @@ type PlayerHurtEvent struct {
 
 func (x *PlayerHurtEvent) Reset() {
 	*x = PlayerHurtEvent{}
-	mi := &file_player_events_proto_msgTypes[11]
+	mi := &file_player_events_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -755,7 +910,7 @@ func (x *PlayerHurtEvent) String() string {
 func (*PlayerHurtEvent) ProtoMessage() {}
 
 func (x *PlayerHurtEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[11]
+	mi := &file_player_events_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,7 +923,7 @@ func (x *PlayerHurtEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerHurtEvent.ProtoReflect.Descriptor instead.
 func (*PlayerHurtEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{11}
+	return file_player_events_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PlayerHurtEvent) GetPlayerUuid() string {
@@ -825,7 +980,7 @@ type PlayerDeathEvent struct {
 
 func (x *PlayerDeathEvent) Reset() {
 	*x = PlayerDeathEvent{}
-	mi := &file_player_events_proto_msgTypes[12]
+	mi := &file_player_events_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +992,7 @@ func (x *PlayerDeathEvent) String() string {
 func (*PlayerDeathEvent) ProtoMessage() {}
 
 func (x *PlayerDeathEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[12]
+	mi := &file_player_events_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1005,7 @@ func (x *PlayerDeathEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerDeathEvent.ProtoReflect.Descriptor instead.
 func (*PlayerDeathEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{12}
+	return file_player_events_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PlayerDeathEvent) GetPlayerUuid() string {
@@ -893,7 +1048,7 @@ type PlayerRespawnEvent struct {
 
 func (x *PlayerRespawnEvent) Reset() {
 	*x = PlayerRespawnEvent{}
-	mi := &file_player_events_proto_msgTypes[13]
+	mi := &file_player_events_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -905,7 +1060,7 @@ func (x *PlayerRespawnEvent) String() string {
 func (*PlayerRespawnEvent) ProtoMessage() {}
 
 func (x *PlayerRespawnEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[13]
+	mi := &file_player_events_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1073,7 @@ func (x *PlayerRespawnEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerRespawnEvent.ProtoReflect.Descriptor instead.
 func (*PlayerRespawnEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{13}
+	return file_player_events_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PlayerRespawnEvent) GetPlayerUuid() string {
@@ -962,7 +1117,7 @@ type PlayerSkinChangeEvent struct {
 
 func (x *PlayerSkinChangeEvent) Reset() {
 	*x = PlayerSkinChangeEvent{}
-	mi := &file_player_events_proto_msgTypes[14]
+	mi := &file_player_events_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -974,7 +1129,7 @@ func (x *PlayerSkinChangeEvent) String() string {
 func (*PlayerSkinChangeEvent) ProtoMessage() {}
 
 func (x *PlayerSkinChangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[14]
+	mi := &file_player_events_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -987,7 +1142,7 @@ func (x *PlayerSkinChangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerSkinChangeEvent.ProtoReflect.Descriptor instead.
 func (*PlayerSkinChangeEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{14}
+	return file_player_events_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PlayerSkinChangeEvent) GetPlayerUuid() string {
@@ -1037,7 +1192,7 @@ type PlayerFireExtinguishEvent struct {
 
 func (x *PlayerFireExtinguishEvent) Reset() {
 	*x = PlayerFireExtinguishEvent{}
-	mi := &file_player_events_proto_msgTypes[15]
+	mi := &file_player_events_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1204,7 @@ func (x *PlayerFireExtinguishEvent) String() string {
 func (*PlayerFireExtinguishEvent) ProtoMessage() {}
 
 func (x *PlayerFireExtinguishEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[15]
+	mi := &file_player_events_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1217,7 @@ func (x *PlayerFireExtinguishEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerFireExtinguishEvent.ProtoReflect.Descriptor instead.
 func (*PlayerFireExtinguishEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{15}
+	return file_player_events_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PlayerFireExtinguishEvent) GetPlayerUuid() string {
@@ -1105,7 +1260,7 @@ type PlayerStartBreakEvent struct {
 
 func (x *PlayerStartBreakEvent) Reset() {
 	*x = PlayerStartBreakEvent{}
-	mi := &file_player_events_proto_msgTypes[16]
+	mi := &file_player_events_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1117,7 +1272,7 @@ func (x *PlayerStartBreakEvent) String() string {
 func (*PlayerStartBreakEvent) ProtoMessage() {}
 
 func (x *PlayerStartBreakEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[16]
+	mi := &file_player_events_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +1285,7 @@ func (x *PlayerStartBreakEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerStartBreakEvent.ProtoReflect.Descriptor instead.
 func (*PlayerStartBreakEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{16}
+	return file_player_events_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PlayerStartBreakEvent) GetPlayerUuid() string {
@@ -1167,13 +1322,14 @@ type BlockBreakEvent struct {
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	World         string                 `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
 	Position      *BlockPos              `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Block         *BlockState            `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"` // the block being broken
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BlockBreakEvent) Reset() {
 	*x = BlockBreakEvent{}
-	mi := &file_player_events_proto_msgTypes[17]
+	mi := &file_player_events_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1185,7 +1341,7 @@ func (x *BlockBreakEvent) String() string {
 func (*BlockBreakEvent) ProtoMessage() {}
 
 func (x *BlockBreakEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[17]
+	mi := &file_player_events_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,7 +1354,7 @@ func (x *BlockBreakEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockBreakEvent.ProtoReflect.Descriptor instead.
 func (*BlockBreakEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{17}
+	return file_player_events_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BlockBreakEvent) GetPlayerUuid() string {
@@ -1229,6 +1385,13 @@ func (x *BlockBreakEvent) GetPosition() *BlockPos {
 	return nil
 }
 
+func (x *BlockBreakEvent) GetBlock() *BlockState {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
 type PlayerBlockPlaceEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
@@ -1242,7 +1405,7 @@ type PlayerBlockPlaceEvent struct {
 
 func (x *PlayerBlockPlaceEvent) Reset() {
 	*x = PlayerBlockPlaceEvent{}
-	mi := &file_player_events_proto_msgTypes[18]
+	mi := &file_player_events_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1254,7 +1417,7 @@ func (x *PlayerBlockPlaceEvent) String() string {
 func (*PlayerBlockPlaceEvent) ProtoMessage() {}
 
 func (x *PlayerBlockPlaceEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[18]
+	mi := &file_player_events_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +1430,7 @@ func (x *PlayerBlockPlaceEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerBlockPlaceEvent.ProtoReflect.Descriptor instead.
 func (*PlayerBlockPlaceEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{18}
+	return file_player_events_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PlayerBlockPlaceEvent) GetPlayerUuid() string {
@@ -1318,7 +1481,7 @@ type PlayerBlockPickEvent struct {
 
 func (x *PlayerBlockPickEvent) Reset() {
 	*x = PlayerBlockPickEvent{}
-	mi := &file_player_events_proto_msgTypes[19]
+	mi := &file_player_events_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1330,7 +1493,7 @@ func (x *PlayerBlockPickEvent) String() string {
 func (*PlayerBlockPickEvent) ProtoMessage() {}
 
 func (x *PlayerBlockPickEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[19]
+	mi := &file_player_events_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1506,7 @@ func (x *PlayerBlockPickEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerBlockPickEvent.ProtoReflect.Descriptor instead.
 func (*PlayerBlockPickEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{19}
+	return file_player_events_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PlayerBlockPickEvent) GetPlayerUuid() string {
@@ -1393,7 +1556,7 @@ type PlayerItemUseEvent struct {
 
 func (x *PlayerItemUseEvent) Reset() {
 	*x = PlayerItemUseEvent{}
-	mi := &file_player_events_proto_msgTypes[20]
+	mi := &file_player_events_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1405,7 +1568,7 @@ func (x *PlayerItemUseEvent) String() string {
 func (*PlayerItemUseEvent) ProtoMessage() {}
 
 func (x *PlayerItemUseEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[20]
+	mi := &file_player_events_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +1581,7 @@ func (x *PlayerItemUseEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerItemUseEvent.ProtoReflect.Descriptor instead.
 func (*PlayerItemUseEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{20}
+	return file_player_events_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *PlayerItemUseEvent) GetPlayerUuid() string {
@@ -1465,7 +1628,7 @@ type PlayerItemUseOnBlockEvent struct {
 
 func (x *PlayerItemUseOnBlockEvent) Reset() {
 	*x = PlayerItemUseOnBlockEvent{}
-	mi := &file_player_events_proto_msgTypes[21]
+	mi := &file_player_events_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1477,7 +1640,7 @@ func (x *PlayerItemUseOnBlockEvent) String() string {
 func (*PlayerItemUseOnBlockEvent) ProtoMessage() {}
 
 func (x *PlayerItemUseOnBlockEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[21]
+	mi := &file_player_events_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +1653,7 @@ func (x *PlayerItemUseOnBlockEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerItemUseOnBlockEvent.ProtoReflect.Descriptor instead.
 func (*PlayerItemUseOnBlockEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{21}
+	return file_player_events_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PlayerItemUseOnBlockEvent) GetPlayerUuid() string {
@@ -1562,7 +1725,7 @@ type PlayerItemUseOnEntityEvent struct {
 
 func (x *PlayerItemUseOnEntityEvent) Reset() {
 	*x = PlayerItemUseOnEntityEvent{}
-	mi := &file_player_events_proto_msgTypes[22]
+	mi := &file_player_events_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1574,7 +1737,7 @@ func (x *PlayerItemUseOnEntityEvent) String() string {
 func (*PlayerItemUseOnEntityEvent) ProtoMessage() {}
 
 func (x *PlayerItemUseOnEntityEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[22]
+	mi := &file_player_events_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1587,7 +1750,7 @@ func (x *PlayerItemUseOnEntityEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerItemUseOnEntityEvent.ProtoReflect.Descriptor instead.
 func (*PlayerItemUseOnEntityEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{22}
+	return file_player_events_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *PlayerItemUseOnEntityEvent) GetPlayerUuid() string {
@@ -1638,7 +1801,7 @@ type PlayerItemReleaseEvent struct {
 
 func (x *PlayerItemReleaseEvent) Reset() {
 	*x = PlayerItemReleaseEvent{}
-	mi := &file_player_events_proto_msgTypes[23]
+	mi := &file_player_events_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1650,7 +1813,7 @@ func (x *PlayerItemReleaseEvent) String() string {
 func (*PlayerItemReleaseEvent) ProtoMessage() {}
 
 func (x *PlayerItemReleaseEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[23]
+	mi := &file_player_events_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +1826,7 @@ func (x *PlayerItemReleaseEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerItemReleaseEvent.ProtoReflect.Descriptor instead.
 func (*PlayerItemReleaseEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{23}
+	return file_player_events_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *PlayerItemReleaseEvent) GetPlayerUuid() string {
@@ -1713,7 +1876,7 @@ type PlayerItemConsumeEvent struct {
 
 func (x *PlayerItemConsumeEvent) Reset() {
 	*x = PlayerItemConsumeEvent{}
-	mi := &file_player_events_proto_msgTypes[24]
+	mi := &file_player_events_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1725,7 +1888,7 @@ func (x *PlayerItemConsumeEvent) String() string {
 func (*PlayerItemConsumeEvent) ProtoMessage() {}
 
 func (x *PlayerItemConsumeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[24]
+	mi := &file_player_events_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,7 +1901,7 @@ func (x *PlayerItemConsumeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerItemConsumeEvent.ProtoReflect.Descriptor instead.
 func (*PlayerItemConsumeEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{24}
+	return file_player_events_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *PlayerItemConsumeEvent) GetPlayerUuid() string {
@@ -1785,7 +1948,7 @@ type PlayerAttackEntityEvent struct {
 
 func (x *PlayerAttackEntityEvent) Reset() {
 	*x = PlayerAttackEntityEvent{}
-	mi := &file_player_events_proto_msgTypes[25]
+	mi := &file_player_events_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1797,7 +1960,7 @@ func (x *PlayerAttackEntityEvent) String() string {
 func (*PlayerAttackEntityEvent) ProtoMessage() {}
 
 func (x *PlayerAttackEntityEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[25]
+	mi := &file_player_events_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,7 +1973,7 @@ func (x *PlayerAttackEntityEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerAttackEntityEvent.ProtoReflect.Descriptor instead.
 func (*PlayerAttackEntityEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{25}
+	return file_player_events_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *PlayerAttackEntityEvent) GetPlayerUuid() string {
@@ -1881,7 +2044,7 @@ type PlayerExperienceGainEvent struct {
 
 func (x *PlayerExperienceGainEvent) Reset() {
 	*x = PlayerExperienceGainEvent{}
-	mi := &file_player_events_proto_msgTypes[26]
+	mi := &file_player_events_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1893,7 +2056,7 @@ func (x *PlayerExperienceGainEvent) String() string {
 func (*PlayerExperienceGainEvent) ProtoMessage() {}
 
 func (x *PlayerExperienceGainEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[26]
+	mi := &file_player_events_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,7 +2069,7 @@ func (x *PlayerExperienceGainEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerExperienceGainEvent.ProtoReflect.Descriptor instead.
 func (*PlayerExperienceGainEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{26}
+	return file_player_events_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *PlayerExperienceGainEvent) GetPlayerUuid() string {
@@ -1948,7 +2111,7 @@ type PlayerPunchAirEvent struct {
 
 func (x *PlayerPunchAirEvent) Reset() {
 	*x = PlayerPunchAirEvent{}
-	mi := &file_player_events_proto_msgTypes[27]
+	mi := &file_player_events_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1960,7 +2123,7 @@ func (x *PlayerPunchAirEvent) String() string {
 func (*PlayerPunchAirEvent) ProtoMessage() {}
 
 func (x *PlayerPunchAirEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[27]
+	mi := &file_player_events_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +2136,7 @@ func (x *PlayerPunchAirEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerPunchAirEvent.ProtoReflect.Descriptor instead.
 func (*PlayerPunchAirEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{27}
+	return file_player_events_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PlayerPunchAirEvent) GetPlayerUuid() string {
@@ -2012,7 +2175,7 @@ type PlayerSignEditEvent struct {
 
 func (x *PlayerSignEditEvent) Reset() {
 	*x = PlayerSignEditEvent{}
-	mi := &file_player_events_proto_msgTypes[28]
+	mi := &file_player_events_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2024,7 +2187,7 @@ func (x *PlayerSignEditEvent) String() string {
 func (*PlayerSignEditEvent) ProtoMessage() {}
 
 func (x *PlayerSignEditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[28]
+	mi := &file_player_events_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2037,7 +2200,7 @@ func (x *PlayerSignEditEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerSignEditEvent.ProtoReflect.Descriptor instead.
 func (*PlayerSignEditEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{28}
+	return file_player_events_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *PlayerSignEditEvent) GetPlayerUuid() string {
@@ -2103,7 +2266,7 @@ type PlayerLecternPageTurnEvent struct {
 
 func (x *PlayerLecternPageTurnEvent) Reset() {
 	*x = PlayerLecternPageTurnEvent{}
-	mi := &file_player_events_proto_msgTypes[29]
+	mi := &file_player_events_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2115,7 +2278,7 @@ func (x *PlayerLecternPageTurnEvent) String() string {
 func (*PlayerLecternPageTurnEvent) ProtoMessage() {}
 
 func (x *PlayerLecternPageTurnEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[29]
+	mi := &file_player_events_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,7 +2291,7 @@ func (x *PlayerLecternPageTurnEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerLecternPageTurnEvent.ProtoReflect.Descriptor instead.
 func (*PlayerLecternPageTurnEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{29}
+	return file_player_events_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *PlayerLecternPageTurnEvent) GetPlayerUuid() string {
@@ -2186,7 +2349,7 @@ type PlayerItemDamageEvent struct {
 
 func (x *PlayerItemDamageEvent) Reset() {
 	*x = PlayerItemDamageEvent{}
-	mi := &file_player_events_proto_msgTypes[30]
+	mi := &file_player_events_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2198,7 +2361,7 @@ func (x *PlayerItemDamageEvent) String() string {
 func (*PlayerItemDamageEvent) ProtoMessage() {}
 
 func (x *PlayerItemDamageEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[30]
+	mi := &file_player_events_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2211,7 +2374,7 @@ func (x *PlayerItemDamageEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerItemDamageEvent.ProtoReflect.Descriptor instead.
 func (*PlayerItemDamageEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{30}
+	return file_player_events_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PlayerItemDamageEvent) GetPlayerUuid() string {
@@ -2261,7 +2424,7 @@ type PlayerItemPickupEvent struct {
 
 func (x *PlayerItemPickupEvent) Reset() {
 	*x = PlayerItemPickupEvent{}
-	mi := &file_player_events_proto_msgTypes[31]
+	mi := &file_player_events_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2273,7 +2436,7 @@ func (x *PlayerItemPickupEvent) String() string {
 func (*PlayerItemPickupEvent) ProtoMessage() {}
 
 func (x *PlayerItemPickupEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[31]
+	mi := &file_player_events_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,7 +2449,7 @@ func (x *PlayerItemPickupEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerItemPickupEvent.ProtoReflect.Descriptor instead.
 func (*PlayerItemPickupEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{31}
+	return file_player_events_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PlayerItemPickupEvent) GetPlayerUuid() string {
@@ -2303,46 +2466,731 @@ func (x *PlayerItemPickupEvent) GetName() string {
 	return ""
 }
 
-func (x *PlayerItemPickupEvent) GetWorld() string {
-	if x != nil {
-		return x.World
+func (x *PlayerItemPickupEvent) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *PlayerItemPickupEvent) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PlayerHeldSlotChangeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	World         string                 `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
+	FromSlot      int32                  `protobuf:"varint,4,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
+	ToSlot        int32                  `protobuf:"varint,5,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerHeldSlotChangeEvent) Reset() {
+	*x = PlayerHeldSlotChangeEvent{}
+	mi := &file_player_events_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerHeldSlotChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerHeldSlotChangeEvent) ProtoMessage() {}
+
+func (x *PlayerHeldSlotChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerHeldSlotChangeEvent.ProtoReflect.Descriptor instead.
+func (*PlayerHeldSlotChangeEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PlayerHeldSlotChangeEvent) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerHeldSlotChangeEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerHeldSlotChangeEvent) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *PlayerHeldSlotChangeEvent) GetFromSlot() int32 {
+	if x != nil {
+		return x.FromSlot
+	}
+	return 0
+}
+
+func (x *PlayerHeldSlotChangeEvent) GetToSlot() int32 {
+	if x != nil {
+		return x.ToSlot
+	}
+	return 0
+}
+
+type PlayerItemDropEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	World         string                 `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
+	Item          *ItemStack             `protobuf:"bytes,4,opt,name=item,proto3,oneof" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerItemDropEvent) Reset() {
+	*x = PlayerItemDropEvent{}
+	mi := &file_player_events_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerItemDropEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerItemDropEvent) ProtoMessage() {}
+
+func (x *PlayerItemDropEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerItemDropEvent.ProtoReflect.Descriptor instead.
+func (*PlayerItemDropEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PlayerItemDropEvent) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerItemDropEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerItemDropEvent) GetWorld() string {
+	if x != nil {
+		return x.World
+	}
+	return ""
+}
+
+func (x *PlayerItemDropEvent) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type PlayerTransferEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerTransferEvent) Reset() {
+	*x = PlayerTransferEvent{}
+	mi := &file_player_events_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerTransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerTransferEvent) ProtoMessage() {}
+
+func (x *PlayerTransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerTransferEvent.ProtoReflect.Descriptor instead.
+func (*PlayerTransferEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PlayerTransferEvent) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerTransferEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerTransferEvent) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type PlayerDiagnosticsEvent struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid                    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name                          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AverageFramesPerSecond        float64                `protobuf:"fixed64,3,opt,name=average_frames_per_second,json=averageFramesPerSecond,proto3" json:"average_frames_per_second,omitempty"`
+	AverageServerSimTickTime      float64                `protobuf:"fixed64,4,opt,name=average_server_sim_tick_time,json=averageServerSimTickTime,proto3" json:"average_server_sim_tick_time,omitempty"`
+	AverageClientSimTickTime      float64                `protobuf:"fixed64,5,opt,name=average_client_sim_tick_time,json=averageClientSimTickTime,proto3" json:"average_client_sim_tick_time,omitempty"`
+	AverageBeginFrameTime         float64                `protobuf:"fixed64,6,opt,name=average_begin_frame_time,json=averageBeginFrameTime,proto3" json:"average_begin_frame_time,omitempty"`
+	AverageInputTime              float64                `protobuf:"fixed64,7,opt,name=average_input_time,json=averageInputTime,proto3" json:"average_input_time,omitempty"`
+	AverageRenderTime             float64                `protobuf:"fixed64,8,opt,name=average_render_time,json=averageRenderTime,proto3" json:"average_render_time,omitempty"`
+	AverageEndFrameTime           float64                `protobuf:"fixed64,9,opt,name=average_end_frame_time,json=averageEndFrameTime,proto3" json:"average_end_frame_time,omitempty"`
+	AverageRemainderTimePercent   float64                `protobuf:"fixed64,10,opt,name=average_remainder_time_percent,json=averageRemainderTimePercent,proto3" json:"average_remainder_time_percent,omitempty"`
+	AverageUnaccountedTimePercent float64                `protobuf:"fixed64,11,opt,name=average_unaccounted_time_percent,json=averageUnaccountedTimePercent,proto3" json:"average_unaccounted_time_percent,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *PlayerDiagnosticsEvent) Reset() {
+	*x = PlayerDiagnosticsEvent{}
+	mi := &file_player_events_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDiagnosticsEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDiagnosticsEvent) ProtoMessage() {}
+
+func (x *PlayerDiagnosticsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDiagnosticsEvent.ProtoReflect.Descriptor instead.
+func (*PlayerDiagnosticsEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PlayerDiagnosticsEvent) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerDiagnosticsEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageFramesPerSecond() float64 {
+	if x != nil {
+		return x.AverageFramesPerSecond
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageServerSimTickTime() float64 {
+	if x != nil {
+		return x.AverageServerSimTickTime
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageClientSimTickTime() float64 {
+	if x != nil {
+		return x.AverageClientSimTickTime
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageBeginFrameTime() float64 {
+	if x != nil {
+		return x.AverageBeginFrameTime
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageInputTime() float64 {
+	if x != nil {
+		return x.AverageInputTime
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageRenderTime() float64 {
+	if x != nil {
+		return x.AverageRenderTime
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageEndFrameTime() float64 {
+	if x != nil {
+		return x.AverageEndFrameTime
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageRemainderTimePercent() float64 {
+	if x != nil {
+		return x.AverageRemainderTimePercent
+	}
+	return 0
+}
+
+func (x *PlayerDiagnosticsEvent) GetAverageUnaccountedTimePercent() float64 {
+	if x != nil {
+		return x.AverageUnaccountedTimePercent
+	}
+	return 0
+}
+
+// PlayerFormResponseEvent is sent only to the plugin that opened the form.
+type PlayerFormResponseEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PluginId      string                 `protobuf:"bytes,3,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	FormId        string                 `protobuf:"bytes,4,opt,name=form_id,json=formId,proto3" json:"form_id,omitempty"`
+	Closed        bool                   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`                                    // true when the player closed the form without pressing a button
+	ButtonIndex   *int32                 `protobuf:"varint,6,opt,name=button_index,json=buttonIndex,proto3,oneof" json:"button_index,omitempty"` // modal forms: 0 = yes, 1 = no
+	ButtonText    *string                `protobuf:"bytes,7,opt,name=button_text,json=buttonText,proto3,oneof" json:"button_text,omitempty"`
+	Values        []*FormValue           `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty"`                           // custom forms: one entry per element, labels excluded
+	ButtonId      *string                `protobuf:"bytes,9,opt,name=button_id,json=buttonId,proto3,oneof" json:"button_id,omitempty"` // menu forms: FormButton.id of the pressed button
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerFormResponseEvent) Reset() {
+	*x = PlayerFormResponseEvent{}
+	mi := &file_player_events_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerFormResponseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerFormResponseEvent) ProtoMessage() {}
+
+func (x *PlayerFormResponseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerFormResponseEvent.ProtoReflect.Descriptor instead.
+func (*PlayerFormResponseEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PlayerFormResponseEvent) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerFormResponseEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerFormResponseEvent) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *PlayerFormResponseEvent) GetFormId() string {
+	if x != nil {
+		return x.FormId
+	}
+	return ""
+}
+
+func (x *PlayerFormResponseEvent) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *PlayerFormResponseEvent) GetButtonIndex() int32 {
+	if x != nil && x.ButtonIndex != nil {
+		return *x.ButtonIndex
+	}
+	return 0
+}
+
+func (x *PlayerFormResponseEvent) GetButtonText() string {
+	if x != nil && x.ButtonText != nil {
+		return *x.ButtonText
+	}
+	return ""
+}
+
+func (x *PlayerFormResponseEvent) GetValues() []*FormValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PlayerFormResponseEvent) GetButtonId() string {
+	if x != nil && x.ButtonId != nil {
+		return *x.ButtonId
+	}
+	return ""
+}
+
+// FormValue is a value submitted for a custom form element.
+type FormValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Index int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // position of the element in PlayerSendCustomFormAction.elements
+	// Types that are valid to be assigned to Value:
+	//
+	//	*FormValue_Input
+	//	*FormValue_Toggle
+	//	*FormValue_Slider
+	//	*FormValue_Dropdown
+	//	*FormValue_StepSlider
+	Value         isFormValue_Value `protobuf_oneof:"value"`
+	Option        *string           `protobuf:"bytes,7,opt,name=option,proto3,oneof" json:"option,omitempty"` // selected option text for dropdowns and step sliders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FormValue) Reset() {
+	*x = FormValue{}
+	mi := &file_player_events_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FormValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormValue) ProtoMessage() {}
+
+func (x *FormValue) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormValue.ProtoReflect.Descriptor instead.
+func (*FormValue) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FormValue) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *FormValue) GetValue() isFormValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FormValue) GetInput() string {
+	if x != nil {
+		if x, ok := x.Value.(*FormValue_Input); ok {
+			return x.Input
+		}
+	}
+	return ""
+}
+
+func (x *FormValue) GetToggle() bool {
+	if x != nil {
+		if x, ok := x.Value.(*FormValue_Toggle); ok {
+			return x.Toggle
+		}
+	}
+	return false
+}
+
+func (x *FormValue) GetSlider() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*FormValue_Slider); ok {
+			return x.Slider
+		}
+	}
+	return 0
+}
+
+func (x *FormValue) GetDropdown() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*FormValue_Dropdown); ok {
+			return x.Dropdown
+		}
+	}
+	return 0
+}
+
+func (x *FormValue) GetStepSlider() int32 {
+	if x != nil {
+		if x, ok := x.Value.(*FormValue_StepSlider); ok {
+			return x.StepSlider
+		}
+	}
+	return 0
+}
+
+func (x *FormValue) GetOption() string {
+	if x != nil && x.Option != nil {
+		return *x.Option
+	}
+	return ""
+}
+
+type isFormValue_Value interface {
+	isFormValue_Value()
+}
+
+type FormValue_Input struct {
+	Input string `protobuf:"bytes,2,opt,name=input,proto3,oneof"`
+}
+
+type FormValue_Toggle struct {
+	Toggle bool `protobuf:"varint,3,opt,name=toggle,proto3,oneof"`
+}
+
+type FormValue_Slider struct {
+	Slider float64 `protobuf:"fixed64,4,opt,name=slider,proto3,oneof"`
+}
+
+type FormValue_Dropdown struct {
+	Dropdown int32 `protobuf:"varint,5,opt,name=dropdown,proto3,oneof"` // selected option index
+}
+
+type FormValue_StepSlider struct {
+	StepSlider int32 `protobuf:"varint,6,opt,name=step_slider,json=stepSlider,proto3,oneof"` // selected option index
+}
+
+func (*FormValue_Input) isFormValue_Value() {}
+
+func (*FormValue_Toggle) isFormValue_Value() {}
+
+func (*FormValue_Slider) isFormValue_Value() {}
+
+func (*FormValue_Dropdown) isFormValue_Value() {}
+
+func (*FormValue_StepSlider) isFormValue_Value() {}
+
+// PlayerDialogueResponseEvent is sent only to the plugin that opened the dialogue.
+type PlayerDialogueResponseEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PluginId      string                 `protobuf:"bytes,3,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	FormId        string                 `protobuf:"bytes,4,opt,name=form_id,json=formId,proto3" json:"form_id,omitempty"`
+	Closed        bool                   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"` // true when the player closed the dialogue without pressing a button
+	ButtonIndex   *int32                 `protobuf:"varint,6,opt,name=button_index,json=buttonIndex,proto3,oneof" json:"button_index,omitempty"`
+	ButtonText    *string                `protobuf:"bytes,7,opt,name=button_text,json=buttonText,proto3,oneof" json:"button_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerDialogueResponseEvent) Reset() {
+	*x = PlayerDialogueResponseEvent{}
+	mi := &file_player_events_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDialogueResponseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDialogueResponseEvent) ProtoMessage() {}
+
+func (x *PlayerDialogueResponseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDialogueResponseEvent.ProtoReflect.Descriptor instead.
+func (*PlayerDialogueResponseEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PlayerDialogueResponseEvent) GetPlayerUuid() string {
+	if x != nil {
+		return x.PlayerUuid
+	}
+	return ""
+}
+
+func (x *PlayerDialogueResponseEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerDialogueResponseEvent) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *PlayerDialogueResponseEvent) GetFormId() string {
+	if x != nil {
+		return x.FormId
+	}
+	return ""
+}
+
+func (x *PlayerDialogueResponseEvent) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+func (x *PlayerDialogueResponseEvent) GetButtonIndex() int32 {
+	if x != nil && x.ButtonIndex != nil {
+		return *x.ButtonIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *PlayerItemPickupEvent) GetItem() *ItemStack {
-	if x != nil {
-		return x.Item
+func (x *PlayerDialogueResponseEvent) GetButtonText() string {
+	if x != nil && x.ButtonText != nil {
+		return *x.ButtonText
 	}
-	return nil
+	return ""
 }
 
-type PlayerHeldSlotChangeEvent struct {
+// PlayerInventorySlotChangeEvent is sent before a player takes items from, places items in or drops items out of a
+// slot of one of its inventories or of an open container. Cancelling it rejects the whole inventory transaction.
+type PlayerInventorySlotChangeEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	World         string                 `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
-	FromSlot      int32                  `protobuf:"varint,4,opt,name=from_slot,json=fromSlot,proto3" json:"from_slot,omitempty"`
-	ToSlot        int32                  `protobuf:"varint,5,opt,name=to_slot,json=toSlot,proto3" json:"to_slot,omitempty"`
+	Inventory     InventoryType          `protobuf:"varint,4,opt,name=inventory,proto3,enum=df.plugin.InventoryType" json:"inventory,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,5,opt,name=position,proto3,oneof" json:"position,omitempty"` // container position, set for INVENTORY_TYPE_CONTAINER
+	Block         *BlockState            `protobuf:"bytes,6,opt,name=block,proto3,oneof" json:"block,omitempty"`       // container block, set for INVENTORY_TYPE_CONTAINER
+	Slot          int32                  `protobuf:"varint,7,opt,name=slot,proto3" json:"slot,omitempty"`
+	Action        InventorySlotAction    `protobuf:"varint,8,opt,name=action,proto3,enum=df.plugin.InventorySlotAction" json:"action,omitempty"`
+	Item          *ItemStack             `protobuf:"bytes,9,opt,name=item,proto3,oneof" json:"item,omitempty"` // the items taken, placed or dropped
+	Before        *ItemStack             `protobuf:"bytes,10,opt,name=before,proto3,oneof" json:"before,omitempty"`
+	After         *ItemStack             `protobuf:"bytes,11,opt,name=after,proto3,oneof" json:"after,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerHeldSlotChangeEvent) Reset() {
-	*x = PlayerHeldSlotChangeEvent{}
-	mi := &file_player_events_proto_msgTypes[32]
+func (x *PlayerInventorySlotChangeEvent) Reset() {
+	*x = PlayerInventorySlotChangeEvent{}
+	mi := &file_player_events_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerHeldSlotChangeEvent) String() string {
+func (x *PlayerInventorySlotChangeEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerHeldSlotChangeEvent) ProtoMessage() {}
+func (*PlayerInventorySlotChangeEvent) ProtoMessage() {}
 
-func (x *PlayerHeldSlotChangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[32]
+func (x *PlayerInventorySlotChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,71 +3201,116 @@ func (x *PlayerHeldSlotChangeEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerHeldSlotChangeEvent.ProtoReflect.Descriptor instead.
-func (*PlayerHeldSlotChangeEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use PlayerInventorySlotChangeEvent.ProtoReflect.Descriptor instead.
+func (*PlayerInventorySlotChangeEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *PlayerHeldSlotChangeEvent) GetPlayerUuid() string {
+func (x *PlayerInventorySlotChangeEvent) GetPlayerUuid() string {
 	if x != nil {
 		return x.PlayerUuid
 	}
 	return ""
 }
 
-func (x *PlayerHeldSlotChangeEvent) GetName() string {
+func (x *PlayerInventorySlotChangeEvent) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PlayerHeldSlotChangeEvent) GetWorld() string {
+func (x *PlayerInventorySlotChangeEvent) GetWorld() string {
 	if x != nil {
 		return x.World
 	}
 	return ""
 }
 
-func (x *PlayerHeldSlotChangeEvent) GetFromSlot() int32 {
+func (x *PlayerInventorySlotChangeEvent) GetInventory() InventoryType {
 	if x != nil {
-		return x.FromSlot
+		return x.Inventory
 	}
-	return 0
+	return InventoryType_INVENTORY_TYPE_PLAYER
 }
 
-func (x *PlayerHeldSlotChangeEvent) GetToSlot() int32 {
+func (x *PlayerInventorySlotChangeEvent) GetPosition() *BlockPos {
 	if x != nil {
-		return x.ToSlot
+		return x.Position
+	}
+	return nil
+}
+
+func (x *PlayerInventorySlotChangeEvent) GetBlock() *BlockState {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *PlayerInventorySlotChangeEvent) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
 	}
 	return 0
 }
 
-type PlayerItemDropEvent struct {
+func (x *PlayerInventorySlotChangeEvent) GetAction() InventorySlotAction {
+	if x != nil {
+		return x.Action
+	}
+	return InventorySlotAction_INVENTORY_SLOT_ACTION_TAKE
+}
+
+func (x *PlayerInventorySlotChangeEvent) GetItem() *ItemStack {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *PlayerInventorySlotChangeEvent) GetBefore() *ItemStack {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PlayerInventorySlotChangeEvent) GetAfter() *ItemStack {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// ContainerOpenEvent is sent when a player interacts with a container block to open it. Cancelling it keeps the
+// container closed.
+type ContainerOpenEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	World         string                 `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
-	Item          *ItemStack             `protobuf:"bytes,4,opt,name=item,proto3,oneof" json:"item,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Block         *BlockState            `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerItemDropEvent) Reset() {
-	*x = PlayerItemDropEvent{}
-	mi := &file_player_events_proto_msgTypes[33]
+func (x *ContainerOpenEvent) Reset() {
+	*x = ContainerOpenEvent{}
+	mi := &file_player_events_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerItemDropEvent) String() string {
+func (x *ContainerOpenEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerItemDropEvent) ProtoMessage() {}
+func (*ContainerOpenEvent) ProtoMessage() {}
 
-func (x *PlayerItemDropEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[33]
+func (x *ContainerOpenEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2428,63 +3321,75 @@ func (x *PlayerItemDropEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerItemDropEvent.ProtoReflect.Descriptor instead.
-func (*PlayerItemDropEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ContainerOpenEvent.ProtoReflect.Descriptor instead.
+func (*ContainerOpenEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *PlayerItemDropEvent) GetPlayerUuid() string {
+func (x *ContainerOpenEvent) GetPlayerUuid() string {
 	if x != nil {
 		return x.PlayerUuid
 	}
 	return ""
 }
 
-func (x *PlayerItemDropEvent) GetName() string {
+func (x *ContainerOpenEvent) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PlayerItemDropEvent) GetWorld() string {
+func (x *ContainerOpenEvent) GetWorld() string {
 	if x != nil {
 		return x.World
 	}
 	return ""
 }
 
-func (x *PlayerItemDropEvent) GetItem() *ItemStack {
+func (x *ContainerOpenEvent) GetPosition() *BlockPos {
 	if x != nil {
-		return x.Item
+		return x.Position
 	}
 	return nil
 }
 
-type PlayerTransferEvent struct {
+func (x *ContainerOpenEvent) GetBlock() *BlockState {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+// ContainerCloseEvent is sent after a player closed a container. Cancelling it opens the container again; closes
+// initiated by the server, such as when another container is opened, cannot be cancelled.
+type ContainerCloseEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	World         string                 `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
+	Position      *BlockPos              `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	Block         *BlockState            `protobuf:"bytes,5,opt,name=block,proto3" json:"block,omitempty"`
+	ServerSide    bool                   `protobuf:"varint,6,opt,name=server_side,json=serverSide,proto3" json:"server_side,omitempty"` // true if the server closed the container
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlayerTransferEvent) Reset() {
-	*x = PlayerTransferEvent{}
-	mi := &file_player_events_proto_msgTypes[34]
+func (x *ContainerCloseEvent) Reset() {
+	*x = ContainerCloseEvent{}
+	mi := &file_player_events_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerTransferEvent) String() string {
+func (x *ContainerCloseEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerTransferEvent) ProtoMessage() {}
+func (*ContainerCloseEvent) ProtoMessage() {}
 
-func (x *PlayerTransferEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[34]
+func (x *ContainerCloseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2495,64 +3400,86 @@ func (x *PlayerTransferEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerTransferEvent.ProtoReflect.Descriptor instead.
-func (*PlayerTransferEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ContainerCloseEvent.ProtoReflect.Descriptor instead.
+func (*ContainerCloseEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *PlayerTransferEvent) GetPlayerUuid() string {
+func (x *ContainerCloseEvent) GetPlayerUuid() string {
 	if x != nil {
 		return x.PlayerUuid
 	}
 	return ""
 }
 
-func (x *PlayerTransferEvent) GetName() string {
+func (x *ContainerCloseEvent) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PlayerTransferEvent) GetAddress() *Address {
+func (x *ContainerCloseEvent) GetWorld() string {
 	if x != nil {
-		return x.Address
+		return x.World
+	}
+	return ""
+}
+
+func (x *ContainerCloseEvent) GetPosition() *BlockPos {
+	if x != nil {
+		return x.Position
 	}
 	return nil
 }
 
-type PlayerDiagnosticsEvent struct {
-	state                         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerUuid                    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
-	Name                          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	AverageFramesPerSecond        float64                `protobuf:"fixed64,3,opt,name=average_frames_per_second,json=averageFramesPerSecond,proto3" json:"average_frames_per_second,omitempty"`
-	AverageServerSimTickTime      float64                `protobuf:"fixed64,4,opt,name=average_server_sim_tick_time,json=averageServerSimTickTime,proto3" json:"average_server_sim_tick_time,omitempty"`
-	AverageClientSimTickTime      float64                `protobuf:"fixed64,5,opt,name=average_client_sim_tick_time,json=averageClientSimTickTime,proto3" json:"average_client_sim_tick_time,omitempty"`
-	AverageBeginFrameTime         float64                `protobuf:"fixed64,6,opt,name=average_begin_frame_time,json=averageBeginFrameTime,proto3" json:"average_begin_frame_time,omitempty"`
-	AverageInputTime              float64                `protobuf:"fixed64,7,opt,name=average_input_time,json=averageInputTime,proto3" json:"average_input_time,omitempty"`
-	AverageRenderTime             float64                `protobuf:"fixed64,8,opt,name=average_render_time,json=averageRenderTime,proto3" json:"average_render_time,omitempty"`
-	AverageEndFrameTime           float64                `protobuf:"fixed64,9,opt,name=average_end_frame_time,json=averageEndFrameTime,proto3" json:"average_end_frame_time,omitempty"`
-	AverageRemainderTimePercent   float64                `protobuf:"fixed64,10,opt,name=average_remainder_time_percent,json=averageRemainderTimePercent,proto3" json:"average_remainder_time_percent,omitempty"`
-	AverageUnaccountedTimePercent float64                `protobuf:"fixed64,11,opt,name=average_unaccounted_time_percent,json=averageUnaccountedTimePercent,proto3" json:"average_unaccounted_time_percent,omitempty"`
-	unknownFields                 protoimpl.UnknownFields
-	sizeCache                     protoimpl.SizeCache
+func (x *ContainerCloseEvent) GetBlock() *BlockState {
+	if x != nil {
+		return x.Block
+	}
+	return nil
 }
 
-func (x *PlayerDiagnosticsEvent) Reset() {
-	*x = PlayerDiagnosticsEvent{}
-	mi := &file_player_events_proto_msgTypes[35]
+func (x *ContainerCloseEvent) GetServerSide() bool {
+	if x != nil {
+		return x.ServerSide
+	}
+	return false
+}
+
+// VirtualInventoryClickEvent is sent only to the plugin that opened the virtual inventory when a player clicks one of
+// its slots. Items are never moved into a virtual inventory. If it was opened with allow_take, a take waits for the
+// plugin and moves the items into the player's inventory unless cancelled; every other click is only reported.
+type VirtualInventoryClickEvent struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	PlayerUuid    string                    `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name          string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	World         string                    `protobuf:"bytes,3,opt,name=world,proto3" json:"world,omitempty"`
+	PluginId      string                    `protobuf:"bytes,4,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"` // plugin that opened the virtual inventory
+	InventoryId   string                    `protobuf:"bytes,5,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
+	Slot          int32                     `protobuf:"varint,6,opt,name=slot,proto3" json:"slot,omitempty"`
+	Action        VirtualInventoryClickType `protobuf:"varint,7,opt,name=action,proto3,enum=df.plugin.VirtualInventoryClickType" json:"action,omitempty"`
+	Item          *ItemStack                `protobuf:"bytes,8,opt,name=item,proto3,oneof" json:"item,omitempty"` // the stack in the clicked slot
+	Count         int32                     `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`    // number of items the client tried to move
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualInventoryClickEvent) Reset() {
+	*x = VirtualInventoryClickEvent{}
+	mi := &file_player_events_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlayerDiagnosticsEvent) String() string {
+func (x *VirtualInventoryClickEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerDiagnosticsEvent) ProtoMessage() {}
+func (*VirtualInventoryClickEvent) ProtoMessage() {}
 
-func (x *PlayerDiagnosticsEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_player_events_proto_msgTypes[35]
+func (x *VirtualInventoryClickEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2563,86 +3490,141 @@ func (x *PlayerDiagnosticsEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerDiagnosticsEvent.ProtoReflect.Descriptor instead.
-func (*PlayerDiagnosticsEvent) Descriptor() ([]byte, []int) {
-	return file_player_events_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use VirtualInventoryClickEvent.ProtoReflect.Descriptor instead.
+func (*VirtualInventoryClickEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *PlayerDiagnosticsEvent) GetPlayerUuid() string {
+func (x *VirtualInventoryClickEvent) GetPlayerUuid() string {
 	if x != nil {
 		return x.PlayerUuid
 	}
 	return ""
 }
 
-func (x *PlayerDiagnosticsEvent) GetName() string {
+func (x *VirtualInventoryClickEvent) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageFramesPerSecond() float64 {
+func (x *VirtualInventoryClickEvent) GetWorld() string {
 	if x != nil {
-		return x.AverageFramesPerSecond
+		return x.World
 	}
-	return 0
+	return ""
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageServerSimTickTime() float64 {
+func (x *VirtualInventoryClickEvent) GetPluginId() string {
 	if x != nil {
-		return x.AverageServerSimTickTime
+		return x.PluginId
 	}
-	return 0
+	return ""
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageClientSimTickTime() float64 {
+func (x *VirtualInventoryClickEvent) GetInventoryId() string {
 	if x != nil {
-		return x.AverageClientSimTickTime
+		return x.InventoryId
 	}
-	return 0
+	return ""
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageBeginFrameTime() float64 {
+func (x *VirtualInventoryClickEvent) GetSlot() int32 {
 	if x != nil {
-		return x.AverageBeginFrameTime
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageInputTime() float64 {
+func (x *VirtualInventoryClickEvent) GetAction() VirtualInventoryClickType {
 	if x != nil {
-		return x.AverageInputTime
+		return x.Action
 	}
-	return 0
+	return VirtualInventoryClickType_VIRTUAL_INVENTORY_CLICK_TYPE_TAKE
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageRenderTime() float64 {
+func (x *VirtualInventoryClickEvent) GetItem() *ItemStack {
 	if x != nil {
-		return x.AverageRenderTime
+		return x.Item
 	}
-	return 0
+	return nil
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageEndFrameTime() float64 {
+func (x *VirtualInventoryClickEvent) GetCount() int32 {
 	if x != nil {
-		return x.AverageEndFrameTime
+		return x.Count
 	}
 	return 0
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageRemainderTimePercent() float64 {
+// VirtualInventoryCloseEvent is sent only to the plugin that opened the virtual inventory.
+type VirtualInventoryCloseEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerUuid    string                 `protobuf:"bytes,1,opt,name=player_uuid,json=playerUuid,proto3" json:"player_uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PluginId      string                 `protobuf:"bytes,3,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	InventoryId   string                 `protobuf:"bytes,4,opt,name=inventory_id,json=inventoryId,proto3" json:"inventory_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VirtualInventoryCloseEvent) Reset() {
+	*x = VirtualInventoryCloseEvent{}
+	mi := &file_player_events_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VirtualInventoryCloseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualInventoryCloseEvent) ProtoMessage() {}
+
+func (x *VirtualInventoryCloseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_player_events_proto_msgTypes[44]
 	if x != nil {
-		return x.AverageRemainderTimePercent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PlayerDiagnosticsEvent) GetAverageUnaccountedTimePercent() float64 {
+// Deprecated: Use VirtualInventoryCloseEvent.ProtoReflect.Descriptor instead.
+func (*VirtualInventoryCloseEvent) Descriptor() ([]byte, []int) {
+	return file_player_events_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VirtualInventoryCloseEvent) GetPlayerUuid() string {
 	if x != nil {
-		return x.AverageUnaccountedTimePercent
+		return x.PlayerUuid
 	}
-	return 0
+	return ""
+}
+
+func (x *VirtualInventoryCloseEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VirtualInventoryCloseEvent) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *VirtualInventoryCloseEvent) GetInventoryId() string {
+	if x != nil {
+		return x.InventoryId
+	}
+	return ""
 }
 
 var File_player_events_proto protoreflect.FileDescriptor
@@ -2658,14 +3640,17 @@ const file_player_events_proto_rawDesc = "" +
 	"\x0fPlayerQuitEvent\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\xba\x01\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xd8\x01\n" +
 	"\x0fPlayerMoveEvent\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05world\x18\x03 \x01(\tR\x05world\x12+\n" +
 	"\bposition\x18\x04 \x01(\v2\x0f.df.plugin.Vec3R\bposition\x12/\n" +
-	"\brotation\x18\x05 \x01(\v2\x13.df.plugin.RotationR\brotation\"\x89\x01\n" +
+	"\brotation\x18\x05 \x01(\v2\x13.df.plugin.RotationR\brotation\x12\x1c\n" +
+	"\tcancelled\x18\x06 \x01(\bR\tcancelled\"C\n" +
+	"\x0fPlayerMoveBatch\x120\n" +
+	"\x05moves\x18\x01 \x03(\v2\x1a.df.plugin.PlayerMoveEventR\x05moves\"\x89\x01\n" +
 	"\x0fPlayerJumpEvent\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x12\n" +
@@ -2759,13 +3744,14 @@ const file_player_events_proto_rawDesc = "" +
 	"playerUuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05world\x18\x03 \x01(\tR\x05world\x12/\n" +
-	"\bposition\x18\x04 \x01(\v2\x13.df.plugin.BlockPosR\bposition\"\x8d\x01\n" +
+	"\bposition\x18\x04 \x01(\v2\x13.df.plugin.BlockPosR\bposition\"\xba\x01\n" +
 	"\x0fBlockBreakEvent\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05world\x18\x03 \x01(\tR\x05world\x12/\n" +
-	"\bposition\x18\x04 \x01(\v2\x13.df.plugin.BlockPosR\bposition\"\xc0\x01\n" +
+	"\bposition\x18\x04 \x01(\v2\x13.df.plugin.BlockPosR\bposition\x12+\n" +
+	"\x05block\x18\x05 \x01(\v2\x15.df.plugin.BlockStateR\x05block\"\xc0\x01\n" +
 	"\x15PlayerBlockPlaceEvent\x12\x1f\n" +
 	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
 	"playerUuid\x12\x12\n" +
@@ -2911,7 +3897,108 @@ const file_player_events_proto_rawDesc = "" +
 	"\x16average_end_frame_time\x18\t \x01(\x01R\x13averageEndFrameTime\x12C\n" +
 	"\x1eaverage_remainder_time_percent\x18\n" +
 	" \x01(\x01R\x1baverageRemainderTimePercent\x12G\n" +
-	" average_unaccounted_time_percent\x18\v \x01(\x01R\x1daverageUnaccountedTimePercentB\x90\x01\n" +
+	" average_unaccounted_time_percent\x18\v \x01(\x01R\x1daverageUnaccountedTimePercent\"\xe9\x02\n" +
+	"\x17PlayerFormResponseEvent\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tplugin_id\x18\x03 \x01(\tR\bpluginId\x12\x17\n" +
+	"\aform_id\x18\x04 \x01(\tR\x06formId\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\bR\x06closed\x12&\n" +
+	"\fbutton_index\x18\x06 \x01(\x05H\x00R\vbuttonIndex\x88\x01\x01\x12$\n" +
+	"\vbutton_text\x18\a \x01(\tH\x01R\n" +
+	"buttonText\x88\x01\x01\x12,\n" +
+	"\x06values\x18\b \x03(\v2\x14.df.plugin.FormValueR\x06values\x12 \n" +
+	"\tbutton_id\x18\t \x01(\tH\x02R\bbuttonId\x88\x01\x01B\x0f\n" +
+	"\r_button_indexB\x0e\n" +
+	"\f_button_textB\f\n" +
+	"\n" +
+	"_button_id\"\xdf\x01\n" +
+	"\tFormValue\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x05input\x18\x02 \x01(\tH\x00R\x05input\x12\x18\n" +
+	"\x06toggle\x18\x03 \x01(\bH\x00R\x06toggle\x12\x18\n" +
+	"\x06slider\x18\x04 \x01(\x01H\x00R\x06slider\x12\x1c\n" +
+	"\bdropdown\x18\x05 \x01(\x05H\x00R\bdropdown\x12!\n" +
+	"\vstep_slider\x18\x06 \x01(\x05H\x00R\n" +
+	"stepSlider\x12\x1b\n" +
+	"\x06option\x18\a \x01(\tH\x01R\x06option\x88\x01\x01B\a\n" +
+	"\x05valueB\t\n" +
+	"\a_option\"\x8f\x02\n" +
+	"\x1bPlayerDialogueResponseEvent\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tplugin_id\x18\x03 \x01(\tR\bpluginId\x12\x17\n" +
+	"\aform_id\x18\x04 \x01(\tR\x06formId\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\bR\x06closed\x12&\n" +
+	"\fbutton_index\x18\x06 \x01(\x05H\x00R\vbuttonIndex\x88\x01\x01\x12$\n" +
+	"\vbutton_text\x18\a \x01(\tH\x01R\n" +
+	"buttonText\x88\x01\x01B\x0f\n" +
+	"\r_button_indexB\x0e\n" +
+	"\f_button_text\"\x9f\x04\n" +
+	"\x1ePlayerInventorySlotChangeEvent\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x03 \x01(\tR\x05world\x126\n" +
+	"\tinventory\x18\x04 \x01(\x0e2\x18.df.plugin.InventoryTypeR\tinventory\x124\n" +
+	"\bposition\x18\x05 \x01(\v2\x13.df.plugin.BlockPosH\x00R\bposition\x88\x01\x01\x120\n" +
+	"\x05block\x18\x06 \x01(\v2\x15.df.plugin.BlockStateH\x01R\x05block\x88\x01\x01\x12\x12\n" +
+	"\x04slot\x18\a \x01(\x05R\x04slot\x126\n" +
+	"\x06action\x18\b \x01(\x0e2\x1e.df.plugin.InventorySlotActionR\x06action\x12-\n" +
+	"\x04item\x18\t \x01(\v2\x14.df.plugin.ItemStackH\x02R\x04item\x88\x01\x01\x121\n" +
+	"\x06before\x18\n" +
+	" \x01(\v2\x14.df.plugin.ItemStackH\x03R\x06before\x88\x01\x01\x12/\n" +
+	"\x05after\x18\v \x01(\v2\x14.df.plugin.ItemStackH\x04R\x05after\x88\x01\x01B\v\n" +
+	"\t_positionB\b\n" +
+	"\x06_blockB\a\n" +
+	"\x05_itemB\t\n" +
+	"\a_beforeB\b\n" +
+	"\x06_after\"\xbd\x01\n" +
+	"\x12ContainerOpenEvent\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x03 \x01(\tR\x05world\x12/\n" +
+	"\bposition\x18\x04 \x01(\v2\x13.df.plugin.BlockPosR\bposition\x12+\n" +
+	"\x05block\x18\x05 \x01(\v2\x15.df.plugin.BlockStateR\x05block\"\xdf\x01\n" +
+	"\x13ContainerCloseEvent\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x03 \x01(\tR\x05world\x12/\n" +
+	"\bposition\x18\x04 \x01(\v2\x13.df.plugin.BlockPosR\bposition\x12+\n" +
+	"\x05block\x18\x05 \x01(\v2\x15.df.plugin.BlockStateR\x05block\x12\x1f\n" +
+	"\vserver_side\x18\x06 \x01(\bR\n" +
+	"serverSide\"\xc7\x02\n" +
+	"\x1aVirtualInventoryClickEvent\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05world\x18\x03 \x01(\tR\x05world\x12\x1b\n" +
+	"\tplugin_id\x18\x04 \x01(\tR\bpluginId\x12!\n" +
+	"\finventory_id\x18\x05 \x01(\tR\vinventoryId\x12\x12\n" +
+	"\x04slot\x18\x06 \x01(\x05R\x04slot\x12<\n" +
+	"\x06action\x18\a \x01(\x0e2$.df.plugin.VirtualInventoryClickTypeR\x06action\x12-\n" +
+	"\x04item\x18\b \x01(\v2\x14.df.plugin.ItemStackH\x00R\x04item\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\t \x01(\x05R\x05countB\a\n" +
+	"\x05_item\"\x91\x01\n" +
+	"\x1aVirtualInventoryCloseEvent\x12\x1f\n" +
+	"\vplayer_uuid\x18\x01 \x01(\tR\n" +
+	"playerUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tplugin_id\x18\x03 \x01(\tR\bpluginId\x12!\n" +
+	"\finventory_id\x18\x04 \x01(\tR\vinventoryId*v\n" +
+	"\x13InventorySlotAction\x12\x1e\n" +
+	"\x1aINVENTORY_SLOT_ACTION_TAKE\x10\x00\x12\x1f\n" +
+	"\x1bINVENTORY_SLOT_ACTION_PLACE\x10\x01\x12\x1e\n" +
+	"\x1aINVENTORY_SLOT_ACTION_DROP\x10\x02*\xb8\x01\n" +
+	"\x19VirtualInventoryClickType\x12%\n" +
+	"!VIRTUAL_INVENTORY_CLICK_TYPE_TAKE\x10\x00\x12&\n" +
+	"\"VIRTUAL_INVENTORY_CLICK_TYPE_PLACE\x10\x01\x12%\n" +
+	"!VIRTUAL_INVENTORY_CLICK_TYPE_SWAP\x10\x02\x12%\n" +
+	"!VIRTUAL_INVENTORY_CLICK_TYPE_DROP\x10\x03B\x90\x01\n" +
 	"\rcom.df.pluginB\x11PlayerEventsProtoP\x01Z'github.com/secmc/plugin/proto/generated\xa2\x02\x03DPX\xaa\x02\tDf.Plugin\xca\x02\tDf\\Plugin\xe2\x02\x15Df\\Plugin\\GPBMetadata\xea\x02\n" +
 	"Df::Pluginb\x06proto3"
 
@@ -2927,97 +4014,126 @@ func file_player_events_proto_rawDescGZIP() []byte {
 	return file_player_events_proto_rawDescData
 }
 
-var file_player_events_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_player_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_player_events_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_player_events_proto_goTypes = []any{
-	(*PlayerJoinEvent)(nil),            // 0: df.plugin.PlayerJoinEvent
-	(*PlayerQuitEvent)(nil),            // 1: df.plugin.PlayerQuitEvent
-	(*PlayerMoveEvent)(nil),            // 2: df.plugin.PlayerMoveEvent
-	(*PlayerJumpEvent)(nil),            // 3: df.plugin.PlayerJumpEvent
-	(*PlayerTeleportEvent)(nil),        // 4: df.plugin.PlayerTeleportEvent
-	(*PlayerChangeWorldEvent)(nil),     // 5: df.plugin.PlayerChangeWorldEvent
-	(*PlayerToggleSprintEvent)(nil),    // 6: df.plugin.PlayerToggleSprintEvent
-	(*PlayerToggleSneakEvent)(nil),     // 7: df.plugin.PlayerToggleSneakEvent
-	(*ChatEvent)(nil),                  // 8: df.plugin.ChatEvent
-	(*PlayerFoodLossEvent)(nil),        // 9: df.plugin.PlayerFoodLossEvent
-	(*PlayerHealEvent)(nil),            // 10: df.plugin.PlayerHealEvent
-	(*PlayerHurtEvent)(nil),            // 11: df.plugin.PlayerHurtEvent
-	(*PlayerDeathEvent)(nil),           // 12: df.plugin.PlayerDeathEvent
-	(*PlayerRespawnEvent)(nil),         // 13: df.plugin.PlayerRespawnEvent
-	(*PlayerSkinChangeEvent)(nil),      // 14: df.plugin.PlayerSkinChangeEvent
-	(*PlayerFireExtinguishEvent)(nil),  // 15: df.plugin.PlayerFireExtinguishEvent
-	(*PlayerStartBreakEvent)(nil),      // 16: df.plugin.PlayerStartBreakEvent
-	(*BlockBreakEvent)(nil),            // 17: df.plugin.BlockBreakEvent
-	(*PlayerBlockPlaceEvent)(nil),      // 18: df.plugin.PlayerBlockPlaceEvent
-	(*PlayerBlockPickEvent)(nil),       // 19: df.plugin.PlayerBlockPickEvent
-	(*PlayerItemUseEvent)(nil),         // 20: df.plugin.PlayerItemUseEvent
-	(*PlayerItemUseOnBlockEvent)(nil),  // 21: df.plugin.PlayerItemUseOnBlockEvent
-	(*PlayerItemUseOnEntityEvent)(nil), // 22: df.plugin.PlayerItemUseOnEntityEvent
-	(*PlayerItemReleaseEvent)(nil),     // 23: df.plugin.PlayerItemReleaseEvent
-	(*PlayerItemConsumeEvent)(nil),     // 24: df.plugin.PlayerItemConsumeEvent
-	(*PlayerAttackEntityEvent)(nil),    // 25: df.plugin.PlayerAttackEntityEvent
-	(*PlayerExperienceGainEvent)(nil),  // 26: df.plugin.PlayerExperienceGainEvent
-	(*PlayerPunchAirEvent)(nil),        // 27: df.plugin.PlayerPunchAirEvent
-	(*PlayerSignEditEvent)(nil),        // 28: df.plugin.PlayerSignEditEvent
-	(*PlayerLecternPageTurnEvent)(nil), // 29: df.plugin.PlayerLecternPageTurnEvent
-	(*PlayerItemDamageEvent)(nil),      // 30: df.plugin.PlayerItemDamageEvent
-	(*PlayerItemPickupEvent)(nil),      // 31: df.plugin.PlayerItemPickupEvent
-	(*PlayerHeldSlotChangeEvent)(nil),  // 32: df.plugin.PlayerHeldSlotChangeEvent
-	(*PlayerItemDropEvent)(nil),        // 33: df.plugin.PlayerItemDropEvent
-	(*PlayerTransferEvent)(nil),        // 34: df.plugin.PlayerTransferEvent
-	(*PlayerDiagnosticsEvent)(nil),     // 35: df.plugin.PlayerDiagnosticsEvent
-	(*WorldRef)(nil),                   // 36: df.plugin.WorldRef
-	(*Vec3)(nil),                       // 37: df.plugin.Vec3
-	(*Rotation)(nil),                   // 38: df.plugin.Rotation
-	(*HealingSource)(nil),              // 39: df.plugin.HealingSource
-	(*DamageSource)(nil),               // 40: df.plugin.DamageSource
-	(*BlockPos)(nil),                   // 41: df.plugin.BlockPos
-	(*BlockState)(nil),                 // 42: df.plugin.BlockState
-	(*ItemStack)(nil),                  // 43: df.plugin.ItemStack
-	(*EntityRef)(nil),                  // 44: df.plugin.EntityRef
-	(*Address)(nil),                    // 45: df.plugin.Address
+	(InventorySlotAction)(0),               // 0: df.plugin.InventorySlotAction
+	(VirtualInventoryClickType)(0),         // 1: df.plugin.VirtualInventoryClickType
+	(*PlayerJoinEvent)(nil),                // 2: df.plugin.PlayerJoinEvent
+	(*PlayerQuitEvent)(nil),                // 3: df.plugin.PlayerQuitEvent
+	(*PlayerMoveEvent)(nil),                // 4: df.plugin.PlayerMoveEvent
+	(*PlayerMoveBatch)(nil),                // 5: df.plugin.PlayerMoveBatch
+	(*PlayerJumpEvent)(nil),                // 6: df.plugin.PlayerJumpEvent
+	(*PlayerTeleportEvent)(nil),            // 7: df.plugin.PlayerTeleportEvent
+	(*PlayerChangeWorldEvent)(nil),         // 8: df.plugin.PlayerChangeWorldEvent
+	(*PlayerToggleSprintEvent)(nil),        // 9: df.plugin.PlayerToggleSprintEvent
+	(*PlayerToggleSneakEvent)(nil),         // 10: df.plugin.PlayerToggleSneakEvent
+	(*ChatEvent)(nil),                      // 11: df.plugin.ChatEvent
+	(*PlayerFoodLossEvent)(nil),            // 12: df.plugin.PlayerFoodLossEvent
+	(*PlayerHealEvent)(nil),                // 13: df.plugin.PlayerHealEvent
+	(*PlayerHurtEvent)(nil),                // 14: df.plugin.PlayerHurtEvent
+	(*PlayerDeathEvent)(nil),               // 15: df.plugin.PlayerDeathEvent
+	(*PlayerRespawnEvent)(nil),             // 16: df.plugin.PlayerRespawnEvent
+	(*PlayerSkinChangeEvent)(nil),          // 17: df.plugin.PlayerSkinChangeEvent
+	(*PlayerFireExtinguishEvent)(nil),      // 18: df.plugin.PlayerFireExtinguishEvent
+	(*PlayerStartBreakEvent)(nil),          // 19: df.plugin.PlayerStartBreakEvent
+	(*BlockBreakEvent)(nil),                // 20: df.plugin.BlockBreakEvent
+	(*PlayerBlockPlaceEvent)(nil),          // 21: df.plugin.PlayerBlockPlaceEvent
+	(*PlayerBlockPickEvent)(nil),           // 22: df.plugin.PlayerBlockPickEvent
+	(*PlayerItemUseEvent)(nil),             // 23: df.plugin.PlayerItemUseEvent
+	(*PlayerItemUseOnBlockEvent)(nil),      // 24: df.plugin.PlayerItemUseOnBlockEvent
+	(*PlayerItemUseOnEntityEvent)(nil),     // 25: df.plugin.PlayerItemUseOnEntityEvent
+	(*PlayerItemReleaseEvent)(nil),         // 26: df.plugin.PlayerItemReleaseEvent
+	(*PlayerItemConsumeEvent)(nil),         // 27: df.plugin.PlayerItemConsumeEvent
+	(*PlayerAttackEntityEvent)(nil),        // 28: df.plugin.PlayerAttackEntityEvent
+	(*PlayerExperienceGainEvent)(nil),      // 29: df.plugin.PlayerExperienceGainEvent
+	(*PlayerPunchAirEvent)(nil),            // 30: df.plugin.PlayerPunchAirEvent
+	(*PlayerSignEditEvent)(nil),            // 31: df.plugin.PlayerSignEditEvent
+	(*PlayerLecternPageTurnEvent)(nil),     // 32: df.plugin.PlayerLecternPageTurnEvent
+	(*PlayerItemDamageEvent)(nil),          // 33: df.plugin.PlayerItemDamageEvent
+	(*PlayerItemPickupEvent)(nil),          // 34: df.plugin.PlayerItemPickupEvent
+	(*PlayerHeldSlotChangeEvent)(nil),      // 35: df.plugin.PlayerHeldSlotChangeEvent
+	(*PlayerItemDropEvent)(nil),            // 36: df.plugin.PlayerItemDropEvent
+	(*PlayerTransferEvent)(nil),            // 37: df.plugin.PlayerTransferEvent
+	(*PlayerDiagnosticsEvent)(nil),         // 38: df.plugin.PlayerDiagnosticsEvent
+	(*PlayerFormResponseEvent)(nil),        // 39: df.plugin.PlayerFormResponseEvent
+	(*FormValue)(nil),                      // 40: df.plugin.FormValue
+	(*PlayerDialogueResponseEvent)(nil),    // 41: df.plugin.PlayerDialogueResponseEvent
+	(*PlayerInventorySlotChangeEvent)(nil), // 42: df.plugin.PlayerInventorySlotChangeEvent
+	(*ContainerOpenEvent)(nil),             // 43: df.plugin.ContainerOpenEvent
+	(*ContainerCloseEvent)(nil),            // 44: df.plugin.ContainerCloseEvent
+	(*VirtualInventoryClickEvent)(nil),     // 45: df.plugin.VirtualInventoryClickEvent
+	(*VirtualInventoryCloseEvent)(nil),     // 46: df.plugin.VirtualInventoryCloseEvent
+	(*WorldRef)(nil),                       // 47: df.plugin.WorldRef
+	(*Vec3)(nil),                           // 48: df.plugin.Vec3
+	(*Rotation)(nil),                       // 49: df.plugin.Rotation
+	(*HealingSource)(nil),                  // 50: df.plugin.HealingSource
+	(*DamageSource)(nil),                   // 51: df.plugin.DamageSource
+	(*BlockPos)(nil),                       // 52: df.plugin.BlockPos
+	(*BlockState)(nil),                     // 53: df.plugin.BlockState
+	(*ItemStack)(nil),                      // 54: df.plugin.ItemStack
+	(*EntityRef)(nil),                      // 55: df.plugin.EntityRef
+	(*Address)(nil),                        // 56: df.plugin.Address
+	(InventoryType)(0),                     // 57: df.plugin.InventoryType
 }
 var file_player_events_proto_depIdxs = []int32{
-	36, // 0: df.plugin.PlayerJoinEvent.world:type_name -> df.plugin.WorldRef
-	37, // 1: df.plugin.PlayerMoveEvent.position:type_name -> df.plugin.Vec3
-	38, // 2: df.plugin.PlayerMoveEvent.rotation:type_name -> df.plugin.Rotation
-	37, // 3: df.plugin.PlayerJumpEvent.position:type_name -> df.plugin.Vec3
-	37, // 4: df.plugin.PlayerTeleportEvent.position:type_name -> df.plugin.Vec3
-	36, // 5: df.plugin.PlayerChangeWorldEvent.before:type_name -> df.plugin.WorldRef
-	36, // 6: df.plugin.PlayerChangeWorldEvent.after:type_name -> df.plugin.WorldRef
-	39, // 7: df.plugin.PlayerHealEvent.source:type_name -> df.plugin.HealingSource
-	40, // 8: df.plugin.PlayerHurtEvent.source:type_name -> df.plugin.DamageSource
-	40, // 9: df.plugin.PlayerDeathEvent.source:type_name -> df.plugin.DamageSource
-	37, // 10: df.plugin.PlayerRespawnEvent.position:type_name -> df.plugin.Vec3
-	36, // 11: df.plugin.PlayerRespawnEvent.world:type_name -> df.plugin.WorldRef
-	41, // 12: df.plugin.PlayerFireExtinguishEvent.position:type_name -> df.plugin.BlockPos
-	41, // 13: df.plugin.PlayerStartBreakEvent.position:type_name -> df.plugin.BlockPos
-	41, // 14: df.plugin.BlockBreakEvent.position:type_name -> df.plugin.BlockPos
-	41, // 15: df.plugin.PlayerBlockPlaceEvent.position:type_name -> df.plugin.BlockPos
-	42, // 16: df.plugin.PlayerBlockPlaceEvent.block:type_name -> df.plugin.BlockState
-	41, // 17: df.plugin.PlayerBlockPickEvent.position:type_name -> df.plugin.BlockPos
-	42, // 18: df.plugin.PlayerBlockPickEvent.block:type_name -> df.plugin.BlockState
-	43, // 19: df.plugin.PlayerItemUseEvent.item:type_name -> df.plugin.ItemStack
-	41, // 20: df.plugin.PlayerItemUseOnBlockEvent.position:type_name -> df.plugin.BlockPos
-	37, // 21: df.plugin.PlayerItemUseOnBlockEvent.click_position:type_name -> df.plugin.Vec3
-	42, // 22: df.plugin.PlayerItemUseOnBlockEvent.block:type_name -> df.plugin.BlockState
-	43, // 23: df.plugin.PlayerItemUseOnBlockEvent.item:type_name -> df.plugin.ItemStack
-	44, // 24: df.plugin.PlayerItemUseOnEntityEvent.entity:type_name -> df.plugin.EntityRef
-	43, // 25: df.plugin.PlayerItemUseOnEntityEvent.item:type_name -> df.plugin.ItemStack
-	43, // 26: df.plugin.PlayerItemReleaseEvent.item:type_name -> df.plugin.ItemStack
-	43, // 27: df.plugin.PlayerItemConsumeEvent.item:type_name -> df.plugin.ItemStack
-	44, // 28: df.plugin.PlayerAttackEntityEvent.entity:type_name -> df.plugin.EntityRef
-	43, // 29: df.plugin.PlayerAttackEntityEvent.item:type_name -> df.plugin.ItemStack
-	41, // 30: df.plugin.PlayerSignEditEvent.position:type_name -> df.plugin.BlockPos
-	41, // 31: df.plugin.PlayerLecternPageTurnEvent.position:type_name -> df.plugin.BlockPos
-	43, // 32: df.plugin.PlayerItemDamageEvent.item:type_name -> df.plugin.ItemStack
-	43, // 33: df.plugin.PlayerItemPickupEvent.item:type_name -> df.plugin.ItemStack
-	43, // 34: df.plugin.PlayerItemDropEvent.item:type_name -> df.plugin.ItemStack
-	45, // 35: df.plugin.PlayerTransferEvent.address:type_name -> df.plugin.Address
-	36, // [36:36] is the sub-list for method output_type
-	36, // [36:36] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	47, // 0: df.plugin.PlayerJoinEvent.world:type_name -> df.plugin.WorldRef
+	48, // 1: df.plugin.PlayerMoveEvent.position:type_name -> df.plugin.Vec3
+	49, // 2: df.plugin.PlayerMoveEvent.rotation:type_name -> df.plugin.Rotation
+	4,  // 3: df.plugin.PlayerMoveBatch.moves:type_name -> df.plugin.PlayerMoveEvent
+	48, // 4: df.plugin.PlayerJumpEvent.position:type_name -> df.plugin.Vec3
+	48, // 5: df.plugin.PlayerTeleportEvent.position:type_name -> df.plugin.Vec3
+	47, // 6: df.plugin.PlayerChangeWorldEvent.before:type_name -> df.plugin.WorldRef
+	47, // 7: df.plugin.PlayerChangeWorldEvent.after:type_name -> df.plugin.WorldRef
+	50, // 8: df.plugin.PlayerHealEvent.source:type_name -> df.plugin.HealingSource
+	51, // 9: df.plugin.PlayerHurtEvent.source:type_name -> df.plugin.DamageSource
+	51, // 10: df.plugin.PlayerDeathEvent.source:type_name -> df.plugin.DamageSource
+	48, // 11: df.plugin.PlayerRespawnEvent.position:type_name -> df.plugin.Vec3
+	47, // 12: df.plugin.PlayerRespawnEvent.world:type_name -> df.plugin.WorldRef
+	52, // 13: df.plugin.PlayerFireExtinguishEvent.position:type_name -> df.plugin.BlockPos
+	52, // 14: df.plugin.PlayerStartBreakEvent.position:type_name -> df.plugin.BlockPos
+	52, // 15: df.plugin.BlockBreakEvent.position:type_name -> df.plugin.BlockPos
+	53, // 16: df.plugin.BlockBreakEvent.block:type_name -> df.plugin.BlockState
+	52, // 17: df.plugin.PlayerBlockPlaceEvent.position:type_name -> df.plugin.BlockPos
+	53, // 18: df.plugin.PlayerBlockPlaceEvent.block:type_name -> df.plugin.BlockState
+	52, // 19: df.plugin.PlayerBlockPickEvent.position:type_name -> df.plugin.BlockPos
+	53, // 20: df.plugin.PlayerBlockPickEvent.block:type_name -> df.plugin.BlockState
+	54, // 21: df.plugin.PlayerItemUseEvent.item:type_name -> df.plugin.ItemStack
+	52, // 22: df.plugin.PlayerItemUseOnBlockEvent.position:type_name -> df.plugin.BlockPos
+	48, // 23: df.plugin.PlayerItemUseOnBlockEvent.click_position:type_name -> df.plugin.Vec3
+	53, // 24: df.plugin.PlayerItemUseOnBlockEvent.block:type_name -> df.plugin.BlockState
+	54, // 25: df.plugin.PlayerItemUseOnBlockEvent.item:type_name -> df.plugin.ItemStack
+	55, // 26: df.plugin.PlayerItemUseOnEntityEvent.entity:type_name -> df.plugin.EntityRef
+	54, // 27: df.plugin.PlayerItemUseOnEntityEvent.item:type_name -> df.plugin.ItemStack
+	54, // 28: df.plugin.PlayerItemReleaseEvent.item:type_name -> df.plugin.ItemStack
+	54, // 29: df.plugin.PlayerItemConsumeEvent.item:type_name -> df.plugin.ItemStack
+	55, // 30: df.plugin.PlayerAttackEntityEvent.entity:type_name -> df.plugin.EntityRef
+	54, // 31: df.plugin.PlayerAttackEntityEvent.item:type_name -> df.plugin.ItemStack
+	52, // 32: df.plugin.PlayerSignEditEvent.position:type_name -> df.plugin.BlockPos
+	52, // 33: df.plugin.PlayerLecternPageTurnEvent.position:type_name -> df.plugin.BlockPos
+	54, // 34: df.plugin.PlayerItemDamageEvent.item:type_name -> df.plugin.ItemStack
+	54, // 35: df.plugin.PlayerItemPickupEvent.item:type_name -> df.plugin.ItemStack
+	54, // 36: df.plugin.PlayerItemDropEvent.item:type_name -> df.plugin.ItemStack
+	56, // 37: df.plugin.PlayerTransferEvent.address:type_name -> df.plugin.Address
+	40, // 38: df.plugin.PlayerFormResponseEvent.values:type_name -> df.plugin.FormValue
+	57, // 39: df.plugin.PlayerInventorySlotChangeEvent.inventory:type_name -> df.plugin.InventoryType
+	52, // 40: df.plugin.PlayerInventorySlotChangeEvent.position:type_name -> df.plugin.BlockPos
+	53, // 41: df.plugin.PlayerInventorySlotChangeEvent.block:type_name -> df.plugin.BlockState
+	0,  // 42: df.plugin.PlayerInventorySlotChangeEvent.action:type_name -> df.plugin.InventorySlotAction
+	54, // 43: df.plugin.PlayerInventorySlotChangeEvent.item:type_name -> df.plugin.ItemStack
+	54, // 44: df.plugin.PlayerInventorySlotChangeEvent.before:type_name -> df.plugin.ItemStack
+	54, // 45: df.plugin.PlayerInventorySlotChangeEvent.after:type_name -> df.plugin.ItemStack
+	52, // 46: df.plugin.ContainerOpenEvent.position:type_name -> df.plugin.BlockPos
+	53, // 47: df.plugin.ContainerOpenEvent.block:type_name -> df.plugin.BlockState
+	52, // 48: df.plugin.ContainerCloseEvent.position:type_name -> df.plugin.BlockPos
+	53, // 49: df.plugin.ContainerCloseEvent.block:type_name -> df.plugin.BlockState
+	1,  // 50: df.plugin.VirtualInventoryClickEvent.action:type_name -> df.plugin.VirtualInventoryClickType
+	54, // 51: df.plugin.VirtualInventoryClickEvent.item:type_name -> df.plugin.ItemStack
+	52, // [52:52] is the sub-list for method output_type
+	52, // [52:52] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_player_events_proto_init() }
@@ -3026,34 +4142,46 @@ func file_player_events_proto_init() {
 		return
 	}
 	file_common_proto_init()
-	file_player_events_proto_msgTypes[5].OneofWrappers = []any{}
-	file_player_events_proto_msgTypes[10].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[6].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[11].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[12].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[13].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[14].OneofWrappers = []any{}
-	file_player_events_proto_msgTypes[20].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[15].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[21].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[22].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[23].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[24].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[25].OneofWrappers = []any{}
-	file_player_events_proto_msgTypes[30].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[26].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[31].OneofWrappers = []any{}
-	file_player_events_proto_msgTypes[33].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[32].OneofWrappers = []any{}
 	file_player_events_proto_msgTypes[34].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[35].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[37].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[38].OneofWrappers = []any{
+		(*FormValue_Input)(nil),
+		(*FormValue_Toggle)(nil),
+		(*FormValue_Slider)(nil),
+		(*FormValue_Dropdown)(nil),
+		(*FormValue_StepSlider)(nil),
+	}
+	file_player_events_proto_msgTypes[39].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[40].OneofWrappers = []any{}
+	file_player_events_proto_msgTypes[43].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_player_events_proto_rawDesc), len(file_player_events_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   36,
+			NumEnums:      2,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_player_events_proto_goTypes,
 		DependencyIndexes: file_player_events_proto_depIdxs,
+		EnumInfos:         file_player_events_proto_enumTypes,
 		MessageInfos:      file_player_events_proto_msgTypes,
 	}.Build()
 	File_player_events_proto = out.File
@@ -21,169 +21,254 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// EventPriority orders cancellable event dispatch. Plugins are called one priority group
+// at a time from LOWEST to HIGHEST, and each group sees the payload as mutated by earlier
+// groups. MONITOR plugins are called last with the final outcome and cannot cancel or mutate.
+type EventPriority int32
+
+const (
+	EventPriority_EVENT_PRIORITY_NORMAL  EventPriority = 0
+	EventPriority_EVENT_PRIORITY_LOWEST  EventPriority = 1
+	EventPriority_EVENT_PRIORITY_LOW     EventPriority = 2
+	EventPriority_EVENT_PRIORITY_HIGH    EventPriority = 3
+	EventPriority_EVENT_PRIORITY_HIGHEST EventPriority = 4
+	EventPriority_EVENT_PRIORITY_MONITOR EventPriority = 5
+)
+
+// Enum value maps for EventPriority.
+var (
+	EventPriority_name = map[int32]string{
+		0: "EVENT_PRIORITY_NORMAL",
+		1: "EVENT_PRIORITY_LOWEST",
+		2: "EVENT_PRIORITY_LOW",
+		3: "EVENT_PRIORITY_HIGH",
+		4: "EVENT_PRIORITY_HIGHEST",
+		5: "EVENT_PRIORITY_MONITOR",
+	}
+	EventPriority_value = map[string]int32{
+		"EVENT_PRIORITY_NORMAL":  0,
+		"EVENT_PRIORITY_LOWEST":  1,
+		"EVENT_PRIORITY_LOW":     2,
+		"EVENT_PRIORITY_HIGH":    3,
+		"EVENT_PRIORITY_HIGHEST": 4,
+		"EVENT_PRIORITY_MONITOR": 5,
+	}
+)
+
+func (x EventPriority) Enum() *EventPriority {
+	p := new(EventPriority)
+	*p = x
+	return p
+}
+
+func (x EventPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_enumTypes[0].Descriptor()
+}
+
+func (EventPriority) Type() protoreflect.EnumType {
+	return &file_plugin_proto_enumTypes[0]
+}
+
+func (x EventPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventPriority.Descriptor instead.
+func (EventPriority) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{0}
+}
+
 type EventType int32
 
 const (
-	EventType_EVENT_TYPE_UNSPECIFIED    EventType = 0
-	EventType_EVENT_TYPE_ALL            EventType = 1
-	EventType_PLAYER_JOIN               EventType = 10
-	EventType_PLAYER_QUIT               EventType = 11
-	EventType_PLAYER_MOVE               EventType = 12
-	EventType_PLAYER_JUMP               EventType = 13
-	EventType_PLAYER_TELEPORT           EventType = 14
-	EventType_PLAYER_CHANGE_WORLD       EventType = 15
-	EventType_PLAYER_TOGGLE_SPRINT      EventType = 16
-	EventType_PLAYER_TOGGLE_SNEAK       EventType = 17
-	EventType_CHAT                      EventType = 18
-	EventType_PLAYER_FOOD_LOSS          EventType = 19
-	EventType_PLAYER_HEAL               EventType = 20
-	EventType_PLAYER_HURT               EventType = 21
-	EventType_PLAYER_DEATH              EventType = 22
-	EventType_PLAYER_RESPAWN            EventType = 23
-	EventType_PLAYER_SKIN_CHANGE        EventType = 24
-	EventType_PLAYER_FIRE_EXTINGUISH    EventType = 25
-	EventType_PLAYER_START_BREAK        EventType = 26
-	EventType_PLAYER_BLOCK_BREAK        EventType = 27
-	EventType_PLAYER_BLOCK_PLACE        EventType = 28
-	EventType_PLAYER_BLOCK_PICK         EventType = 29
-	EventType_PLAYER_ITEM_USE           EventType = 30
-	EventType_PLAYER_ITEM_USE_ON_BLOCK  EventType = 31
-	EventType_PLAYER_ITEM_USE_ON_ENTITY EventType = 32
-	EventType_PLAYER_ITEM_RELEASE       EventType = 33
-	EventType_PLAYER_ITEM_CONSUME       EventType = 34
-	EventType_PLAYER_ATTACK_ENTITY      EventType = 35
-	EventType_PLAYER_EXPERIENCE_GAIN    EventType = 36
-	EventType_PLAYER_PUNCH_AIR          EventType = 37
-	EventType_PLAYER_SIGN_EDIT          EventType = 38
-	EventType_PLAYER_LECTERN_PAGE_TURN  EventType = 39
-	EventType_PLAYER_ITEM_DAMAGE        EventType = 40
-	EventType_PLAYER_ITEM_PICKUP        EventType = 41
-	EventType_PLAYER_HELD_SLOT_CHANGE   EventType = 42
-	EventType_PLAYER_ITEM_DROP          EventType = 43
-	EventType_PLAYER_TRANSFER           EventType = 44
-	EventType_COMMAND                   EventType = 45
-	EventType_PLAYER_DIAGNOSTICS        EventType = 46
-	EventType_WORLD_LIQUID_FLOW         EventType = 70
-	EventType_WORLD_LIQUID_DECAY        EventType = 71
-	EventType_WORLD_LIQUID_HARDEN       EventType = 72
-	EventType_WORLD_SOUND               EventType = 73
-	EventType_WORLD_FIRE_SPREAD         EventType = 74
-	EventType_WORLD_BLOCK_BURN          EventType = 75
-	EventType_WORLD_CROP_TRAMPLE        EventType = 76
-	EventType_WORLD_LEAVES_DECAY        EventType = 77
-	EventType_WORLD_ENTITY_SPAWN        EventType = 78
-	EventType_WORLD_ENTITY_DESPAWN      EventType = 79
-	EventType_WORLD_EXPLOSION           EventType = 80
-	EventType_WORLD_CLOSE               EventType = 81
+	EventType_EVENT_TYPE_UNSPECIFIED       EventType = 0
+	EventType_EVENT_TYPE_ALL               EventType = 1
+	EventType_PLAYER_JOIN                  EventType = 10
+	EventType_PLAYER_QUIT                  EventType = 11
+	EventType_PLAYER_MOVE                  EventType = 12
+	EventType_PLAYER_JUMP                  EventType = 13
+	EventType_PLAYER_TELEPORT              EventType = 14
+	EventType_PLAYER_CHANGE_WORLD          EventType = 15
+	EventType_PLAYER_TOGGLE_SPRINT         EventType = 16
+	EventType_PLAYER_TOGGLE_SNEAK          EventType = 17
+	EventType_CHAT                         EventType = 18
+	EventType_PLAYER_FOOD_LOSS             EventType = 19
+	EventType_PLAYER_HEAL                  EventType = 20
+	EventType_PLAYER_HURT                  EventType = 21
+	EventType_PLAYER_DEATH                 EventType = 22
+	EventType_PLAYER_RESPAWN               EventType = 23
+	EventType_PLAYER_SKIN_CHANGE           EventType = 24
+	EventType_PLAYER_FIRE_EXTINGUISH       EventType = 25
+	EventType_PLAYER_START_BREAK           EventType = 26
+	EventType_PLAYER_BLOCK_BREAK           EventType = 27
+	EventType_PLAYER_BLOCK_PLACE           EventType = 28
+	EventType_PLAYER_BLOCK_PICK            EventType = 29
+	EventType_PLAYER_ITEM_USE              EventType = 30
+	EventType_PLAYER_ITEM_USE_ON_BLOCK     EventType = 31
+	EventType_PLAYER_ITEM_USE_ON_ENTITY    EventType = 32
+	EventType_PLAYER_ITEM_RELEASE          EventType = 33
+	EventType_PLAYER_ITEM_CONSUME          EventType = 34
+	EventType_PLAYER_ATTACK_ENTITY         EventType = 35
+	EventType_PLAYER_EXPERIENCE_GAIN       EventType = 36
+	EventType_PLAYER_PUNCH_AIR             EventType = 37
+	EventType_PLAYER_SIGN_EDIT             EventType = 38
+	EventType_PLAYER_LECTERN_PAGE_TURN     EventType = 39
+	EventType_PLAYER_ITEM_DAMAGE           EventType = 40
+	EventType_PLAYER_ITEM_PICKUP           EventType = 41
+	EventType_PLAYER_HELD_SLOT_CHANGE      EventType = 42
+	EventType_PLAYER_ITEM_DROP             EventType = 43
+	EventType_PLAYER_TRANSFER              EventType = 44
+	EventType_COMMAND                      EventType = 45
+	EventType_PLAYER_DIAGNOSTICS           EventType = 46
+	EventType_PLAYER_FORM_RESPONSE         EventType = 47
+	EventType_PLAYER_DIALOGUE_RESPONSE     EventType = 48
+	EventType_PLAYER_INVENTORY_SLOT_CHANGE EventType = 50
+	EventType_CONTAINER_OPEN               EventType = 51
+	EventType_CONTAINER_CLOSE              EventType = 52
+	EventType_VIRTUAL_INVENTORY_CLICK      EventType = 53
+	EventType_VIRTUAL_INVENTORY_CLOSE      EventType = 54
+	EventType_WORLD_LIQUID_FLOW            EventType = 70
+	EventType_WORLD_LIQUID_DECAY           EventType = 71
+	EventType_WORLD_LIQUID_HARDEN          EventType = 72
+	EventType_WORLD_SOUND                  EventType = 73
+	EventType_WORLD_FIRE_SPREAD            EventType = 74
+	EventType_WORLD_BLOCK_BURN             EventType = 75
+	EventType_WORLD_CROP_TRAMPLE           EventType = 76
+	EventType_WORLD_LEAVES_DECAY           EventType = 77
+	EventType_WORLD_ENTITY_SPAWN           EventType = 78
+	EventType_WORLD_ENTITY_DESPAWN         EventType = 79
+	EventType_WORLD_EXPLOSION              EventType = 80
+	EventType_WORLD_CLOSE                  EventType = 81
+	EventType_PLUGIN_CIRCUIT_STATE         EventType = 100
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0:  "EVENT_TYPE_UNSPECIFIED",
-		1:  "EVENT_TYPE_ALL",
-		10: "PLAYER_JOIN",
-		11: "PLAYER_QUIT",
-		12: "PLAYER_MOVE",
-		13: "PLAYER_JUMP",
-		14: "PLAYER_TELEPORT",
-		15: "PLAYER_CHANGE_WORLD",
-		16: "PLAYER_TOGGLE_SPRINT",
-		17: "PLAYER_TOGGLE_SNEAK",
-		18: "CHAT",
-		19: "PLAYER_FOOD_LOSS",
-		20: "PLAYER_HEAL",
-		21: "PLAYER_HURT",
-		22: "PLAYER_DEATH",
-		23: "PLAYER_RESPAWN",
-		24: "PLAYER_SKIN_CHANGE",
-		25: "PLAYER_FIRE_EXTINGUISH",
-		26: "PLAYER_START_BREAK",
-		27: "PLAYER_BLOCK_BREAK",
-		28: "PLAYER_BLOCK_PLACE",
-		29: "PLAYER_BLOCK_PICK",
-		30: "PLAYER_ITEM_USE",
-		31: "PLAYER_ITEM_USE_ON_BLOCK",
-		32: "PLAYER_ITEM_USE_ON_ENTITY",
-		33: "PLAYER_ITEM_RELEASE",
-		34: "PLAYER_ITEM_CONSUME",
-		35: "PLAYER_ATTACK_ENTITY",
-		36: "PLAYER_EXPERIENCE_GAIN",
-		37: "PLAYER_PUNCH_AIR",
-		38: "PLAYER_SIGN_EDIT",
-		39: "PLAYER_LECTERN_PAGE_TURN",
-		40: "PLAYER_ITEM_DAMAGE",
-		41: "PLAYER_ITEM_PICKUP",
-		42: "PLAYER_HELD_SLOT_CHANGE",
-		43: "PLAYER_ITEM_DROP",
-		44: "PLAYER_TRANSFER",
-		45: "COMMAND",
-		46: "PLAYER_DIAGNOSTICS",
-		70: "WORLD_LIQUID_FLOW",
-		71: "WORLD_LIQUID_DECAY",
-		72: "WORLD_LIQUID_HARDEN",
-		73: "WORLD_SOUND",
-		74: "WORLD_FIRE_SPREAD",
-		75: "WORLD_BLOCK_BURN",
-		76: "WORLD_CROP_TRAMPLE",
-		77: "WORLD_LEAVES_DECAY",
-		78: "WORLD_ENTITY_SPAWN",
-		79: "WORLD_ENTITY_DESPAWN",
-		80: "WORLD_EXPLOSION",
-		81: "WORLD_CLOSE",
+		0:   "EVENT_TYPE_UNSPECIFIED",
+		1:   "EVENT_TYPE_ALL",
+		10:  "PLAYER_JOIN",
+		11:  "PLAYER_QUIT",
+		12:  "PLAYER_MOVE",
+		13:  "PLAYER_JUMP",
+		14:  "PLAYER_TELEPORT",
+		15:  "PLAYER_CHANGE_WORLD",
+		16:  "PLAYER_TOGGLE_SPRINT",
+		17:  "PLAYER_TOGGLE_SNEAK",
+		18:  "CHAT",
+		19:  "PLAYER_FOOD_LOSS",
+		20:  "PLAYER_HEAL",
+		21:  "PLAYER_HURT",
+		22:  "PLAYER_DEATH",
+		23:  "PLAYER_RESPAWN",
+		24:  "PLAYER_SKIN_CHANGE",
+		25:  "PLAYER_FIRE_EXTINGUISH",
+		26:  "PLAYER_START_BREAK",
+		27:  "PLAYER_BLOCK_BREAK",
+		28:  "PLAYER_BLOCK_PLACE",
+		29:  "PLAYER_BLOCK_PICK",
+		30:  "PLAYER_ITEM_USE",
+		31:  "PLAYER_ITEM_USE_ON_BLOCK",
+		32:  "PLAYER_ITEM_USE_ON_ENTITY",
+		33:  "PLAYER_ITEM_RELEASE",
+		34:  "PLAYER_ITEM_CONSUME",
+		35:  "PLAYER_ATTACK_ENTITY",
+		36:  "PLAYER_EXPERIENCE_GAIN",
+		37:  "PLAYER_PUNCH_AIR",
+		38:  "PLAYER_SIGN_EDIT",
+		39:  "PLAYER_LECTERN_PAGE_TURN",
+		40:  "PLAYER_ITEM_DAMAGE",
+		41:  "PLAYER_ITEM_PICKUP",
+		42:  "PLAYER_HELD_SLOT_CHANGE",
+		43:  "PLAYER_ITEM_DROP",
+		44:  "PLAYER_TRANSFER",
+		45:  "COMMAND",
+		46:  "PLAYER_DIAGNOSTICS",
+		47:  "PLAYER_FORM_RESPONSE",
+		48:  "PLAYER_DIALOGUE_RESPONSE",
+		50:  "PLAYER_INVENTORY_SLOT_CHANGE",
+		51:  "CONTAINER_OPEN",
+		52:  "CONTAINER_CLOSE",
+		53:  "VIRTUAL_INVENTORY_CLICK",
+		54:  "VIRTUAL_INVENTORY_CLOSE",
+		70:  "WORLD_LIQUID_FLOW",
+		71:  "WORLD_LIQUID_DECAY",
+		72:  "WORLD_LIQUID_HARDEN",
+		73:  "WORLD_SOUND",
+		74:  "WORLD_FIRE_SPREAD",
+		75:  "WORLD_BLOCK_BURN",
+		76:  "WORLD_CROP_TRAMPLE",
+		77:  "WORLD_LEAVES_DECAY",
+		78:  "WORLD_ENTITY_SPAWN",
+		79:  "WORLD_ENTITY_DESPAWN",
+		80:  "WORLD_EXPLOSION",
+		81:  "WORLD_CLOSE",
+		100: "PLUGIN_CIRCUIT_STATE",
 	}
 	EventType_value = map[string]int32{
-		"EVENT_TYPE_UNSPECIFIED":    0,
-		"EVENT_TYPE_ALL":            1,
-		"PLAYER_JOIN":               10,
-		"PLAYER_QUIT":               11,
-		"PLAYER_MOVE":               12,
-		"PLAYER_JUMP":               13,
-		"PLAYER_TELEPORT":           14,
-		"PLAYER_CHANGE_WORLD":       15,
-		"PLAYER_TOGGLE_SPRINT":      16,
-		"PLAYER_TOGGLE_SNEAK":       17,
-		"CHAT":                      18,
-		"PLAYER_FOOD_LOSS":          19,
-		"PLAYER_HEAL":               20,
-		"PLAYER_HURT":               21,
-		"PLAYER_DEATH":              22,
-		"PLAYER_RESPAWN":            23,
-		"PLAYER_SKIN_CHANGE":        24,
-		"PLAYER_FIRE_EXTINGUISH":    25,
-		"PLAYER_START_BREAK":        26,
-		"PLAYER_BLOCK_BREAK":        27,
-		"PLAYER_BLOCK_PLACE":        28,
-		"PLAYER_BLOCK_PICK":         29,
-		"PLAYER_ITEM_USE":           30,
-		"PLAYER_ITEM_USE_ON_BLOCK":  31,
-		"PLAYER_ITEM_USE_ON_ENTITY": 32,
-		"PLAYER_ITEM_RELEASE":       33,
-		"PLAYER_ITEM_CONSUME":       34,
-		"PLAYER_ATTACK_ENTITY":      35,
-		"PLAYER_EXPERIENCE_GAIN":    36,
-		"PLAYER_PUNCH_AIR":          37,
-		"PLAYER_SIGN_EDIT":          38,
-		"PLAYER_LECTERN_PAGE_TURN":  39,
-		"PLAYER_ITEM_DAMAGE":        40,
-		"PLAYER_ITEM_PICKUP":        41,
-		"PLAYER_HELD_SLOT_CHANGE":   42,
-		"PLAYER_ITEM_DROP":          43,
-		"PLAYER_TRANSFER":           44,
-		"COMMAND":                   45,
-		"PLAYER_DIAGNOSTICS":        46,
-		"WORLD_LIQUID_FLOW":         70,
-		"WORLD_LIQUID_DECAY":        71,
-		"WORLD_LIQUID_HARDEN":       72,
-		"WORLD_SOUND":               73,
-		"WORLD_FIRE_SPREAD":         74,
-		"WORLD_BLOCK_BURN":          75,
-		"WORLD_CROP_TRAMPLE":        76,
-		"WORLD_LEAVES_DECAY":        77,
-		"WORLD_ENTITY_SPAWN":        78,
-		"WORLD_ENTITY_DESPAWN":      79,
-		"WORLD_EXPLOSION":           80,
-		"WORLD_CLOSE":               81,
+		"EVENT_TYPE_UNSPECIFIED":       0,
+		"EVENT_TYPE_ALL":               1,
+		"PLAYER_JOIN":                  10,
+		"PLAYER_QUIT":                  11,
+		"PLAYER_MOVE":                  12,
+		"PLAYER_JUMP":                  13,
+		"PLAYER_TELEPORT":              14,
+		"PLAYER_CHANGE_WORLD":          15,
+		"PLAYER_TOGGLE_SPRINT":         16,
+		"PLAYER_TOGGLE_SNEAK":          17,
+		"CHAT":                         18,
+		"PLAYER_FOOD_LOSS":             19,
+		"PLAYER_HEAL":                  20,
+		"PLAYER_HURT":                  21,
+		"PLAYER_DEATH":                 22,
+		"PLAYER_RESPAWN":               23,
+		"PLAYER_SKIN_CHANGE":           24,
+		"PLAYER_FIRE_EXTINGUISH":       25,
+		"PLAYER_START_BREAK":           26,
+		"PLAYER_BLOCK_BREAK":           27,
+		"PLAYER_BLOCK_PLACE":           28,
+		"PLAYER_BLOCK_PICK":            29,
+		"PLAYER_ITEM_USE":              30,
+		"PLAYER_ITEM_USE_ON_BLOCK":     31,
+		"PLAYER_ITEM_USE_ON_ENTITY":    32,
+		"PLAYER_ITEM_RELEASE":          33,
+		"PLAYER_ITEM_CONSUME":          34,
+		"PLAYER_ATTACK_ENTITY":         35,
+		"PLAYER_EXPERIENCE_GAIN":       36,
+		"PLAYER_PUNCH_AIR":             37,
+		"PLAYER_SIGN_EDIT":             38,
+		"PLAYER_LECTERN_PAGE_TURN":     39,
+		"PLAYER_ITEM_DAMAGE":           40,
+		"PLAYER_ITEM_PICKUP":           41,
+		"PLAYER_HELD_SLOT_CHANGE":      42,
+		"PLAYER_ITEM_DROP":             43,
+		"PLAYER_TRANSFER":              44,
+		"COMMAND":                      45,
+		"PLAYER_DIAGNOSTICS":           46,
+		"PLAYER_FORM_RESPONSE":         47,
+		"PLAYER_DIALOGUE_RESPONSE":     48,
+		"PLAYER_INVENTORY_SLOT_CHANGE": 50,
+		"CONTAINER_OPEN":               51,
+		"CONTAINER_CLOSE":              52,
+		"VIRTUAL_INVENTORY_CLICK":      53,
+		"VIRTUAL_INVENTORY_CLOSE":      54,
+		"WORLD_LIQUID_FLOW":            70,
+		"WORLD_LIQUID_DECAY":           71,
+		"WORLD_LIQUID_HARDEN":          72,
+		"WORLD_SOUND":                  73,
+		"WORLD_FIRE_SPREAD":            74,
+		"WORLD_BLOCK_BURN":             75,
+		"WORLD_CROP_TRAMPLE":           76,
+		"WORLD_LEAVES_DECAY":           77,
+		"WORLD_ENTITY_SPAWN":           78,
+		"WORLD_ENTITY_DESPAWN":         79,
+		"WORLD_EXPLOSION":              80,
+		"WORLD_CLOSE":                  81,
+		"PLUGIN_CIRCUIT_STATE":         100,
 	}
 )
 
@@ -198,11 +283,11 @@ func (x EventType) String() string {
 }
 
 func (EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plugin_proto_enumTypes[0].Descriptor()
+	return file_plugin_proto_enumTypes[1].Descriptor()
 }
 
 func (EventType) Type() protoreflect.EnumType {
-	return &file_plugin_proto_enumTypes[0]
+	return &file_plugin_proto_enumTypes[1]
 }
 
 func (x EventType) Number() protoreflect.EnumNumber {
@@ -211,7 +296,7 @@ func (x EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventType.Descriptor instead.
 func (EventType) EnumDescriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{0}
+	return file_plugin_proto_rawDescGZIP(), []int{1}
 }
 
 type HostToPlugin struct {
@@ -224,6 +309,10 @@ type HostToPlugin struct {
 	//	*HostToPlugin_ServerInfo
 	//	*HostToPlugin_Event
 	//	*HostToPlugin_ActionResult
+	//	*HostToPlugin_EventOutcome
+	//	*HostToPlugin_EventBatch
+	//	*HostToPlugin_EventsDropped
+	//	*HostToPlugin_PluginMessage
 	Payload       isHostToPlugin_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -318,6 +407,42 @@ func (x *HostToPlugin) GetActionResult() *ActionResult {
 	return nil
 }
 
+func (x *HostToPlugin) GetEventOutcome() *EventOutcome {
+	if x != nil {
+		if x, ok := x.Payload.(*HostToPlugin_EventOutcome); ok {
+			return x.EventOutcome
+		}
+	}
+	return nil
+}
+
+func (x *HostToPlugin) GetEventBatch() *EventBatch {
+	if x != nil {
+		if x, ok := x.Payload.(*HostToPlugin_EventBatch); ok {
+			return x.EventBatch
+		}
+	}
+	return nil
+}
+
+func (x *HostToPlugin) GetEventsDropped() *EventsDropped {
+	if x != nil {
+		if x, ok := x.Payload.(*HostToPlugin_EventsDropped); ok {
+			return x.EventsDropped
+		}
+	}
+	return nil
+}
+
+func (x *HostToPlugin) GetPluginMessage() *PluginMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HostToPlugin_PluginMessage); ok {
+			return x.PluginMessage
+		}
+	}
+	return nil
+}
+
 type isHostToPlugin_Payload interface {
 	isHostToPlugin_Payload()
 }
@@ -342,6 +467,22 @@ type HostToPlugin_ActionResult struct {
 	ActionResult *ActionResult `protobuf:"bytes,21,opt,name=action_result,json=actionResult,proto3,oneof"`
 }
 
+type HostToPlugin_EventOutcome struct {
+	EventOutcome *EventOutcome `protobuf:"bytes,22,opt,name=event_outcome,json=eventOutcome,proto3,oneof"`
+}
+
+type HostToPlugin_EventBatch struct {
+	EventBatch *EventBatch `protobuf:"bytes,23,opt,name=event_batch,json=eventBatch,proto3,oneof"` // only sent to plugins that set PluginHello.supports_event_batch
+}
+
+type HostToPlugin_EventsDropped struct {
+	EventsDropped *EventsDropped `protobuf:"bytes,24,opt,name=events_dropped,json=eventsDropped,proto3,oneof"`
+}
+
+type HostToPlugin_PluginMessage struct {
+	PluginMessage *PluginMessage `protobuf:"bytes,30,opt,name=plugin_message,json=pluginMessage,proto3,oneof"`
+}
+
 func (*HostToPlugin_Hello) isHostToPlugin_Payload() {}
 
 func (*HostToPlugin_Shutdown) isHostToPlugin_Payload() {}
@@ -352,6 +493,14 @@ func (*HostToPlugin_Event) isHostToPlugin_Payload() {}
 
 func (*HostToPlugin_ActionResult) isHostToPlugin_Payload() {}
 
+func (*HostToPlugin_EventOutcome) isHostToPlugin_Payload() {}
+
+func (*HostToPlugin_EventBatch) isHostToPlugin_Payload() {}
+
+func (*HostToPlugin_EventsDropped) isHostToPlugin_Payload() {}
+
+func (*HostToPlugin_PluginMessage) isHostToPlugin_Payload() {}
+
 type ServerInformationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -487,6 +636,7 @@ func (x *HostHello) GetBootId() string {
 type HostShutdown struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	GracePeriodMs int64                  `protobuf:"varint,2,opt,name=grace_period_ms,json=gracePeriodMs,proto3" json:"grace_period_ms,omitempty"` // time the plugin has to flush state and reply with PluginShutdownAck
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -528,11 +678,117 @@ func (x *HostShutdown) GetReason() string {
 	return ""
 }
 
+func (x *HostShutdown) GetGracePeriodMs() int64 {
+	if x != nil {
+		return x.GracePeriodMs
+	}
+	return 0
+}
+
+// PluginShutdownAck tells the host the plugin finished shutting down. Actions sent before it are still applied.
+type PluginShutdownAck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginShutdownAck) Reset() {
+	*x = PluginShutdownAck{}
+	mi := &file_plugin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginShutdownAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginShutdownAck) ProtoMessage() {}
+
+func (x *PluginShutdownAck) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginShutdownAck.ProtoReflect.Descriptor instead.
+func (*PluginShutdownAck) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{5}
+}
+
+// PluginCircuitStateEvent reports a plugin's event circuit breaker opening or closing.
+type PluginCircuitStateEvent struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PluginId            string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Open                bool                   `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"` // true while the host sends the plugin events without waiting for results
+	ConsecutiveTimeouts int32                  `protobuf:"varint,3,opt,name=consecutive_timeouts,json=consecutiveTimeouts,proto3" json:"consecutive_timeouts,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PluginCircuitStateEvent) Reset() {
+	*x = PluginCircuitStateEvent{}
+	mi := &file_plugin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginCircuitStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginCircuitStateEvent) ProtoMessage() {}
+
+func (x *PluginCircuitStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginCircuitStateEvent.ProtoReflect.Descriptor instead.
+func (*PluginCircuitStateEvent) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PluginCircuitStateEvent) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *PluginCircuitStateEvent) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *PluginCircuitStateEvent) GetConsecutiveTimeouts() int32 {
+	if x != nil {
+		return x.ConsecutiveTimeouts
+	}
+	return 0
+}
+
 type EventEnvelope struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	EventId         string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
 	Type            EventType              `protobuf:"varint,2,opt,name=type,proto3,enum=df.plugin.EventType" json:"type,omitempty"`
 	ExpectsResponse bool                   `protobuf:"varint,3,opt,name=expects_response,json=expectsResponse,proto3" json:"expects_response,omitempty"` // If an event can be cancelled or mutated it expects an acknowledgement.
+	Cancelled       bool                   `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`                                    // Set on read-only deliveries of an event that an earlier plugin cancelled.
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*EventEnvelope_PlayerJoin
@@ -572,6 +828,14 @@ type EventEnvelope struct {
 	//	*EventEnvelope_PlayerTransfer
 	//	*EventEnvelope_Command
 	//	*EventEnvelope_PlayerDiagnostics
+	//	*EventEnvelope_PlayerFormResponse
+	//	*EventEnvelope_PlayerDialogueResponse
+	//	*EventEnvelope_PlayerMoveBatch
+	//	*EventEnvelope_PlayerInventorySlotChange
+	//	*EventEnvelope_ContainerOpen
+	//	*EventEnvelope_ContainerClose
+	//	*EventEnvelope_VirtualInventoryClick
+	//	*EventEnvelope_VirtualInventoryClose
 	//	*EventEnvelope_WorldLiquidFlow
 	//	*EventEnvelope_WorldLiquidDecay
 	//	*EventEnvelope_WorldLiquidHarden
@@ -584,6 +848,7 @@ type EventEnvelope struct {
 	//	*EventEnvelope_WorldEntityDespawn
 	//	*EventEnvelope_WorldExplosion
 	//	*EventEnvelope_WorldClose
+	//	*EventEnvelope_PluginCircuitState
 	Payload       isEventEnvelope_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -591,7 +856,7 @@ type EventEnvelope struct {
 
 func (x *EventEnvelope) Reset() {
 	*x = EventEnvelope{}
-	mi := &file_plugin_proto_msgTypes[5]
+	mi := &file_plugin_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -603,7 +868,7 @@ func (x *EventEnvelope) String() string {
 func (*EventEnvelope) ProtoMessage() {}
 
 func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[5]
+	mi := &file_plugin_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,7 +881,7 @@ func (x *EventEnvelope) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventEnvelope.ProtoReflect.Descriptor instead.
 func (*EventEnvelope) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{5}
+	return file_plugin_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EventEnvelope) GetEventId() string {
@@ -640,6 +905,13 @@ func (x *EventEnvelope) GetExpectsResponse() bool {
 	return false
 }
 
+func (x *EventEnvelope) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
 func (x *EventEnvelope) GetPayload() isEventEnvelope_Payload {
 	if x != nil {
 		return x.Payload
@@ -980,6 +1252,78 @@ func (x *EventEnvelope) GetPlayerDiagnostics() *PlayerDiagnosticsEvent {
 	return nil
 }
 
+func (x *EventEnvelope) GetPlayerFormResponse() *PlayerFormResponseEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_PlayerFormResponse); ok {
+			return x.PlayerFormResponse
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPlayerDialogueResponse() *PlayerDialogueResponseEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_PlayerDialogueResponse); ok {
+			return x.PlayerDialogueResponse
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPlayerMoveBatch() *PlayerMoveBatch {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_PlayerMoveBatch); ok {
+			return x.PlayerMoveBatch
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetPlayerInventorySlotChange() *PlayerInventorySlotChangeEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_PlayerInventorySlotChange); ok {
+			return x.PlayerInventorySlotChange
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetContainerOpen() *ContainerOpenEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_ContainerOpen); ok {
+			return x.ContainerOpen
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetContainerClose() *ContainerCloseEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_ContainerClose); ok {
+			return x.ContainerClose
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetVirtualInventoryClick() *VirtualInventoryClickEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_VirtualInventoryClick); ok {
+			return x.VirtualInventoryClick
+		}
+	}
+	return nil
+}
+
+func (x *EventEnvelope) GetVirtualInventoryClose() *VirtualInventoryCloseEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_VirtualInventoryClose); ok {
+			return x.VirtualInventoryClose
+		}
+	}
+	return nil
+}
+
 func (x *EventEnvelope) GetWorldLiquidFlow() *WorldLiquidFlowEvent {
 	if x != nil {
 		if x, ok := x.Payload.(*EventEnvelope_WorldLiquidFlow); ok {
@@ -1088,6 +1432,15 @@ func (x *EventEnvelope) GetWorldClose() *WorldCloseEvent {
 	return nil
 }
 
+func (x *EventEnvelope) GetPluginCircuitState() *PluginCircuitStateEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventEnvelope_PluginCircuitState); ok {
+			return x.PluginCircuitState
+		}
+	}
+	return nil
+}
+
 type isEventEnvelope_Payload interface {
 	isEventEnvelope_Payload()
 }
@@ -1240,6 +1593,38 @@ type EventEnvelope_PlayerDiagnostics struct {
 	PlayerDiagnostics *PlayerDiagnosticsEvent `protobuf:"bytes,46,opt,name=player_diagnostics,json=playerDiagnostics,proto3,oneof"`
 }
 
+type EventEnvelope_PlayerFormResponse struct {
+	PlayerFormResponse *PlayerFormResponseEvent `protobuf:"bytes,47,opt,name=player_form_response,json=playerFormResponse,proto3,oneof"`
+}
+
+type EventEnvelope_PlayerDialogueResponse struct {
+	PlayerDialogueResponse *PlayerDialogueResponseEvent `protobuf:"bytes,48,opt,name=player_dialogue_response,json=playerDialogueResponse,proto3,oneof"`
+}
+
+type EventEnvelope_PlayerMoveBatch struct {
+	PlayerMoveBatch *PlayerMoveBatch `protobuf:"bytes,49,opt,name=player_move_batch,json=playerMoveBatch,proto3,oneof"` // PLAYER_MOVE for observe-only subscriptions
+}
+
+type EventEnvelope_PlayerInventorySlotChange struct {
+	PlayerInventorySlotChange *PlayerInventorySlotChangeEvent `protobuf:"bytes,50,opt,name=player_inventory_slot_change,json=playerInventorySlotChange,proto3,oneof"`
+}
+
+type EventEnvelope_ContainerOpen struct {
+	ContainerOpen *ContainerOpenEvent `protobuf:"bytes,51,opt,name=container_open,json=containerOpen,proto3,oneof"`
+}
+
+type EventEnvelope_ContainerClose struct {
+	ContainerClose *ContainerCloseEvent `protobuf:"bytes,52,opt,name=container_close,json=containerClose,proto3,oneof"`
+}
+
+type EventEnvelope_VirtualInventoryClick struct {
+	VirtualInventoryClick *VirtualInventoryClickEvent `protobuf:"bytes,53,opt,name=virtual_inventory_click,json=virtualInventoryClick,proto3,oneof"`
+}
+
+type EventEnvelope_VirtualInventoryClose struct {
+	VirtualInventoryClose *VirtualInventoryCloseEvent `protobuf:"bytes,54,opt,name=virtual_inventory_close,json=virtualInventoryClose,proto3,oneof"`
+}
+
 type EventEnvelope_WorldLiquidFlow struct {
 	WorldLiquidFlow *WorldLiquidFlowEvent `protobuf:"bytes,70,opt,name=world_liquid_flow,json=worldLiquidFlow,proto3,oneof"`
 }
@@ -1288,6 +1673,10 @@ type EventEnvelope_WorldClose struct {
 	WorldClose *WorldCloseEvent `protobuf:"bytes,81,opt,name=world_close,json=worldClose,proto3,oneof"`
 }
 
+type EventEnvelope_PluginCircuitState struct {
+	PluginCircuitState *PluginCircuitStateEvent `protobuf:"bytes,100,opt,name=plugin_circuit_state,json=pluginCircuitState,proto3,oneof"`
+}
+
 func (*EventEnvelope_PlayerJoin) isEventEnvelope_Payload() {}
 
 func (*EventEnvelope_PlayerQuit) isEventEnvelope_Payload() {}
@@ -1362,6 +1751,22 @@ func (*EventEnvelope_Command) isEventEnvelope_Payload() {}
 
 func (*EventEnvelope_PlayerDiagnostics) isEventEnvelope_Payload() {}
 
+func (*EventEnvelope_PlayerFormResponse) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PlayerDialogueResponse) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PlayerMoveBatch) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_PlayerInventorySlotChange) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_ContainerOpen) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_ContainerClose) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_VirtualInventoryClick) isEventEnvelope_Payload() {}
+
+func (*EventEnvelope_VirtualInventoryClose) isEventEnvelope_Payload() {}
+
 func (*EventEnvelope_WorldLiquidFlow) isEventEnvelope_Payload() {}
 
 func (*EventEnvelope_WorldLiquidDecay) isEventEnvelope_Payload() {}
@@ -1386,17 +1791,24 @@ func (*EventEnvelope_WorldExplosion) isEventEnvelope_Payload() {}
 
 func (*EventEnvelope_WorldClose) isEventEnvelope_Payload() {}
 
+func (*EventEnvelope_PluginCircuitState) isEventEnvelope_Payload() {}
+
 type PluginToHost struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	PluginId string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	// Connection secret, required on the first message unless sent as "df-plugin-token" gRPC metadata.
+	// Launched plugins read it from DF_PLUGIN_TOKEN; external plugins use their configured token.
+	AuthToken string `protobuf:"bytes,2,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*PluginToHost_Hello
 	//	*PluginToHost_Subscribe
 	//	*PluginToHost_ServerInfo
+	//	*PluginToHost_ShutdownAck
 	//	*PluginToHost_Actions
 	//	*PluginToHost_Log
 	//	*PluginToHost_EventResult
+	//	*PluginToHost_PluginMessage
 	Payload       isPluginToHost_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1404,7 +1816,7 @@ type PluginToHost struct {
 
 func (x *PluginToHost) Reset() {
 	*x = PluginToHost{}
-	mi := &file_plugin_proto_msgTypes[6]
+	mi := &file_plugin_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1416,7 +1828,7 @@ func (x *PluginToHost) String() string {
 func (*PluginToHost) ProtoMessage() {}
 
 func (x *PluginToHost) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[6]
+	mi := &file_plugin_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1429,7 +1841,7 @@ func (x *PluginToHost) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginToHost.ProtoReflect.Descriptor instead.
 func (*PluginToHost) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{6}
+	return file_plugin_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PluginToHost) GetPluginId() string {
@@ -1439,6 +1851,13 @@ func (x *PluginToHost) GetPluginId() string {
 	return ""
 }
 
+func (x *PluginToHost) GetAuthToken() string {
+	if x != nil {
+		return x.AuthToken
+	}
+	return ""
+}
+
 func (x *PluginToHost) GetPayload() isPluginToHost_Payload {
 	if x != nil {
 		return x.Payload
@@ -1473,6 +1892,15 @@ func (x *PluginToHost) GetServerInfo() *ServerInformationRequest {
 	return nil
 }
 
+func (x *PluginToHost) GetShutdownAck() *PluginShutdownAck {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginToHost_ShutdownAck); ok {
+			return x.ShutdownAck
+		}
+	}
+	return nil
+}
+
 func (x *PluginToHost) GetActions() *ActionBatch {
 	if x != nil {
 		if x, ok := x.Payload.(*PluginToHost_Actions); ok {
@@ -1500,6 +1928,15 @@ func (x *PluginToHost) GetEventResult() *EventResult {
 	return nil
 }
 
+func (x *PluginToHost) GetPluginMessage() *PluginMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*PluginToHost_PluginMessage); ok {
+			return x.PluginMessage
+		}
+	}
+	return nil
+}
+
 type isPluginToHost_Payload interface {
 	isPluginToHost_Payload()
 }
@@ -1516,6 +1953,10 @@ type PluginToHost_ServerInfo struct {
 	ServerInfo *ServerInformationRequest `protobuf:"bytes,12,opt,name=server_info,json=serverInfo,proto3,oneof"`
 }
 
+type PluginToHost_ShutdownAck struct {
+	ShutdownAck *PluginShutdownAck `protobuf:"bytes,13,opt,name=shutdown_ack,json=shutdownAck,proto3,oneof"`
+}
+
 type PluginToHost_Actions struct {
 	Actions *ActionBatch `protobuf:"bytes,20,opt,name=actions,proto3,oneof"`
 }
@@ -1528,33 +1969,144 @@ type PluginToHost_EventResult struct {
 	EventResult *EventResult `protobuf:"bytes,40,opt,name=event_result,json=eventResult,proto3,oneof"`
 }
 
+type PluginToHost_PluginMessage struct {
+	PluginMessage *PluginMessage `protobuf:"bytes,50,opt,name=plugin_message,json=pluginMessage,proto3,oneof"`
+}
+
 func (*PluginToHost_Hello) isPluginToHost_Payload() {}
 
 func (*PluginToHost_Subscribe) isPluginToHost_Payload() {}
 
 func (*PluginToHost_ServerInfo) isPluginToHost_Payload() {}
 
+func (*PluginToHost_ShutdownAck) isPluginToHost_Payload() {}
+
 func (*PluginToHost_Actions) isPluginToHost_Payload() {}
 
 func (*PluginToHost_Log) isPluginToHost_Payload() {}
 
 func (*PluginToHost_EventResult) isPluginToHost_Payload() {}
 
-type PluginHello struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version       string                   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	ApiVersion    string                   `protobuf:"bytes,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	Commands      []*CommandSpec           `protobuf:"bytes,4,rep,name=commands,proto3" json:"commands,omitempty"`
-	CustomItems   []*CustomItemDefinition  `protobuf:"bytes,5,rep,name=custom_items,json=customItems,proto3" json:"custom_items,omitempty"`
-	CustomBlocks  []*CustomBlockDefinition `protobuf:"bytes,6,rep,name=custom_blocks,json=customBlocks,proto3" json:"custom_blocks,omitempty"`
+func (*PluginToHost_PluginMessage) isPluginToHost_Payload() {}
+
+// EventsDropped tells a plugin that events for it were dropped because its send queue was full.
+// It is sent as soon as the queue has room again.
+type EventsDropped struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         uint64                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"` // events dropped since the previous notice
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // events dropped since the plugin was loaded
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *EventsDropped) Reset() {
+	*x = EventsDropped{}
+	mi := &file_plugin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsDropped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsDropped) ProtoMessage() {}
+
+func (x *EventsDropped) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsDropped.ProtoReflect.Descriptor instead.
+func (*EventsDropped) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventsDropped) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EventsDropped) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// EventBatch carries events that were queued together, in the order they were emitted. It never
+// contains events that expect a response.
+type EventBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*EventEnvelope       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventBatch) Reset() {
+	*x = EventBatch{}
+	mi := &file_plugin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventBatch) ProtoMessage() {}
+
+func (x *EventBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventBatch.ProtoReflect.Descriptor instead.
+func (*EventBatch) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventBatch) GetEvents() []*EventEnvelope {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PluginHello struct {
+	state        protoimpl.MessageState   `protogen:"open.v1"`
+	Name         string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version      string                   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ApiVersion   string                   `protobuf:"bytes,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Commands     []*CommandSpec           `protobuf:"bytes,4,rep,name=commands,proto3" json:"commands,omitempty"`
+	CustomItems  []*CustomItemDefinition  `protobuf:"bytes,5,rep,name=custom_items,json=customItems,proto3" json:"custom_items,omitempty"`
+	CustomBlocks []*CustomBlockDefinition `protobuf:"bytes,6,rep,name=custom_blocks,json=customBlocks,proto3" json:"custom_blocks,omitempty"`
+	// The plugin accepts HostToPlugin.event_batch. Events that do not expect a response may then be
+	// delivered several at a time.
+	SupportsEventBatch bool `protobuf:"varint,7,opt,name=supports_event_batch,json=supportsEventBatch,proto3" json:"supports_event_batch,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
 func (x *PluginHello) Reset() {
 	*x = PluginHello{}
-	mi := &file_plugin_proto_msgTypes[7]
+	mi := &file_plugin_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1566,7 +2118,7 @@ func (x *PluginHello) String() string {
 func (*PluginHello) ProtoMessage() {}
 
 func (x *PluginHello) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[7]
+	mi := &file_plugin_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1579,7 +2131,7 @@ func (x *PluginHello) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PluginHello.ProtoReflect.Descriptor instead.
 func (*PluginHello) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{7}
+	return file_plugin_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PluginHello) GetName() string {
@@ -1624,6 +2176,13 @@ func (x *PluginHello) GetCustomBlocks() []*CustomBlockDefinition {
 	return nil
 }
 
+func (x *PluginHello) GetSupportsEventBatch() bool {
+	if x != nil {
+		return x.SupportsEventBatch
+	}
+	return false
+}
+
 type LogMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
@@ -1634,7 +2193,7 @@ type LogMessage struct {
 
 func (x *LogMessage) Reset() {
 	*x = LogMessage{}
-	mi := &file_plugin_proto_msgTypes[8]
+	mi := &file_plugin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1646,7 +2205,7 @@ func (x *LogMessage) String() string {
 func (*LogMessage) ProtoMessage() {}
 
 func (x *LogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[8]
+	mi := &file_plugin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +2218,7 @@ func (x *LogMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{8}
+	return file_plugin_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LogMessage) GetLevel() string {
@@ -1679,13 +2238,15 @@ func (x *LogMessage) GetMessage() string {
 type EventSubscribe struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []EventType            `protobuf:"varint,1,rep,packed,name=events,proto3,enum=df.plugin.EventType" json:"events,omitempty"`
+	Channels      []string               `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`           // PluginMessage channels to receive
+	Subscriptions []*EventSubscription   `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"` // per-event options; listed events are subscribed as well
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EventSubscribe) Reset() {
 	*x = EventSubscribe{}
-	mi := &file_plugin_proto_msgTypes[9]
+	mi := &file_plugin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1697,7 +2258,7 @@ func (x *EventSubscribe) String() string {
 func (*EventSubscribe) ProtoMessage() {}
 
 func (x *EventSubscribe) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_msgTypes[9]
+	mi := &file_plugin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1710,7 +2271,7 @@ func (x *EventSubscribe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EventSubscribe.ProtoReflect.Descriptor instead.
 func (*EventSubscribe) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_rawDescGZIP(), []int{9}
+	return file_plugin_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EventSubscribe) GetEvents() []EventType {
@@ -1720,11 +2281,507 @@ func (x *EventSubscribe) GetEvents() []EventType {
 	return nil
 }
 
+func (x *EventSubscribe) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *EventSubscribe) GetSubscriptions() []*EventSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type EventSubscription struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Event    EventType              `protobuf:"varint,1,opt,name=event,proto3,enum=df.plugin.EventType" json:"event,omitempty"`
+	Priority EventPriority          `protobuf:"varint,2,opt,name=priority,proto3,enum=df.plugin.EventPriority" json:"priority,omitempty"`
+	// Keep receiving the event (read-only, with EventEnvelope.cancelled set) after an earlier
+	// priority group cancelled it, and receive an EventOutcome once dispatch completes.
+	ReceiveCancelled bool `protobuf:"varint,3,opt,name=receive_cancelled,json=receiveCancelled,proto3" json:"receive_cancelled,omitempty"`
+	// Only deliver events that match the filter. Evaluated by the host before the event is sent.
+	Filter *EventFilter `protobuf:"bytes,4,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	// Drop events that change too little or arrive too often. Throttled events are not sent to the
+	// plugin at all, so it cannot cancel or mutate them.
+	Throttle *EventThrottle `protobuf:"bytes,5,opt,name=throttle,proto3,oneof" json:"throttle,omitempty"`
+	// Never wait for this plugin: the event is delivered read-only with the final outcome, like
+	// EVENT_PRIORITY_MONITOR. PLAYER_MOVE is coalesced into one PlayerMoveBatch per tick.
+	ObserveOnly   bool `protobuf:"varint,6,opt,name=observe_only,json=observeOnly,proto3" json:"observe_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSubscription) Reset() {
+	*x = EventSubscription{}
+	mi := &file_plugin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSubscription) ProtoMessage() {}
+
+func (x *EventSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSubscription.ProtoReflect.Descriptor instead.
+func (*EventSubscription) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EventSubscription) GetEvent() EventType {
+	if x != nil {
+		return x.Event
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *EventSubscription) GetPriority() EventPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return EventPriority_EVENT_PRIORITY_NORMAL
+}
+
+func (x *EventSubscription) GetReceiveCancelled() bool {
+	if x != nil {
+		return x.ReceiveCancelled
+	}
+	return false
+}
+
+func (x *EventSubscription) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *EventSubscription) GetThrottle() *EventThrottle {
+	if x != nil {
+		return x.Throttle
+	}
+	return nil
+}
+
+func (x *EventSubscription) GetObserveOnly() bool {
+	if x != nil {
+		return x.ObserveOnly
+	}
+	return false
+}
+
+// EventThrottle limits high-frequency events such as PLAYER_MOVE, WORLD_SOUND and
+// PLAYER_DIAGNOSTICS. Limits are tracked per player, or per world for events without a player.
+type EventThrottle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Minimum distance in blocks from the position of the last delivered event.
+	MinDistance float64 `protobuf:"fixed64,1,opt,name=min_distance,json=minDistance,proto3" json:"min_distance,omitempty"`
+	// Minimum yaw or pitch change in degrees from the last delivered event. When both deltas are
+	// set, an event passes if it exceeds either of them.
+	MinAngle float64 `protobuf:"fixed64,2,opt,name=min_angle,json=minAngle,proto3" json:"min_angle,omitempty"`
+	// Maximum events per second; 0 is unlimited.
+	MaxPerSecond  float64 `protobuf:"fixed64,3,opt,name=max_per_second,json=maxPerSecond,proto3" json:"max_per_second,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventThrottle) Reset() {
+	*x = EventThrottle{}
+	mi := &file_plugin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventThrottle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventThrottle) ProtoMessage() {}
+
+func (x *EventThrottle) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventThrottle.ProtoReflect.Descriptor instead.
+func (*EventThrottle) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EventThrottle) GetMinDistance() float64 {
+	if x != nil {
+		return x.MinDistance
+	}
+	return 0
+}
+
+func (x *EventThrottle) GetMinAngle() float64 {
+	if x != nil {
+		return x.MinAngle
+	}
+	return 0
+}
+
+func (x *EventThrottle) GetMaxPerSecond() float64 {
+	if x != nil {
+		return x.MaxPerSecond
+	}
+	return 0
+}
+
+// EventFilter narrows a subscription. Every non-empty criterion must match; an event that does not
+// carry the filtered value (for example a world filter on PLAYER_QUIT) does not match.
+type EventFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Worlds        []string               `protobuf:"bytes,1,rep,name=worlds,proto3" json:"worlds,omitempty"` // world names, IDs or dimensions ("overworld", "nether", "end")
+	PlayerUuids   []string               `protobuf:"bytes,2,rep,name=player_uuids,json=playerUuids,proto3" json:"player_uuids,omitempty"`
+	BlockNames    []string               `protobuf:"bytes,3,rep,name=block_names,json=blockNames,proto3" json:"block_names,omitempty"` // e.g. "minecraft:diamond_ore"; the "minecraft:" prefix is optional
+	ItemNames     []string               `protobuf:"bytes,4,rep,name=item_names,json=itemNames,proto3" json:"item_names,omitempty"`
+	Commands      []string               `protobuf:"bytes,5,rep,name=commands,proto3" json:"commands,omitempty"`   // command names without the leading "/"
+	Region        *BBox                  `protobuf:"bytes,6,opt,name=region,proto3,oneof" json:"region,omitempty"` // inclusive bounds checked against the event position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventFilter) Reset() {
+	*x = EventFilter{}
+	mi := &file_plugin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFilter) ProtoMessage() {}
+
+func (x *EventFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventFilter.ProtoReflect.Descriptor instead.
+func (*EventFilter) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EventFilter) GetWorlds() []string {
+	if x != nil {
+		return x.Worlds
+	}
+	return nil
+}
+
+func (x *EventFilter) GetPlayerUuids() []string {
+	if x != nil {
+		return x.PlayerUuids
+	}
+	return nil
+}
+
+func (x *EventFilter) GetBlockNames() []string {
+	if x != nil {
+		return x.BlockNames
+	}
+	return nil
+}
+
+func (x *EventFilter) GetItemNames() []string {
+	if x != nil {
+		return x.ItemNames
+	}
+	return nil
+}
+
+func (x *EventFilter) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+func (x *EventFilter) GetRegion() *BBox {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+// EventOutcome reports how a cancellable event was resolved after every plugin was called.
+type EventOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Type          EventType              `protobuf:"varint,2,opt,name=type,proto3,enum=df.plugin.EventType" json:"type,omitempty"`
+	Cancelled     bool                   `protobuf:"varint,3,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	CancelledBy   string                 `protobuf:"bytes,4,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"` // plugin ID of the first plugin that cancelled
+	Mutations     []*AppliedMutation     `protobuf:"bytes,5,rep,name=mutations,proto3" json:"mutations,omitempty"`                        // in application order; empty when cancelled
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventOutcome) Reset() {
+	*x = EventOutcome{}
+	mi := &file_plugin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOutcome) ProtoMessage() {}
+
+func (x *EventOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventOutcome.ProtoReflect.Descriptor instead.
+func (*EventOutcome) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EventOutcome) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventOutcome) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *EventOutcome) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *EventOutcome) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+func (x *EventOutcome) GetMutations() []*AppliedMutation {
+	if x != nil {
+		return x.Mutations
+	}
+	return nil
+}
+
+type AppliedMutation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PluginId      string                 `protobuf:"bytes,1,opt,name=plugin_id,json=pluginId,proto3" json:"plugin_id,omitempty"`
+	Result        *EventResult           `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedMutation) Reset() {
+	*x = AppliedMutation{}
+	mi := &file_plugin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedMutation) ProtoMessage() {}
+
+func (x *AppliedMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedMutation.ProtoReflect.Descriptor instead.
+func (*AppliedMutation) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AppliedMutation) GetPluginId() string {
+	if x != nil {
+		return x.PluginId
+	}
+	return ""
+}
+
+func (x *AppliedMutation) GetResult() *EventResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// PluginMessage is routed by the host from one plugin to another, either to
+// target_plugin_id or to every plugin subscribed to channel.
+type PluginMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MessageId      string                 `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                  // assigned by the sender; replies reference it in reply_to
+	SourcePluginId string                 `protobuf:"bytes,2,opt,name=source_plugin_id,json=sourcePluginId,proto3" json:"source_plugin_id,omitempty"` // set by the host, empty for host-generated errors
+	TargetPluginId string                 `protobuf:"bytes,3,opt,name=target_plugin_id,json=targetPluginId,proto3" json:"target_plugin_id,omitempty"`
+	Channel        string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload        []byte                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	ExpectsReply   bool                   `protobuf:"varint,6,opt,name=expects_reply,json=expectsReply,proto3" json:"expects_reply,omitempty"` // request/reply: the first reply is forwarded to the sender
+	ReplyTo        string                 `protobuf:"bytes,7,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`                 // message_id of the request being answered; replies target the request source_plugin_id
+	TimeoutMs      int64                  `protobuf:"varint,8,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`          // request timeout; 0 uses the host default
+	Error          *string                `protobuf:"bytes,9,opt,name=error,proto3,oneof" json:"error,omitempty"`                              // set by the host when routing fails or a request times out
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PluginMessage) Reset() {
+	*x = PluginMessage{}
+	mi := &file_plugin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginMessage) ProtoMessage() {}
+
+func (x *PluginMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginMessage.ProtoReflect.Descriptor instead.
+func (*PluginMessage) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PluginMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *PluginMessage) GetSourcePluginId() string {
+	if x != nil {
+		return x.SourcePluginId
+	}
+	return ""
+}
+
+func (x *PluginMessage) GetTargetPluginId() string {
+	if x != nil {
+		return x.TargetPluginId
+	}
+	return ""
+}
+
+func (x *PluginMessage) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PluginMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PluginMessage) GetExpectsReply() bool {
+	if x != nil {
+		return x.ExpectsReply
+	}
+	return false
+}
+
+func (x *PluginMessage) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *PluginMessage) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *PluginMessage) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
 var File_plugin_proto protoreflect.FileDescriptor
 
 const file_plugin_proto_rawDesc = "" +
 	"\n" +
-	"\fplugin.proto\x12\tdf.plugin\x1a\x13player_events.proto\x1a\x12world_events.proto\x1a\rcommand.proto\x1a\ractions.proto\x1a\x0fmutations.proto\x1a\fcommon.proto\x1a\x14action_results.proto\"\xd6\x02\n" +
+	"\fplugin.proto\x12\tdf.plugin\x1a\x13player_events.proto\x1a\x12world_events.proto\x1a\rcommand.proto\x1a\ractions.proto\x1a\x0fmutations.proto\x1a\fcommon.proto\x1a\x14action_results.proto\"\xd6\x04\n" +
 	"\fHostToPlugin\x12\x1b\n" +
 	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12,\n" +
 	"\x05hello\x18\n" +
@@ -1733,7 +2790,12 @@ const file_plugin_proto_rawDesc = "" +
 	"\vserver_info\x18\f \x01(\v2$.df.plugin.ServerInformationResponseH\x00R\n" +
 	"serverInfo\x120\n" +
 	"\x05event\x18\x14 \x01(\v2\x18.df.plugin.EventEnvelopeH\x00R\x05event\x12>\n" +
-	"\raction_result\x18\x15 \x01(\v2\x17.df.plugin.ActionResultH\x00R\factionResultB\t\n" +
+	"\raction_result\x18\x15 \x01(\v2\x17.df.plugin.ActionResultH\x00R\factionResult\x12>\n" +
+	"\revent_outcome\x18\x16 \x01(\v2\x17.df.plugin.EventOutcomeH\x00R\feventOutcome\x128\n" +
+	"\vevent_batch\x18\x17 \x01(\v2\x15.df.plugin.EventBatchH\x00R\n" +
+	"eventBatch\x12A\n" +
+	"\x0eevents_dropped\x18\x18 \x01(\v2\x18.df.plugin.EventsDroppedH\x00R\reventsDropped\x12A\n" +
+	"\x0eplugin_message\x18\x1e \x01(\v2\x18.df.plugin.PluginMessageH\x00R\rpluginMessageB\t\n" +
 	"\apayload\"\x1a\n" +
 	"\x18ServerInformationRequest\"5\n" +
 	"\x19ServerInformationResponse\x12\x18\n" +
@@ -1741,13 +2803,20 @@ const file_plugin_proto_rawDesc = "" +
 	"\tHostHello\x12\x1f\n" +
 	"\vapi_version\x18\x01 \x01(\tR\n" +
 	"apiVersion\x12\x17\n" +
-	"\aboot_id\x18\x02 \x01(\tR\x06bootId\"&\n" +
+	"\aboot_id\x18\x02 \x01(\tR\x06bootId\"N\n" +
 	"\fHostShutdown\x12\x16\n" +
-	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xe6\x1e\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12&\n" +
+	"\x0fgrace_period_ms\x18\x02 \x01(\x03R\rgracePeriodMs\"\x13\n" +
+	"\x11PluginShutdownAck\"}\n" +
+	"\x17PluginCircuitStateEvent\x12\x1b\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\bR\x04open\x121\n" +
+	"\x14consecutive_timeouts\x18\x03 \x01(\x05R\x13consecutiveTimeouts\"\xa5%\n" +
 	"\rEventEnvelope\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12(\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x14.df.plugin.EventTypeR\x04type\x12)\n" +
-	"\x10expects_response\x18\x03 \x01(\bR\x0fexpectsResponse\x12=\n" +
+	"\x10expects_response\x18\x03 \x01(\bR\x0fexpectsResponse\x12\x1c\n" +
+	"\tcancelled\x18\x04 \x01(\bR\tcancelled\x12=\n" +
 	"\vplayer_join\x18\n" +
 	" \x01(\v2\x1a.df.plugin.PlayerJoinEventH\x00R\n" +
 	"playerJoin\x12=\n" +
@@ -1792,7 +2861,15 @@ const file_plugin_proto_rawDesc = "" +
 	"\x10player_item_drop\x18+ \x01(\v2\x1e.df.plugin.PlayerItemDropEventH\x00R\x0eplayerItemDrop\x12I\n" +
 	"\x0fplayer_transfer\x18, \x01(\v2\x1e.df.plugin.PlayerTransferEventH\x00R\x0eplayerTransfer\x123\n" +
 	"\acommand\x18- \x01(\v2\x17.df.plugin.CommandEventH\x00R\acommand\x12R\n" +
-	"\x12player_diagnostics\x18. \x01(\v2!.df.plugin.PlayerDiagnosticsEventH\x00R\x11playerDiagnostics\x12M\n" +
+	"\x12player_diagnostics\x18. \x01(\v2!.df.plugin.PlayerDiagnosticsEventH\x00R\x11playerDiagnostics\x12V\n" +
+	"\x14player_form_response\x18/ \x01(\v2\".df.plugin.PlayerFormResponseEventH\x00R\x12playerFormResponse\x12b\n" +
+	"\x18player_dialogue_response\x180 \x01(\v2&.df.plugin.PlayerDialogueResponseEventH\x00R\x16playerDialogueResponse\x12H\n" +
+	"\x11player_move_batch\x181 \x01(\v2\x1a.df.plugin.PlayerMoveBatchH\x00R\x0fplayerMoveBatch\x12l\n" +
+	"\x1cplayer_inventory_slot_change\x182 \x01(\v2).df.plugin.PlayerInventorySlotChangeEventH\x00R\x19playerInventorySlotChange\x12F\n" +
+	"\x0econtainer_open\x183 \x01(\v2\x1d.df.plugin.ContainerOpenEventH\x00R\rcontainerOpen\x12I\n" +
+	"\x0fcontainer_close\x184 \x01(\v2\x1e.df.plugin.ContainerCloseEventH\x00R\x0econtainerClose\x12_\n" +
+	"\x17virtual_inventory_click\x185 \x01(\v2%.df.plugin.VirtualInventoryClickEventH\x00R\x15virtualInventoryClick\x12_\n" +
+	"\x17virtual_inventory_close\x186 \x01(\v2%.df.plugin.VirtualInventoryCloseEventH\x00R\x15virtualInventoryClose\x12M\n" +
 	"\x11world_liquid_flow\x18F \x01(\v2\x1f.df.plugin.WorldLiquidFlowEventH\x00R\x0fworldLiquidFlow\x12P\n" +
 	"\x12world_liquid_decay\x18G \x01(\v2 .df.plugin.WorldLiquidDecayEventH\x00R\x10worldLiquidDecay\x12S\n" +
 	"\x13world_liquid_harden\x18H \x01(\v2!.df.plugin.WorldLiquidHardenEventH\x00R\x11worldLiquidHarden\x12=\n" +
@@ -1806,19 +2883,30 @@ const file_plugin_proto_rawDesc = "" +
 	"\x14world_entity_despawn\x18O \x01(\v2\".df.plugin.WorldEntityDespawnEventH\x00R\x12worldEntityDespawn\x12I\n" +
 	"\x0fworld_explosion\x18P \x01(\v2\x1e.df.plugin.WorldExplosionEventH\x00R\x0eworldExplosion\x12=\n" +
 	"\vworld_close\x18Q \x01(\v2\x1a.df.plugin.WorldCloseEventH\x00R\n" +
-	"worldCloseB\t\n" +
-	"\apayload\"\x85\x03\n" +
+	"worldClose\x12V\n" +
+	"\x14plugin_circuit_state\x18d \x01(\v2\".df.plugin.PluginCircuitStateEventH\x00R\x12pluginCircuitStateB\t\n" +
+	"\apayload\"\xaa\x04\n" +
 	"\fPluginToHost\x12\x1b\n" +
-	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12.\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12\x1d\n" +
+	"\n" +
+	"auth_token\x18\x02 \x01(\tR\tauthToken\x12.\n" +
 	"\x05hello\x18\n" +
 	" \x01(\v2\x16.df.plugin.PluginHelloH\x00R\x05hello\x129\n" +
 	"\tsubscribe\x18\v \x01(\v2\x19.df.plugin.EventSubscribeH\x00R\tsubscribe\x12F\n" +
 	"\vserver_info\x18\f \x01(\v2#.df.plugin.ServerInformationRequestH\x00R\n" +
-	"serverInfo\x122\n" +
+	"serverInfo\x12A\n" +
+	"\fshutdown_ack\x18\r \x01(\v2\x1c.df.plugin.PluginShutdownAckH\x00R\vshutdownAck\x122\n" +
 	"\aactions\x18\x14 \x01(\v2\x16.df.plugin.ActionBatchH\x00R\aactions\x12)\n" +
 	"\x03log\x18\x1e \x01(\v2\x15.df.plugin.LogMessageH\x00R\x03log\x12;\n" +
-	"\fevent_result\x18( \x01(\v2\x16.df.plugin.EventResultH\x00R\veventResultB\t\n" +
-	"\apayload\"\x9b\x02\n" +
+	"\fevent_result\x18( \x01(\v2\x16.df.plugin.EventResultH\x00R\veventResult\x12A\n" +
+	"\x0eplugin_message\x182 \x01(\v2\x18.df.plugin.PluginMessageH\x00R\rpluginMessageB\t\n" +
+	"\apayload\";\n" +
+	"\rEventsDropped\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x04R\x05count\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\">\n" +
+	"\n" +
+	"EventBatch\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.df.plugin.EventEnvelopeR\x06events\"\xcd\x02\n" +
 	"\vPluginHello\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1f\n" +
@@ -1826,13 +2914,69 @@ const file_plugin_proto_rawDesc = "" +
 	"apiVersion\x122\n" +
 	"\bcommands\x18\x04 \x03(\v2\x16.df.plugin.CommandSpecR\bcommands\x12B\n" +
 	"\fcustom_items\x18\x05 \x03(\v2\x1f.df.plugin.CustomItemDefinitionR\vcustomItems\x12E\n" +
-	"\rcustom_blocks\x18\x06 \x03(\v2 .df.plugin.CustomBlockDefinitionR\fcustomBlocks\"<\n" +
+	"\rcustom_blocks\x18\x06 \x03(\v2 .df.plugin.CustomBlockDefinitionR\fcustomBlocks\x120\n" +
+	"\x14supports_event_batch\x18\a \x01(\bR\x12supportsEventBatch\"<\n" +
 	"\n" +
 	"LogMessage\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\">\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9e\x01\n" +
 	"\x0eEventSubscribe\x12,\n" +
-	"\x06events\x18\x01 \x03(\x0e2\x14.df.plugin.EventTypeR\x06events*\x8a\t\n" +
+	"\x06events\x18\x01 \x03(\x0e2\x14.df.plugin.EventTypeR\x06events\x12\x1a\n" +
+	"\bchannels\x18\x02 \x03(\tR\bchannels\x12B\n" +
+	"\rsubscriptions\x18\x03 \x03(\v2\x1c.df.plugin.EventSubscriptionR\rsubscriptions\"\xcd\x02\n" +
+	"\x11EventSubscription\x12*\n" +
+	"\x05event\x18\x01 \x01(\x0e2\x14.df.plugin.EventTypeR\x05event\x124\n" +
+	"\bpriority\x18\x02 \x01(\x0e2\x18.df.plugin.EventPriorityR\bpriority\x12+\n" +
+	"\x11receive_cancelled\x18\x03 \x01(\bR\x10receiveCancelled\x123\n" +
+	"\x06filter\x18\x04 \x01(\v2\x16.df.plugin.EventFilterH\x00R\x06filter\x88\x01\x01\x129\n" +
+	"\bthrottle\x18\x05 \x01(\v2\x18.df.plugin.EventThrottleH\x01R\bthrottle\x88\x01\x01\x12!\n" +
+	"\fobserve_only\x18\x06 \x01(\bR\vobserveOnlyB\t\n" +
+	"\a_filterB\v\n" +
+	"\t_throttle\"u\n" +
+	"\rEventThrottle\x12!\n" +
+	"\fmin_distance\x18\x01 \x01(\x01R\vminDistance\x12\x1b\n" +
+	"\tmin_angle\x18\x02 \x01(\x01R\bminAngle\x12$\n" +
+	"\x0emax_per_second\x18\x03 \x01(\x01R\fmaxPerSecond\"\xdd\x01\n" +
+	"\vEventFilter\x12\x16\n" +
+	"\x06worlds\x18\x01 \x03(\tR\x06worlds\x12!\n" +
+	"\fplayer_uuids\x18\x02 \x03(\tR\vplayerUuids\x12\x1f\n" +
+	"\vblock_names\x18\x03 \x03(\tR\n" +
+	"blockNames\x12\x1d\n" +
+	"\n" +
+	"item_names\x18\x04 \x03(\tR\titemNames\x12\x1a\n" +
+	"\bcommands\x18\x05 \x03(\tR\bcommands\x12,\n" +
+	"\x06region\x18\x06 \x01(\v2\x0f.df.plugin.BBoxH\x00R\x06region\x88\x01\x01B\t\n" +
+	"\a_region\"\xce\x01\n" +
+	"\fEventOutcome\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12(\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.df.plugin.EventTypeR\x04type\x12\x1c\n" +
+	"\tcancelled\x18\x03 \x01(\bR\tcancelled\x12!\n" +
+	"\fcancelled_by\x18\x04 \x01(\tR\vcancelledBy\x128\n" +
+	"\tmutations\x18\x05 \x03(\v2\x1a.df.plugin.AppliedMutationR\tmutations\"^\n" +
+	"\x0fAppliedMutation\x12\x1b\n" +
+	"\tplugin_id\x18\x01 \x01(\tR\bpluginId\x12.\n" +
+	"\x06result\x18\x02 \x01(\v2\x16.df.plugin.EventResultR\x06result\"\xba\x02\n" +
+	"\rPluginMessage\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\tR\tmessageId\x12(\n" +
+	"\x10source_plugin_id\x18\x02 \x01(\tR\x0esourcePluginId\x12(\n" +
+	"\x10target_plugin_id\x18\x03 \x01(\tR\x0etargetPluginId\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\fR\apayload\x12#\n" +
+	"\rexpects_reply\x18\x06 \x01(\bR\fexpectsReply\x12\x19\n" +
+	"\breply_to\x18\a \x01(\tR\areplyTo\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\b \x01(\x03R\ttimeoutMs\x12\x19\n" +
+	"\x05error\x18\t \x01(\tH\x00R\x05error\x88\x01\x01B\b\n" +
+	"\x06_error*\xae\x01\n" +
+	"\rEventPriority\x12\x19\n" +
+	"\x15EVENT_PRIORITY_NORMAL\x10\x00\x12\x19\n" +
+	"\x15EVENT_PRIORITY_LOWEST\x10\x01\x12\x16\n" +
+	"\x12EVENT_PRIORITY_LOW\x10\x02\x12\x17\n" +
+	"\x13EVENT_PRIORITY_HIGH\x10\x03\x12\x1a\n" +
+	"\x16EVENT_PRIORITY_HIGHEST\x10\x04\x12\x1a\n" +
+	"\x16EVENT_PRIORITY_MONITOR\x10\x05*\xe1\n" +
+	"\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eEVENT_TYPE_ALL\x10\x01\x12\x0f\n" +
@@ -1873,7 +3017,14 @@ const file_plugin_proto_rawDesc = "" +
 	"\x10PLAYER_ITEM_DROP\x10+\x12\x13\n" +
 	"\x0fPLAYER_TRANSFER\x10,\x12\v\n" +
 	"\aCOMMAND\x10-\x12\x16\n" +
-	"\x12PLAYER_DIAGNOSTICS\x10.\x12\x15\n" +
+	"\x12PLAYER_DIAGNOSTICS\x10.\x12\x18\n" +
+	"\x14PLAYER_FORM_RESPONSE\x10/\x12\x1c\n" +
+	"\x18PLAYER_DIALOGUE_RESPONSE\x100\x12 \n" +
+	"\x1cPLAYER_INVENTORY_SLOT_CHANGE\x102\x12\x12\n" +
+	"\x0eCONTAINER_OPEN\x103\x12\x13\n" +
+	"\x0fCONTAINER_CLOSE\x104\x12\x1b\n" +
+	"\x17VIRTUAL_INVENTORY_CLICK\x105\x12\x1b\n" +
+	"\x17VIRTUAL_INVENTORY_CLOSE\x106\x12\x15\n" +
 	"\x11WORLD_LIQUID_FLOW\x10F\x12\x16\n" +
 	"\x12WORLD_LIQUID_DECAY\x10G\x12\x17\n" +
 	"\x13WORLD_LIQUID_HARDEN\x10H\x12\x0f\n" +
@@ -1885,7 +3036,8 @@ const file_plugin_proto_rawDesc = "" +
 	"\x12WORLD_ENTITY_SPAWN\x10N\x12\x18\n" +
 	"\x14WORLD_ENTITY_DESPAWN\x10O\x12\x13\n" +
 	"\x0fWORLD_EXPLOSION\x10P\x12\x0f\n" +
-	"\vWORLD_CLOSE\x10Q2M\n" +
+	"\vWORLD_CLOSE\x10Q\x12\x18\n" +
+	"\x14PLUGIN_CIRCUIT_STATE\x10d2M\n" +
 	"\x06Plugin\x12C\n" +
 	"\vEventStream\x12\x17.df.plugin.PluginToHost\x1a\x17.df.plugin.HostToPlugin(\x010\x01B\x8a\x01\n" +
 	"\rcom.df.pluginB\vPluginProtoP\x01Z'github.com/secmc/plugin/proto/generated\xa2\x02\x03DPX\xaa\x02\tDf.Plugin\xca\x02\tDf\\Plugin\xe2\x02\x15Df\\Plugin\\GPBMetadata\xea\x02\n" +
@@ -1903,149 +3055,194 @@ func file_plugin_proto_rawDescGZIP() []byte {
 	return file_plugin_proto_rawDescData
 }
 
-var file_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_plugin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_plugin_proto_goTypes = []any{
-	(EventType)(0),                     // 0: df.plugin.EventType
-	(*HostToPlugin)(nil),               // 1: df.plugin.HostToPlugin
-	(*ServerInformationRequest)(nil),   // 2: df.plugin.ServerInformationRequest
-	(*ServerInformationResponse)(nil),  // 3: df.plugin.ServerInformationResponse
-	(*HostHello)(nil),                  // 4: df.plugin.HostHello
-	(*HostShutdown)(nil),               // 5: df.plugin.HostShutdown
-	(*EventEnvelope)(nil),              // 6: df.plugin.EventEnvelope
-	(*PluginToHost)(nil),               // 7: df.plugin.PluginToHost
-	(*PluginHello)(nil),                // 8: df.plugin.PluginHello
-	(*LogMessage)(nil),                 // 9: df.plugin.LogMessage
-	(*EventSubscribe)(nil),             // 10: df.plugin.EventSubscribe
-	(*ActionResult)(nil),               // 11: df.plugin.ActionResult
-	(*PlayerJoinEvent)(nil),            // 12: df.plugin.PlayerJoinEvent
-	(*PlayerQuitEvent)(nil),            // 13: df.plugin.PlayerQuitEvent
-	(*PlayerMoveEvent)(nil),            // 14: df.plugin.PlayerMoveEvent
-	(*PlayerJumpEvent)(nil),            // 15: df.plugin.PlayerJumpEvent
-	(*PlayerTeleportEvent)(nil),        // 16: df.plugin.PlayerTeleportEvent
-	(*PlayerChangeWorldEvent)(nil),     // 17: df.plugin.PlayerChangeWorldEvent
-	(*PlayerToggleSprintEvent)(nil),    // 18: df.plugin.PlayerToggleSprintEvent
-	(*PlayerToggleSneakEvent)(nil),     // 19: df.plugin.PlayerToggleSneakEvent
-	(*ChatEvent)(nil),                  // 20: df.plugin.ChatEvent
-	(*PlayerFoodLossEvent)(nil),        // 21: df.plugin.PlayerFoodLossEvent
-	(*PlayerHealEvent)(nil),            // 22: df.plugin.PlayerHealEvent
-	(*PlayerHurtEvent)(nil),            // 23: df.plugin.PlayerHurtEvent
-	(*PlayerDeathEvent)(nil),           // 24: df.plugin.PlayerDeathEvent
-	(*PlayerRespawnEvent)(nil),         // 25: df.plugin.PlayerRespawnEvent
-	(*PlayerSkinChangeEvent)(nil),      // 26: df.plugin.PlayerSkinChangeEvent
-	(*PlayerFireExtinguishEvent)(nil),  // 27: df.plugin.PlayerFireExtinguishEvent
-	(*PlayerStartBreakEvent)(nil),      // 28: df.plugin.PlayerStartBreakEvent
-	(*BlockBreakEvent)(nil),            // 29: df.plugin.BlockBreakEvent
-	(*PlayerBlockPlaceEvent)(nil),      // 30: df.plugin.PlayerBlockPlaceEvent
-	(*PlayerBlockPickEvent)(nil),       // 31: df.plugin.PlayerBlockPickEvent
-	(*PlayerItemUseEvent)(nil),         // 32: df.plugin.PlayerItemUseEvent
-	(*PlayerItemUseOnBlockEvent)(nil),  // 33: df.plugin.PlayerItemUseOnBlockEvent
-	(*PlayerItemUseOnEntityEvent)(nil), // 34: df.plugin.PlayerItemUseOnEntityEvent
-	(*PlayerItemReleaseEvent)(nil),     // 35: df.plugin.PlayerItemReleaseEvent
-	(*PlayerItemConsumeEvent)(nil),     // 36: df.plugin.PlayerItemConsumeEvent
-	(*PlayerAttackEntityEvent)(nil),    // 37: df.plugin.PlayerAttackEntityEvent
-	(*PlayerExperienceGainEvent)(nil),  // 38: df.plugin.PlayerExperienceGainEvent
-	(*PlayerPunchAirEvent)(nil),        // 39: df.plugin.PlayerPunchAirEvent
-	(*PlayerSignEditEvent)(nil),        // 40: df.plugin.PlayerSignEditEvent
-	(*PlayerLecternPageTurnEvent)(nil), // 41: df.plugin.PlayerLecternPageTurnEvent
-	(*PlayerItemDamageEvent)(nil),      // 42: df.plugin.PlayerItemDamageEvent
-	(*PlayerItemPickupEvent)(nil),      // 43: df.plugin.PlayerItemPickupEvent
-	(*PlayerHeldSlotChangeEvent)(nil),  // 44: df.plugin.PlayerHeldSlotChangeEvent
-	(*PlayerItemDropEvent)(nil),        // 45: df.plugin.PlayerItemDropEvent
-	(*PlayerTransferEvent)(nil),        // 46: df.plugin.PlayerTransferEvent
-	(*CommandEvent)(nil),               // 47: df.plugin.CommandEvent
-	(*PlayerDiagnosticsEvent)(nil),     // 48: df.plugin.PlayerDiagnosticsEvent
-	(*WorldLiquidFlowEvent)(nil),       // 49: df.plugin.WorldLiquidFlowEvent
-	(*WorldLiquidDecayEvent)(nil),      // 50: df.plugin.WorldLiquidDecayEvent
-	(*WorldLiquidHardenEvent)(nil),     // 51: df.plugin.WorldLiquidHardenEvent
-	(*WorldSoundEvent)(nil),            // 52: df.plugin.WorldSoundEvent
-	(*WorldFireSpreadEvent)(nil),       // 53: df.plugin.WorldFireSpreadEvent
-	(*WorldBlockBurnEvent)(nil),        // 54: df.plugin.WorldBlockBurnEvent
-	(*WorldCropTrampleEvent)(nil),      // 55: df.plugin.WorldCropTrampleEvent
-	(*WorldLeavesDecayEvent)(nil),      // 56: df.plugin.WorldLeavesDecayEvent
-	(*WorldEntitySpawnEvent)(nil),      // 57: df.plugin.WorldEntitySpawnEvent
-	(*WorldEntityDespawnEvent)(nil),    // 58: df.plugin.WorldEntityDespawnEvent
-	(*WorldExplosionEvent)(nil),        // 59: df.plugin.WorldExplosionEvent
-	(*WorldCloseEvent)(nil),            // 60: df.plugin.WorldCloseEvent
-	(*ActionBatch)(nil),                // 61: df.plugin.ActionBatch
-	(*EventResult)(nil),                // 62: df.plugin.EventResult
-	(*CommandSpec)(nil),                // 63: df.plugin.CommandSpec
-	(*CustomItemDefinition)(nil),       // 64: df.plugin.CustomItemDefinition
-	(*CustomBlockDefinition)(nil),      // 65: df.plugin.CustomBlockDefinition
+	(EventPriority)(0),                     // 0: df.plugin.EventPriority
+	(EventType)(0),                         // 1: df.plugin.EventType
+	(*HostToPlugin)(nil),                   // 2: df.plugin.HostToPlugin
+	(*ServerInformationRequest)(nil),       // 3: df.plugin.ServerInformationRequest
+	(*ServerInformationResponse)(nil),      // 4: df.plugin.ServerInformationResponse
+	(*HostHello)(nil),                      // 5: df.plugin.HostHello
+	(*HostShutdown)(nil),                   // 6: df.plugin.HostShutdown
+	(*PluginShutdownAck)(nil),              // 7: df.plugin.PluginShutdownAck
+	(*PluginCircuitStateEvent)(nil),        // 8: df.plugin.PluginCircuitStateEvent
+	(*EventEnvelope)(nil),                  // 9: df.plugin.EventEnvelope
+	(*PluginToHost)(nil),                   // 10: df.plugin.PluginToHost
+	(*EventsDropped)(nil),                  // 11: df.plugin.EventsDropped
+	(*EventBatch)(nil),                     // 12: df.plugin.EventBatch
+	(*PluginHello)(nil),                    // 13: df.plugin.PluginHello
+	(*LogMessage)(nil),                     // 14: df.plugin.LogMessage
+	(*EventSubscribe)(nil),                 // 15: df.plugin.EventSubscribe
+	(*EventSubscription)(nil),              // 16: df.plugin.EventSubscription
+	(*EventThrottle)(nil),                  // 17: df.plugin.EventThrottle
+	(*EventFilter)(nil),                    // 18: df.plugin.EventFilter
+	(*EventOutcome)(nil),                   // 19: df.plugin.EventOutcome
+	(*AppliedMutation)(nil),                // 20: df.plugin.AppliedMutation
+	(*PluginMessage)(nil),                  // 21: df.plugin.PluginMessage
+	(*ActionResult)(nil),                   // 22: df.plugin.ActionResult
+	(*PlayerJoinEvent)(nil),                // 23: df.plugin.PlayerJoinEvent
+	(*PlayerQuitEvent)(nil),                // 24: df.plugin.PlayerQuitEvent
+	(*PlayerMoveEvent)(nil),                // 25: df.plugin.PlayerMoveEvent
+	(*PlayerJumpEvent)(nil),                // 26: df.plugin.PlayerJumpEvent
+	(*PlayerTeleportEvent)(nil),            // 27: df.plugin.PlayerTeleportEvent
+	(*PlayerChangeWorldEvent)(nil),         // 28: df.plugin.PlayerChangeWorldEvent
+	(*PlayerToggleSprintEvent)(nil),        // 29: df.plugin.PlayerToggleSprintEvent
+	(*PlayerToggleSneakEvent)(nil),         // 30: df.plugin.PlayerToggleSneakEvent
+	(*ChatEvent)(nil),                      // 31: df.plugin.ChatEvent
+	(*PlayerFoodLossEvent)(nil),            // 32: df.plugin.PlayerFoodLossEvent
+	(*PlayerHealEvent)(nil),                // 33: df.plugin.PlayerHealEvent
+	(*PlayerHurtEvent)(nil),                // 34: df.plugin.PlayerHurtEvent
+	(*PlayerDeathEvent)(nil),               // 35: df.plugin.PlayerDeathEvent
+	(*PlayerRespawnEvent)(nil),             // 36: df.plugin.PlayerRespawnEvent
+	(*PlayerSkinChangeEvent)(nil),          // 37: df.plugin.PlayerSkinChangeEvent
+	(*PlayerFireExtinguishEvent)(nil),      // 38: df.plugin.PlayerFireExtinguishEvent
+	(*PlayerStartBreakEvent)(nil),          // 39: df.plugin.PlayerStartBreakEvent
+	(*BlockBreakEvent)(nil),                // 40: df.plugin.BlockBreakEvent
+	(*PlayerBlockPlaceEvent)(nil),          // 41: df.plugin.PlayerBlockPlaceEvent
+	(*PlayerBlockPickEvent)(nil),           // 42: df.plugin.PlayerBlockPickEvent
+	(*PlayerItemUseEvent)(nil),             // 43: df.plugin.PlayerItemUseEvent
+	(*PlayerItemUseOnBlockEvent)(nil),      // 44: df.plugin.PlayerItemUseOnBlockEvent
+	(*PlayerItemUseOnEntityEvent)(nil),     // 45: df.plugin.PlayerItemUseOnEntityEvent
+	(*PlayerItemReleaseEvent)(nil),         // 46: df.plugin.PlayerItemReleaseEvent
+	(*PlayerItemConsumeEvent)(nil),         // 47: df.plugin.PlayerItemConsumeEvent
+	(*PlayerAttackEntityEvent)(nil),        // 48: df.plugin.PlayerAttackEntityEvent
+	(*PlayerExperienceGainEvent)(nil),      // 49: df.plugin.PlayerExperienceGainEvent
+	(*PlayerPunchAirEvent)(nil),            // 50: df.plugin.PlayerPunchAirEvent
+	(*PlayerSignEditEvent)(nil),            // 51: df.plugin.PlayerSignEditEvent
+	(*PlayerLecternPageTurnEvent)(nil),     // 52: df.plugin.PlayerLecternPageTurnEvent
+	(*PlayerItemDamageEvent)(nil),          // 53: df.plugin.PlayerItemDamageEvent
+	(*PlayerItemPickupEvent)(nil),          // 54: df.plugin.PlayerItemPickupEvent
+	(*PlayerHeldSlotChangeEvent)(nil),      // 55: df.plugin.PlayerHeldSlotChangeEvent
+	(*PlayerItemDropEvent)(nil),            // 56: df.plugin.PlayerItemDropEvent
+	(*PlayerTransferEvent)(nil),            // 57: df.plugin.PlayerTransferEvent
+	(*CommandEvent)(nil),                   // 58: df.plugin.CommandEvent
+	(*PlayerDiagnosticsEvent)(nil),         // 59: df.plugin.PlayerDiagnosticsEvent
+	(*PlayerFormResponseEvent)(nil),        // 60: df.plugin.PlayerFormResponseEvent
+	(*PlayerDialogueResponseEvent)(nil),    // 61: df.plugin.PlayerDialogueResponseEvent
+	(*PlayerMoveBatch)(nil),                // 62: df.plugin.PlayerMoveBatch
+	(*PlayerInventorySlotChangeEvent)(nil), // 63: df.plugin.PlayerInventorySlotChangeEvent
+	(*ContainerOpenEvent)(nil),             // 64: df.plugin.ContainerOpenEvent
+	(*ContainerCloseEvent)(nil),            // 65: df.plugin.ContainerCloseEvent
+	(*VirtualInventoryClickEvent)(nil),     // 66: df.plugin.VirtualInventoryClickEvent
+	(*VirtualInventoryCloseEvent)(nil),     // 67: df.plugin.VirtualInventoryCloseEvent
+	(*WorldLiquidFlowEvent)(nil),           // 68: df.plugin.WorldLiquidFlowEvent
+	(*WorldLiquidDecayEvent)(nil),          // 69: df.plugin.WorldLiquidDecayEvent
+	(*WorldLiquidHardenEvent)(nil),         // 70: df.plugin.WorldLiquidHardenEvent
+	(*WorldSoundEvent)(nil),                // 71: df.plugin.WorldSoundEvent
+	(*WorldFireSpreadEvent)(nil),           // 72: df.plugin.WorldFireSpreadEvent
+	(*WorldBlockBurnEvent)(nil),            // 73: df.plugin.WorldBlockBurnEvent
+	(*WorldCropTrampleEvent)(nil),          // 74: df.plugin.WorldCropTrampleEvent
+	(*WorldLeavesDecayEvent)(nil),          // 75: df.plugin.WorldLeavesDecayEvent
+	(*WorldEntitySpawnEvent)(nil),          // 76: df.plugin.WorldEntitySpawnEvent
+	(*WorldEntityDespawnEvent)(nil),        // 77: df.plugin.WorldEntityDespawnEvent
+	(*WorldExplosionEvent)(nil),            // 78: df.plugin.WorldExplosionEvent
+	(*WorldCloseEvent)(nil),                // 79: df.plugin.WorldCloseEvent
+	(*ActionBatch)(nil),                    // 80: df.plugin.ActionBatch
+	(*EventResult)(nil),                    // 81: df.plugin.EventResult
+	(*CommandSpec)(nil),                    // 82: df.plugin.CommandSpec
+	(*CustomItemDefinition)(nil),           // 83: df.plugin.CustomItemDefinition
+	(*CustomBlockDefinition)(nil),          // 84: df.plugin.CustomBlockDefinition
+	(*BBox)(nil),                           // 85: df.plugin.BBox
 }
 var file_plugin_proto_depIdxs = []int32{
-	4,  // 0: df.plugin.HostToPlugin.hello:type_name -> df.plugin.HostHello
-	5,  // 1: df.plugin.HostToPlugin.shutdown:type_name -> df.plugin.HostShutdown
-	3,  // 2: df.plugin.HostToPlugin.server_info:type_name -> df.plugin.ServerInformationResponse
-	6,  // 3: df.plugin.HostToPlugin.event:type_name -> df.plugin.EventEnvelope
-	11, // 4: df.plugin.HostToPlugin.action_result:type_name -> df.plugin.ActionResult
-	0,  // 5: df.plugin.EventEnvelope.type:type_name -> df.plugin.EventType
-	12, // 6: df.plugin.EventEnvelope.player_join:type_name -> df.plugin.PlayerJoinEvent
-	13, // 7: df.plugin.EventEnvelope.player_quit:type_name -> df.plugin.PlayerQuitEvent
-	14, // 8: df.plugin.EventEnvelope.player_move:type_name -> df.plugin.PlayerMoveEvent
-	15, // 9: df.plugin.EventEnvelope.player_jump:type_name -> df.plugin.PlayerJumpEvent
-	16, // 10: df.plugin.EventEnvelope.player_teleport:type_name -> df.plugin.PlayerTeleportEvent
-	17, // 11: df.plugin.EventEnvelope.player_change_world:type_name -> df.plugin.PlayerChangeWorldEvent
-	18, // 12: df.plugin.EventEnvelope.player_toggle_sprint:type_name -> df.plugin.PlayerToggleSprintEvent
-	19, // 13: df.plugin.EventEnvelope.player_toggle_sneak:type_name -> df.plugin.PlayerToggleSneakEvent
-	20, // 14: df.plugin.EventEnvelope.chat:type_name -> df.plugin.ChatEvent
-	21, // 15: df.plugin.EventEnvelope.player_food_loss:type_name -> df.plugin.PlayerFoodLossEvent
-	22, // 16: df.plugin.EventEnvelope.player_heal:type_name -> df.plugin.PlayerHealEvent
-	23, // 17: df.plugin.EventEnvelope.player_hurt:type_name -> df.plugin.PlayerHurtEvent
-	24, // 18: df.plugin.EventEnvelope.player_death:type_name -> df.plugin.PlayerDeathEvent
-	25, // 19: df.plugin.EventEnvelope.player_respawn:type_name -> df.plugin.PlayerRespawnEvent
-	26, // 20: df.plugin.EventEnvelope.player_skin_change:type_name -> df.plugin.PlayerSkinChangeEvent
-	27, // 21: df.plugin.EventEnvelope.player_fire_extinguish:type_name -> df.plugin.PlayerFireExtinguishEvent
-	28, // 22: df.plugin.EventEnvelope.player_start_break:type_name -> df.plugin.PlayerStartBreakEvent
-	29, // 23: df.plugin.EventEnvelope.block_break:type_name -> df.plugin.BlockBreakEvent
-	30, // 24: df.plugin.EventEnvelope.player_block_place:type_name -> df.plugin.PlayerBlockPlaceEvent
-	31, // 25: df.plugin.EventEnvelope.player_block_pick:type_name -> df.plugin.PlayerBlockPickEvent
-	32, // 26: df.plugin.EventEnvelope.player_item_use:type_name -> df.plugin.PlayerItemUseEvent
-	33, // 27: df.plugin.EventEnvelope.player_item_use_on_block:type_name -> df.plugin.PlayerItemUseOnBlockEvent
-	34, // 28: df.plugin.EventEnvelope.player_item_use_on_entity:type_name -> df.plugin.PlayerItemUseOnEntityEvent
-	35, // 29: df.plugin.EventEnvelope.player_item_release:type_name -> df.plugin.PlayerItemReleaseEvent
-	36, // 30: df.plugin.EventEnvelope.player_item_consume:type_name -> df.plugin.PlayerItemConsumeEvent
-	37, // 31: df.plugin.EventEnvelope.player_attack_entity:type_name -> df.plugin.PlayerAttackEntityEvent
-	38, // 32: df.plugin.EventEnvelope.player_experience_gain:type_name -> df.plugin.PlayerExperienceGainEvent
-	39, // 33: df.plugin.EventEnvelope.player_punch_air:type_name -> df.plugin.PlayerPunchAirEvent
-	40, // 34: df.plugin.EventEnvelope.player_sign_edit:type_name -> df.plugin.PlayerSignEditEvent
-	41, // 35: df.plugin.EventEnvelope.player_lectern_page_turn:type_name -> df.plugin.PlayerLecternPageTurnEvent
-	42, // 36: df.plugin.EventEnvelope.player_item_damage:type_name -> df.plugin.PlayerItemDamageEvent
-	43, // 37: df.plugin.EventEnvelope.player_item_pickup:type_name -> df.plugin.PlayerItemPickupEvent
-	44, // 38: df.plugin.EventEnvelope.player_held_slot_change:type_name -> df.plugin.PlayerHeldSlotChangeEvent
-	45, // 39: df.plugin.EventEnvelope.player_item_drop:type_name -> df.plugin.PlayerItemDropEvent
-	46, // 40: df.plugin.EventEnvelope.player_transfer:type_name -> df.plugin.PlayerTransferEvent
-	47, // 41: df.plugin.EventEnvelope.command:type_name -> df.plugin.CommandEvent
-	48, // 42: df.plugin.EventEnvelope.player_diagnostics:type_name -> df.plugin.PlayerDiagnosticsEvent
-	49, // 43: df.plugin.EventEnvelope.world_liquid_flow:type_name -> df.plugin.WorldLiquidFlowEvent
-	50, // 44: df.plugin.EventEnvelope.world_liquid_decay:type_name -> df.plugin.WorldLiquidDecayEvent
-	51, // 45: df.plugin.EventEnvelope.world_liquid_harden:type_name -> df.plugin.WorldLiquidHardenEvent
-	52, // 46: df.plugin.EventEnvelope.world_sound:type_name -> df.plugin.WorldSoundEvent
-	53, // 47: df.plugin.EventEnvelope.world_fire_spread:type_name -> df.plugin.WorldFireSpreadEvent
-	54, // 48: df.plugin.EventEnvelope.world_block_burn:type_name -> df.plugin.WorldBlockBurnEvent
-	55, // 49: df.plugin.EventEnvelope.world_crop_trample:type_name -> df.plugin.WorldCropTrampleEvent
-	56, // 50: df.plugin.EventEnvelope.world_leaves_decay:type_name -> df.plugin.WorldLeavesDecayEvent
-	57, // 51: df.plugin.EventEnvelope.world_entity_spawn:type_name -> df.plugin.WorldEntitySpawnEvent
-	58, // 52: df.plugin.EventEnvelope.world_entity_despawn:type_name -> df.plugin.WorldEntityDespawnEvent
-	59, // 53: df.plugin.EventEnvelope.world_explosion:type_name -> df.plugin.WorldExplosionEvent
-	60, // 54: df.plugin.EventEnvelope.world_close:type_name -> df.plugin.WorldCloseEvent
-	8,  // 55: df.plugin.PluginToHost.hello:type_name -> df.plugin.PluginHello
-	10, // 56: df.plugin.PluginToHost.subscribe:type_name -> df.plugin.EventSubscribe
-	2,  // 57: df.plugin.PluginToHost.server_info:type_name -> df.plugin.ServerInformationRequest
-	61, // 58: df.plugin.PluginToHost.actions:type_name -> df.plugin.ActionBatch
-	9,  // 59: df.plugin.PluginToHost.log:type_name -> df.plugin.LogMessage
-	62, // 60: df.plugin.PluginToHost.event_result:type_name -> df.plugin.EventResult
-	63, // 61: df.plugin.PluginHello.commands:type_name -> df.plugin.CommandSpec
-	64, // 62: df.plugin.PluginHello.custom_items:type_name -> df.plugin.CustomItemDefinition
-	65, // 63: df.plugin.PluginHello.custom_blocks:type_name -> df.plugin.CustomBlockDefinition
-	0,  // 64: df.plugin.EventSubscribe.events:type_name -> df.plugin.EventType
-	7,  // 65: df.plugin.Plugin.EventStream:input_type -> df.plugin.PluginToHost
-	1,  // 66: df.plugin.Plugin.EventStream:output_type -> df.plugin.HostToPlugin
-	66, // [66:67] is the sub-list for method output_type
-	65, // [65:66] is the sub-list for method input_type
-	65, // [65:65] is the sub-list for extension type_name
-	65, // [65:65] is the sub-list for extension extendee
-	0,  // [0:65] is the sub-list for field type_name
+	5,  // 0: df.plugin.HostToPlugin.hello:type_name -> df.plugin.HostHello
+	6,  // 1: df.plugin.HostToPlugin.shutdown:type_name -> df.plugin.HostShutdown
+	4,  // 2: df.plugin.HostToPlugin.server_info:type_name -> df.plugin.ServerInformationResponse
+	9,  // 3: df.plugin.HostToPlugin.event:type_name -> df.plugin.EventEnvelope
+	22, // 4: df.plugin.HostToPlugin.action_result:type_name -> df.plugin.ActionResult
+	19, // 5: df.plugin.HostToPlugin.event_outcome:type_name -> df.plugin.EventOutcome
+	12, // 6: df.plugin.HostToPlugin.event_batch:type_name -> df.plugin.EventBatch
+	11, // 7: df.plugin.HostToPlugin.events_dropped:type_name -> df.plugin.EventsDropped
+	21, // 8: df.plugin.HostToPlugin.plugin_message:type_name -> df.plugin.PluginMessage
+	1,  // 9: df.plugin.EventEnvelope.type:type_name -> df.plugin.EventType
+	23, // 10: df.plugin.EventEnvelope.player_join:type_name -> df.plugin.PlayerJoinEvent
+	24, // 11: df.plugin.EventEnvelope.player_quit:type_name -> df.plugin.PlayerQuitEvent
+	25, // 12: df.plugin.EventEnvelope.player_move:type_name -> df.plugin.PlayerMoveEvent
+	26, // 13: df.plugin.EventEnvelope.player_jump:type_name -> df.plugin.PlayerJumpEvent
+	27, // 14: df.plugin.EventEnvelope.player_teleport:type_name -> df.plugin.PlayerTeleportEvent
+	28, // 15: df.plugin.EventEnvelope.player_change_world:type_name -> df.plugin.PlayerChangeWorldEvent
+	29, // 16: df.plugin.EventEnvelope.player_toggle_sprint:type_name -> df.plugin.PlayerToggleSprintEvent
+	30, // 17: df.plugin.EventEnvelope.player_toggle_sneak:type_name -> df.plugin.PlayerToggleSneakEvent
+	31, // 18: df.plugin.EventEnvelope.chat:type_name -> df.plugin.ChatEvent
+	32, // 19: df.plugin.EventEnvelope.player_food_loss:type_name -> df.plugin.PlayerFoodLossEvent
+	33, // 20: df.plugin.EventEnvelope.player_heal:type_name -> df.plugin.PlayerHealEvent
+	34, // 21: df.plugin.EventEnvelope.player_hurt:type_name -> df.plugin.PlayerHurtEvent
+	35, // 22: df.plugin.EventEnvelope.player_death:type_name -> df.plugin.PlayerDeathEvent
+	36, // 23: df.plugin.EventEnvelope.player_respawn:type_name -> df.plugin.PlayerRespawnEvent
+	37, // 24: df.plugin.EventEnvelope.player_skin_change:type_name -> df.plugin.PlayerSkinChangeEvent
+	38, // 25: df.plugin.EventEnvelope.player_fire_extinguish:type_name -> df.plugin.PlayerFireExtinguishEvent
+	39, // 26: df.plugin.EventEnvelope.player_start_break:type_name -> df.plugin.PlayerStartBreakEvent
+	40, // 27: df.plugin.EventEnvelope.block_break:type_name -> df.plugin.BlockBreakEvent
+	41, // 28: df.plugin.EventEnvelope.player_block_place:type_name -> df.plugin.PlayerBlockPlaceEvent
+	42, // 29: df.plugin.EventEnvelope.player_block_pick:type_name -> df.plugin.PlayerBlockPickEvent
+	43, // 30: df.plugin.EventEnvelope.player_item_use:type_name -> df.plugin.PlayerItemUseEvent
+	44, // 31: df.plugin.EventEnvelope.player_item_use_on_block:type_name -> df.plugin.PlayerItemUseOnBlockEvent
+	45, // 32: df.plugin.EventEnvelope.player_item_use_on_entity:type_name -> df.plugin.PlayerItemUseOnEntityEvent
+	46, // 33: df.plugin.EventEnvelope.player_item_release:type_name -> df.plugin.PlayerItemReleaseEvent
+	47, // 34: df.plugin.EventEnvelope.player_item_consume:type_name -> df.plugin.PlayerItemConsumeEvent
+	48, // 35: df.plugin.EventEnvelope.player_attack_entity:type_name -> df.plugin.PlayerAttackEntityEvent
+	49, // 36: df.plugin.EventEnvelope.player_experience_gain:type_name -> df.plugin.PlayerExperienceGainEvent
+	50, // 37: df.plugin.EventEnvelope.player_punch_air:type_name -> df.plugin.PlayerPunchAirEvent
+	51, // 38: df.plugin.EventEnvelope.player_sign_edit:type_name -> df.plugin.PlayerSignEditEvent
+	52, // 39: df.plugin.EventEnvelope.player_lectern_page_turn:type_name -> df.plugin.PlayerLecternPageTurnEvent
+	53, // 40: df.plugin.EventEnvelope.player_item_damage:type_name -> df.plugin.PlayerItemDamageEvent
+	54, // 41: df.plugin.EventEnvelope.player_item_pickup:type_name -> df.plugin.PlayerItemPickupEvent
+	55, // 42: df.plugin.EventEnvelope.player_held_slot_change:type_name -> df.plugin.PlayerHeldSlotChangeEvent
+	56, // 43: df.plugin.EventEnvelope.player_item_drop:type_name -> df.plugin.PlayerItemDropEvent
+	57, // 44: df.plugin.EventEnvelope.player_transfer:type_name -> df.plugin.PlayerTransferEvent
+	58, // 45: df.plugin.EventEnvelope.command:type_name -> df.plugin.CommandEvent
+	59, // 46: df.plugin.EventEnvelope.player_diagnostics:type_name -> df.plugin.PlayerDiagnosticsEvent
+	60, // 47: df.plugin.EventEnvelope.player_form_response:type_name -> df.plugin.PlayerFormResponseEvent
+	61, // 48: df.plugin.EventEnvelope.player_dialogue_response:type_name -> df.plugin.PlayerDialogueResponseEvent
+	62, // 49: df.plugin.EventEnvelope.player_move_batch:type_name -> df.plugin.PlayerMoveBatch
+	63, // 50: df.plugin.EventEnvelope.player_inventory_slot_change:type_name -> df.plugin.PlayerInventorySlotChangeEvent
+	64, // 51: df.plugin.EventEnvelope.container_open:type_name -> df.plugin.ContainerOpenEvent
+	65, // 52: df.plugin.EventEnvelope.container_close:type_name -> df.plugin.ContainerCloseEvent
+	66, // 53: df.plugin.EventEnvelope.virtual_inventory_click:type_name -> df.plugin.VirtualInventoryClickEvent
+	67, // 54: df.plugin.EventEnvelope.virtual_inventory_close:type_name -> df.plugin.VirtualInventoryCloseEvent
+	68, // 55: df.plugin.EventEnvelope.world_liquid_flow:type_name -> df.plugin.WorldLiquidFlowEvent
+	69, // 56: df.plugin.EventEnvelope.world_liquid_decay:type_name -> df.plugin.WorldLiquidDecayEvent
+	70, // 57: df.plugin.EventEnvelope.world_liquid_harden:type_name -> df.plugin.WorldLiquidHardenEvent
+	71, // 58: df.plugin.EventEnvelope.world_sound:type_name -> df.plugin.WorldSoundEvent
+	72, // 59: df.plugin.EventEnvelope.world_fire_spread:type_name -> df.plugin.WorldFireSpreadEvent
+	73, // 60: df.plugin.EventEnvelope.world_block_burn:type_name -> df.plugin.WorldBlockBurnEvent
+	74, // 61: df.plugin.EventEnvelope.world_crop_trample:type_name -> df.plugin.WorldCropTrampleEvent
+	75, // 62: df.plugin.EventEnvelope.world_leaves_decay:type_name -> df.plugin.WorldLeavesDecayEvent
+	76, // 63: df.plugin.EventEnvelope.world_entity_spawn:type_name -> df.plugin.WorldEntitySpawnEvent
+	77, // 64: df.plugin.EventEnvelope.world_entity_despawn:type_name -> df.plugin.WorldEntityDespawnEvent
+	78, // 65: df.plugin.EventEnvelope.world_explosion:type_name -> df.plugin.WorldExplosionEvent
+	79, // 66: df.plugin.EventEnvelope.world_close:type_name -> df.plugin.WorldCloseEvent
+	8,  // 67: df.plugin.EventEnvelope.plugin_circuit_state:type_name -> df.plugin.PluginCircuitStateEvent
+	13, // 68: df.plugin.PluginToHost.hello:type_name -> df.plugin.PluginHello
+	15, // 69: df.plugin.PluginToHost.subscribe:type_name -> df.plugin.EventSubscribe
+	3,  // 70: df.plugin.PluginToHost.server_info:type_name -> df.plugin.ServerInformationRequest
+	7,  // 71: df.plugin.PluginToHost.shutdown_ack:type_name -> df.plugin.PluginShutdownAck
+	80, // 72: df.plugin.PluginToHost.actions:type_name -> df.plugin.ActionBatch
+	14, // 73: df.plugin.PluginToHost.log:type_name -> df.plugin.LogMessage
+	81, // 74: df.plugin.PluginToHost.event_result:type_name -> df.plugin.EventResult
+	21, // 75: df.plugin.PluginToHost.plugin_message:type_name -> df.plugin.PluginMessage
+	9,  // 76: df.plugin.EventBatch.events:type_name -> df.plugin.EventEnvelope
+	82, // 77: df.plugin.PluginHello.commands:type_name -> df.plugin.CommandSpec
+	83, // 78: df.plugin.PluginHello.custom_items:type_name -> df.plugin.CustomItemDefinition
+	84, // 79: df.plugin.PluginHello.custom_blocks:type_name -> df.plugin.CustomBlockDefinition
+	1,  // 80: df.plugin.EventSubscribe.events:type_name -> df.plugin.EventType
+	16, // 81: df.plugin.EventSubscribe.subscriptions:type_name -> df.plugin.EventSubscription
+	1,  // 82: df.plugin.EventSubscription.event:type_name -> df.plugin.EventType
+	0,  // 83: df.plugin.EventSubscription.priority:type_name -> df.plugin.EventPriority
+	18, // 84: df.plugin.EventSubscription.filter:type_name -> df.plugin.EventFilter
+	17, // 85: df.plugin.EventSubscription.throttle:type_name -> df.plugin.EventThrottle
+	85, // 86: df.plugin.EventFilter.region:type_name -> df.plugin.BBox
+	1,  // 87: df.plugin.EventOutcome.type:type_name -> df.plugin.EventType
+	20, // 88: df.plugin.EventOutcome.mutations:type_name -> df.plugin.AppliedMutation
+	81, // 89: df.plugin.AppliedMutation.result:type_name -> df.plugin.EventResult
+	10, // 90: df.plugin.Plugin.EventStream:input_type -> df.plugin.PluginToHost
+	2,  // 91: df.plugin.Plugin.EventStream:output_type -> df.plugin.HostToPlugin
+	91, // [91:92] is the sub-list for method output_type
+	90, // [90:91] is the sub-list for method input_type
+	90, // [90:90] is the sub-list for extension type_name
+	90, // [90:90] is the sub-list for extension extendee
+	0,  // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_plugin_proto_init() }
@@ -2066,8 +3263,12 @@ func file_plugin_proto_init() {
 		(*HostToPlugin_ServerInfo)(nil),
 		(*HostToPlugin_Event)(nil),
 		(*HostToPlugin_ActionResult)(nil),
+		(*HostToPlugin_EventOutcome)(nil),
+		(*HostToPlugin_EventBatch)(nil),
+		(*HostToPlugin_EventsDropped)(nil),
+		(*HostToPlugin_PluginMessage)(nil),
 	}
-	file_plugin_proto_msgTypes[5].OneofWrappers = []any{
+	file_plugin_proto_msgTypes[7].OneofWrappers = []any{
 		(*EventEnvelope_PlayerJoin)(nil),
 		(*EventEnvelope_PlayerQuit)(nil),
 		(*EventEnvelope_PlayerMove)(nil),
@@ -2105,6 +3306,14 @@ func file_plugin_proto_init() {
 		(*EventEnvelope_PlayerTransfer)(nil),
 		(*EventEnvelope_Command)(nil),
 		(*EventEnvelope_PlayerDiagnostics)(nil),
+		(*EventEnvelope_PlayerFormResponse)(nil),
+		(*EventEnvelope_PlayerDialogueResponse)(nil),
+		(*EventEnvelope_PlayerMoveBatch)(nil),
+		(*EventEnvelope_PlayerInventorySlotChange)(nil),
+		(*EventEnvelope_ContainerOpen)(nil),
+		(*EventEnvelope_ContainerClose)(nil),
+		(*EventEnvelope_VirtualInventoryClick)(nil),
+		(*EventEnvelope_VirtualInventoryClose)(nil),
 		(*EventEnvelope_WorldLiquidFlow)(nil),
 		(*EventEnvelope_WorldLiquidDecay)(nil),
 		(*EventEnvelope_WorldLiquidHarden)(nil),
@@ -2117,22 +3326,28 @@ func file_plugin_proto_init() {
 		(*EventEnvelope_WorldEntityDespawn)(nil),
 		(*EventEnvelope_WorldExplosion)(nil),
 		(*EventEnvelope_WorldClose)(nil),
+		(*EventEnvelope_PluginCircuitState)(nil),
 	}
-	file_plugin_proto_msgTypes[6].OneofWrappers = []any{
+	file_plugin_proto_msgTypes[8].OneofWrappers = []any{
 		(*PluginToHost_Hello)(nil),
 		(*PluginToHost_Subscribe)(nil),
 		(*PluginToHost_ServerInfo)(nil),
+		(*PluginToHost_ShutdownAck)(nil),
 		(*PluginToHost_Actions)(nil),
 		(*PluginToHost_Log)(nil),
 		(*PluginToHost_EventResult)(nil),
+		(*PluginToHost_PluginMessage)(nil),
 	}
+	file_plugin_proto_msgTypes[14].OneofWrappers = []any{}
+	file_plugin_proto_msgTypes[16].OneofWrappers = []any{}
+	file_plugin_proto_msgTypes[19].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugin_proto_rawDesc), len(file_plugin_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      2,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},